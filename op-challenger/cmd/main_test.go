@@ -89,7 +89,12 @@ func TestTraceType(t *testing.T) {
 
 func TestGameAddress(t *testing.T) {
 	t.Run("Required", func(t *testing.T) {
-		verifyArgsInvalid(t, "flag game-address is required", addRequiredArgsExcept(config.TraceTypeAlphabet, "--game-address"))
+		verifyArgsInvalid(t, "flag game-address or game-factory-address is required", addRequiredArgsExcept(config.TraceTypeAlphabet, "--game-address"))
+	})
+
+	t.Run("NotRequiredWithGameFactory", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgsExcept(config.TraceTypeAlphabet, "--game-address", "--game-factory-address="+gameAddressValue))
+		require.Equal(t, common.Address{}, cfg.GameAddress)
 	})
 
 	t.Run("Valid", func(t *testing.T) {
@@ -103,6 +108,58 @@ func TestGameAddress(t *testing.T) {
 	})
 }
 
+func TestGameFactoryAddress(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Equal(t, common.Address{}, cfg.GameFactoryAddress)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		addr := common.Address{0xbb, 0xcc, 0xdd}
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--game-factory-address="+addr.Hex()))
+		require.Equal(t, addr, cfg.GameFactoryAddress)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid address: foo", addRequiredArgs(config.TraceTypeAlphabet, "--game-factory-address=foo"))
+	})
+}
+
+func TestAdditionalGames(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.AdditionalGames)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		addr1 := common.Address{0xbb}
+		addr2 := common.Address{0xcc}
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet,
+			"--additional-game-address="+addr1.Hex(), "--additional-game-address="+addr2.Hex()))
+		require.Equal(t, []common.Address{addr1, addr2}, cfg.AdditionalGames)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid address: foo", addRequiredArgs(config.TraceTypeAlphabet, "--additional-game-address=foo"))
+	})
+}
+
+func TestGameTypes(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.GameTypes)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--game-type=1", "--game-type=0"))
+		require.Equal(t, []uint8{1, 0}, cfg.GameTypes)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		verifyArgsInvalid(t, "invalid game type: 256", addRequiredArgs(config.TraceTypeAlphabet, "--game-type=256"))
+	})
+}
+
 func TestTxManagerFlagsSupported(t *testing.T) {
 	// Not a comprehensive list of flags, just enough to sanity check the txmgr.CLIFlags were defined
 	cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--"+txmgr.NumConfirmationsFlagName, "7"))
@@ -226,6 +283,30 @@ func TestCannonSnapshotFreq(t *testing.T) {
 	})
 }
 
+func TestSkipFinalizedOutputs(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.False(t, cfg.SkipFinalizedOutputs)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--skip-finalized-outputs"))
+		require.True(t, cfg.SkipFinalizedOutputs)
+	})
+}
+
+func TestStateDumpDir(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet))
+		require.Empty(t, cfg.StateDumpDir)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		cfg := configForArgs(t, addRequiredArgs(config.TraceTypeAlphabet, "--state-dump-dir=/some/dir"))
+		require.Equal(t, "/some/dir", cfg.StateDumpDir)
+	})
+}
+
 func verifyArgsInvalid(t *testing.T, messageContains string, cliArgs []string) {
 	_, _, err := runWithArgs(cliArgs)
 	require.ErrorContains(t, err, messageContains)
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -59,10 +60,15 @@ const DefaultCannonSnapshotFreq = uint(10_000)
 // This also contains config options for auxiliary services.
 // It is used to initialize the challenger.
 type Config struct {
-	L1EthRpc                string         // L1 RPC Url
-	GameAddress             common.Address // Address of the fault game
-	AgreeWithProposedOutput bool           // Temporary config if we agree or disagree with the posted output
-	GameDepth               int            // Depth of the game tree
+	L1EthRpc                string           // L1 RPC Url
+	GameAddress             common.Address   // Address of the fault game
+	AdditionalGames         []common.Address // Further games to monitor with the same configuration
+	GameFactoryAddress      common.Address   // Address of the dispute game factory to discover games from, if set
+	GameTypes               []uint8          // Only monitor games of these types, or games of any type if empty
+	AgreeWithProposedOutput bool             // Temporary config if we agree or disagree with the posted output
+	GameDepth               int              // Depth of the game tree
+	SkipFinalizedOutputs    bool             // Skip games disputing an output that has already finalized
+	StateDumpDir            string           // Directory to write the claims loaded for each game to, for debugging. Disabled if empty
 
 	TraceType TraceType // Type of trace
 
@@ -78,6 +84,10 @@ type Config struct {
 	CannonSnapshotFreq     uint   // Frequency of snapshots to create when executing cannon (in VM instructions)
 
 	TxMgrConfig txmgr.CLIConfig
+
+	// Optional
+	TraceProviderWrapper func(provider types.TraceProvider) types.TraceProvider // Wraps the trace provider, allowing tests to inject faults
+	CannonFixtureDir     string                                                 // Pre-generated cannon trace to read proofs from instead of executing cannon, for tests
 }
 
 func NewConfig(
@@ -106,13 +116,23 @@ func (c Config) Check() error {
 	if c.L1EthRpc == "" {
 		return ErrMissingL1EthRPC
 	}
-	if c.GameAddress == (common.Address{}) {
+	if c.GameAddress == (common.Address{}) && c.GameFactoryAddress == (common.Address{}) {
 		return ErrMissingGameAddress
 	}
+	for _, addr := range c.AdditionalGames {
+		if addr == (common.Address{}) {
+			return ErrMissingGameAddress
+		}
+	}
 	if c.TraceType == "" {
 		return ErrMissingTraceType
 	}
-	if c.TraceType == TraceTypeCannon {
+	if c.TraceType == TraceTypeCannon && c.CannonFixtureDir != "" {
+		// Proofs are read from the fixture so cannon is never executed.
+		if c.CannonDatadir == "" {
+			return ErrMissingCannonDatadir
+		}
+	} else if c.TraceType == TraceTypeCannon {
 		if c.CannonBin == "" {
 			return ErrMissingCannonBin
 		}
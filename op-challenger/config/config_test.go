@@ -67,6 +67,19 @@ func TestGameAddressRequired(t *testing.T) {
 	require.ErrorIs(t, config.Check(), ErrMissingGameAddress)
 }
 
+func TestGameAddressNotRequiredWithGameFactory(t *testing.T) {
+	config := validConfig(TraceTypeCannon)
+	config.GameAddress = common.Address{}
+	config.GameFactoryAddress = common.Address{0xdd}
+	require.NoError(t, config.Check())
+}
+
+func TestAdditionalGamesMustBeSet(t *testing.T) {
+	config := validConfig(TraceTypeAlphabet)
+	config.AdditionalGames = []common.Address{{0xbb}, {}}
+	require.ErrorIs(t, config.Check(), ErrMissingGameAddress)
+}
+
 func TestAlphabetTraceRequired(t *testing.T) {
 	config := validConfig(TraceTypeAlphabet)
 	config.AlphabetTrace = ""
@@ -110,3 +123,23 @@ func TestCannonSnapshotFreq(t *testing.T) {
 		require.ErrorIs(t, cfg.Check(), ErrMissingCannonSnapshotFreq)
 	})
 }
+
+func TestCannonFixture(t *testing.T) {
+	t.Run("ExecutionConfigNotRequired", func(t *testing.T) {
+		cfg := validConfig(TraceTypeCannon)
+		cfg.CannonFixtureDir = "./fixture"
+		cfg.CannonBin = ""
+		cfg.CannonServer = ""
+		cfg.CannonAbsolutePreState = ""
+		cfg.CannonL2 = ""
+		cfg.CannonSnapshotFreq = 0
+		require.NoError(t, cfg.Check())
+	})
+
+	t.Run("DatadirRequired", func(t *testing.T) {
+		cfg := validConfig(TraceTypeCannon)
+		cfg.CannonFixtureDir = "./fixture"
+		cfg.CannonDatadir = ""
+		require.ErrorIs(t, cfg.Check(), ErrMissingCannonDatadir)
+	})
+}
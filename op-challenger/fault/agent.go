@@ -53,13 +53,13 @@ func (a *Agent) Act(ctx context.Context) error {
 	// Create counter claims
 	for _, claim := range game.Claims() {
 		if err := a.move(ctx, claim, game); err != nil && !errors.Is(err, types.ErrGameDepthReached) {
-			log.Error("Failed to move", "err", err)
+			a.log.Error("Failed to move", "err", err)
 		}
 	}
 	// Step on all leaf claims
 	for _, claim := range game.Claims() {
 		if err := a.step(ctx, claim, game); err != nil {
-			log.Error("Failed to step", "err", err)
+			a.log.Error("Failed to step", "err", err)
 		}
 	}
 	return nil
@@ -95,7 +95,18 @@ func (e *Executor) GenerateProof(ctx context.Context, dir string, i uint64) erro
 		return fmt.Errorf("could not create proofs directory %v: %w", proofDir, err)
 	}
 	e.logger.Info("Generating trace", "proof", i, "cmd", e.cannon, "args", args)
-	return e.cmdExecutor(ctx, e.logger.New("proof", i), e.cannon, args...)
+	if err := e.cmdExecutor(ctx, e.logger.New("proof", i), e.cannon, args...); err != nil {
+		// Cannon may have been interrupted while writing the proof, for example because the L2 node became
+		// unavailable. Remove any incomplete proof so it is generated again rather than failing to decode forever.
+		proofPath := filepath.Join(proofDir, fmt.Sprintf("%d.json", i))
+		if rmErr := os.Remove(proofPath); rmErr == nil {
+			e.logger.Warn("Removed proof left by failed trace generation", "proof", i, "path", proofPath)
+		} else if !errors.Is(rmErr, os.ErrNotExist) {
+			e.logger.Error("Failed to remove proof left by failed trace generation", "proof", i, "path", proofPath, "err", rmErr)
+		}
+		return err
+	}
+	return nil
 }
 
 func runCmd(ctx context.Context, l log.Logger, binary string, args ...string) error {
@@ -2,6 +2,7 @@ package cannon
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"os"
@@ -18,6 +19,24 @@ import (
 
 const execTestCannonPrestate = "/foo/pre.json"
 
+func TestGenerateProofRemovesIncompleteProof(t *testing.T) {
+	cfg := config.NewConfig("http://localhost:8888", common.Address{0xaa}, config.TraceTypeCannon, true, 5)
+	cfg.CannonDatadir = t.TempDir()
+	executor := NewExecutor(testlog.Logger(t, log.LvlInfo), &cfg, localGameInputs{l2BlockNumber: big.NewInt(3333)})
+	executor.selectSnapshot = func(logger log.Logger, dir string, absolutePreState string, i uint64) (string, error) {
+		return "starting.json", nil
+	}
+	proofPath := filepath.Join(cfg.CannonDatadir, proofsDir, "9.json")
+	cmdErr := errors.New("interrupted")
+	executor.cmdExecutor = func(ctx context.Context, l log.Logger, b string, a ...string) error {
+		require.NoError(t, os.WriteFile(proofPath, []byte(`{"post": "0x45fd`), 0o644))
+		return cmdErr
+	}
+	err := executor.GenerateProof(context.Background(), cfg.CannonDatadir, 9)
+	require.ErrorIs(t, err, cmdErr)
+	require.NoFileExists(t, proofPath, "should remove the incomplete proof")
+}
+
 func TestGenerateProof(t *testing.T) {
 	input := "starting.json"
 	cfg := config.NewConfig("http://localhost:8888", common.Address{0xaa}, config.TraceTypeCannon, true, 5)
@@ -0,0 +1,126 @@
+package cannon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	fixturePrestate = "prestate.json"
+	fixtureFinal    = "final.json"
+)
+
+// Fixture is a pre-generated cannon trace for a program, allowing the trace to be used without executing cannon.
+// A fixture directory contains the program's initial state in prestate.json, its state after exiting in final.json
+// and the proof for every step of the execution in proofs/<step>.json, as written by cannon run with --proof-at '%1'.
+type Fixture struct {
+	Prestate *mipsevm.State
+	Final    *mipsevm.State
+}
+
+// LoadFixture loads the prestate and final state of the fixture in dir.
+func LoadFixture(dir string) (*Fixture, error) {
+	prestate, err := loadState(filepath.Join(dir, fixturePrestate))
+	if err != nil {
+		return nil, err
+	}
+	final, err := loadState(filepath.Join(dir, fixtureFinal))
+	if err != nil {
+		return nil, err
+	}
+	if !final.Exited {
+		return nil, fmt.Errorf("fixture final state in %v has not exited", dir)
+	}
+	return &Fixture{Prestate: prestate, Final: final}, nil
+}
+
+// NewFixtureTraceProvider creates a trace provider that reads proofs from the fixture in cfg.CannonFixtureDir instead
+// of executing cannon. Proofs are copied into cfg.CannonDatadir as they are used so the fixture is never modified.
+func NewFixtureTraceProvider(cfg *config.Config) (*CannonTraceProvider, error) {
+	fixture, err := LoadFixture(cfg.CannonFixtureDir)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Join(cfg.CannonDatadir, proofsDir), 0755); err != nil {
+		return nil, fmt.Errorf("create proofs dir: %w", err)
+	}
+	if err := copyFile(filepath.Join(cfg.CannonFixtureDir, fixturePrestate), filepath.Join(cfg.CannonDatadir, fixturePrestate)); err != nil {
+		return nil, fmt.Errorf("copy fixture prestate: %w", err)
+	}
+	return &CannonTraceProvider{
+		dir:       cfg.CannonDatadir,
+		prestate:  fixturePrestate,
+		generator: &fixtureGenerator{dir: cfg.CannonFixtureDir, final: fixture.Final},
+	}, nil
+}
+
+// fixtureGenerator provides proofs from a fixture rather than executing cannon.
+type fixtureGenerator struct {
+	dir   string
+	final *mipsevm.State
+}
+
+func (g *fixtureGenerator) GenerateProof(_ context.Context, dir string, i uint64) error {
+	dest := filepath.Join(dir, proofsDir, fmt.Sprintf("%d.json", i))
+	err := copyFile(filepath.Join(g.dir, proofsDir, fmt.Sprintf("%d.json", i)), dest)
+	if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if i < g.final.Step {
+		return fmt.Errorf("fixture %v has no proof for step %v", g.dir, i)
+	}
+	// The program has exited so every later step leaves the state unchanged.
+	proof, err := exitedProof(g.final)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(proof)
+	if err != nil {
+		return fmt.Errorf("encode proof for step %v: %w", i, err)
+	}
+	return os.WriteFile(dest, data, 0644)
+}
+
+// exitedProof creates the proof for a step from the exited state final, which results in the same state.
+func exitedProof(final *mipsevm.State) (*proofData, error) {
+	state := *final
+	witness, err := mipsevm.NewInstrumentedState(&state, nil, io.Discard, io.Discard).Step(true)
+	if err != nil {
+		return nil, fmt.Errorf("step exited state: %w", err)
+	}
+	return &proofData{
+		ClaimValue: crypto.Keccak256(state.EncodeWitness()),
+		StateData:  witness.State,
+		ProofData:  witness.MemProof,
+	}, nil
+}
+
+func loadState(path string) (*mipsevm.State, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open state file (%v): %w", path, err)
+	}
+	defer file.Close()
+	var state mipsevm.State
+	if err := json.NewDecoder(file).Decode(&state); err != nil {
+		return nil, fmt.Errorf("invalid mipsevm state (%v): %w", path, err)
+	}
+	return &state, nil
+}
+
+func copyFile(src string, dest string) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dest, data, 0644)
+}
@@ -0,0 +1,77 @@
+package cannon
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// e2eFixtureDir is the fixture used by the op-e2e cannon fixture game, which runs a program that exits after 3 steps.
+var e2eFixtureDir = filepath.Join("..", "..", "..", "op-e2e", "testdata", "cannon-fixture")
+
+func TestFixtureTraceProvider(t *testing.T) {
+	fixture, err := LoadFixture(e2eFixtureDir)
+	require.NoError(t, err)
+	finalHash := crypto.Keccak256Hash(fixture.Final.EncodeWitness())
+
+	newProvider := func(t *testing.T, fixtureDir string) *CannonTraceProvider {
+		provider, err := NewFixtureTraceProvider(&config.Config{CannonFixtureDir: fixtureDir, CannonDatadir: t.TempDir()})
+		require.NoError(t, err)
+		return provider
+	}
+
+	t.Run("AbsolutePreState", func(t *testing.T) {
+		provider := newProvider(t, e2eFixtureDir)
+		prestate, err := provider.AbsolutePreState(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, fixture.Prestate.EncodeWitness(), prestate)
+	})
+
+	t.Run("ProofFromFixture", func(t *testing.T) {
+		provider := newProvider(t, e2eFixtureDir)
+		value, err := provider.Get(context.Background(), 0)
+		require.NoError(t, err)
+		// Read directly from the fixture, no proofs can be generated
+		proof, err := (&CannonTraceProvider{dir: e2eFixtureDir}).loadProof(context.Background(), 0)
+		require.NoError(t, err)
+		require.Equal(t, common.BytesToHash(proof.ClaimValue), value)
+	})
+
+	t.Run("LastStepIsFinalState", func(t *testing.T) {
+		provider := newProvider(t, e2eFixtureDir)
+		value, err := provider.Get(context.Background(), fixture.Final.Step-1)
+		require.NoError(t, err)
+		require.Equal(t, finalHash, value)
+	})
+
+	t.Run("StepsAfterExit", func(t *testing.T) {
+		provider := newProvider(t, e2eFixtureDir)
+		value, err := provider.Get(context.Background(), 1000)
+		require.NoError(t, err)
+		require.Equal(t, finalHash, value)
+
+		state, proof, err := provider.GetPreimage(context.Background(), 1000)
+		require.NoError(t, err)
+		require.Equal(t, fixture.Final.EncodeWitness(), state)
+		require.NotEmpty(t, proof)
+
+		_, err = os.Stat(filepath.Join(e2eFixtureDir, proofsDir, "1000.json"))
+		require.ErrorIs(t, err, os.ErrNotExist, "should not modify the fixture")
+	})
+
+	t.Run("MissingProof", func(t *testing.T) {
+		dir := t.TempDir()
+		for _, name := range []string{fixturePrestate, fixtureFinal} {
+			require.NoError(t, copyFile(filepath.Join(e2eFixtureDir, name), filepath.Join(dir, name)))
+		}
+		provider := newProvider(t, dir)
+		_, err := provider.Get(context.Background(), 1)
+		require.ErrorContains(t, err, "has no proof for step 1")
+	})
+}
@@ -2,8 +2,11 @@ package cannon
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"os"
+	"path/filepath"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -19,6 +22,18 @@ type localGameInputs struct {
 	l2BlockNumber *big.Int
 }
 
+// InputsFile is the name of the file in the cannon data dir that records the local inputs cannon is run with.
+const InputsFile = "inputs.json"
+
+// Inputs are the local game inputs passed to cannon, in the format recorded in InputsFile.
+type Inputs struct {
+	L1Head        common.Hash `json:"l1Head"`
+	L2Head        common.Hash `json:"l2Head"`
+	L2OutputRoot  common.Hash `json:"l2OutputRoot"`
+	L2Claim       common.Hash `json:"l2Claim"`
+	L2BlockNumber *big.Int    `json:"l2BlockNumber"`
+}
+
 type L2DataSource interface {
 	ChainID(context.Context) (*big.Int, error)
 	HeaderByNumber(context.Context, *big.Int) (*ethtypes.Header, error)
@@ -59,3 +74,39 @@ func fetchLocalInputs(ctx context.Context, gameAddr common.Address, caller GameI
 		l2BlockNumber: claimedOutput.L2BlockNumber,
 	}, nil
 }
+
+// writeInputs records inputs in InputsFile in dir so the inputs cannon was run with can be inspected later.
+func writeInputs(dir string, inputs localGameInputs) error {
+	data, err := json.MarshalIndent(Inputs{
+		L1Head:        inputs.l1Head,
+		L2Head:        inputs.l2Head,
+		L2OutputRoot:  inputs.l2OutputRoot,
+		L2Claim:       inputs.l2Claim,
+		L2BlockNumber: inputs.l2BlockNumber,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode inputs: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("could not create data directory %v: %w", dir, err)
+	}
+	path := filepath.Join(dir, InputsFile)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("write inputs file %v: %w", path, err)
+	}
+	return nil
+}
+
+// ReadInputs reads the local game inputs recorded in InputsFile in the cannon data dir.
+func ReadInputs(dir string) (Inputs, error) {
+	path := filepath.Join(dir, InputsFile)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Inputs{}, fmt.Errorf("read inputs file %v: %w", path, err)
+	}
+	var inputs Inputs
+	if err := json.Unmarshal(data, &inputs); err != nil {
+		return Inputs{}, fmt.Errorf("decode inputs file %v: %w", path, err)
+	}
+	return inputs, nil
+}
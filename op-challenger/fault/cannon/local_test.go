@@ -3,6 +3,8 @@ package cannon
 import (
 	"context"
 	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
@@ -46,6 +48,31 @@ func TestFetchLocalInputs(t *testing.T) {
 	require.Equal(t, l1Client.disputed.L2BlockNumber, inputs.l2BlockNumber)
 }
 
+func TestRecordInputs(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cannon")
+	inputs := localGameInputs{
+		l1Head:        common.Hash{0xaa},
+		l2Head:        common.Hash{0xbb},
+		l2OutputRoot:  common.Hash{0xcc},
+		l2Claim:       common.Hash{0xdd},
+		l2BlockNumber: big.NewInt(3333),
+	}
+	require.NoError(t, writeInputs(dir, inputs))
+
+	recorded, err := ReadInputs(dir)
+	require.NoError(t, err)
+	require.Equal(t, Inputs{
+		L1Head:        inputs.l1Head,
+		L2Head:        inputs.l2Head,
+		L2OutputRoot:  inputs.l2OutputRoot,
+		L2Claim:       inputs.l2Claim,
+		L2BlockNumber: inputs.l2BlockNumber,
+	}, recorded)
+
+	_, err = ReadInputs(t.TempDir())
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
 type mockGameInputsSource struct {
 	l1Head   common.Hash
 	starting bindings.IFaultDisputeGameOutputProposal
@@ -53,14 +53,17 @@ func NewTraceProvider(ctx context.Context, logger log.Logger, cfg *config.Config
 	if err != nil {
 		return nil, fmt.Errorf("create caller for game %v: %w", cfg.GameAddress, err)
 	}
-	l1Head, err := fetchLocalInputs(ctx, cfg.GameAddress, gameCaller, l2Client)
+	localInputs, err := fetchLocalInputs(ctx, cfg.GameAddress, gameCaller, l2Client)
 	if err != nil {
 		return nil, fmt.Errorf("fetch local game inputs: %w", err)
 	}
+	if err := writeInputs(cfg.CannonDatadir, localInputs); err != nil {
+		return nil, fmt.Errorf("record local game inputs: %w", err)
+	}
 	return &CannonTraceProvider{
 		dir:       cfg.CannonDatadir,
 		prestate:  cfg.CannonAbsolutePreState,
-		generator: NewExecutor(logger, cfg, l1Head),
+		generator: NewExecutor(logger, cfg, localInputs),
 	}, nil
 }
 
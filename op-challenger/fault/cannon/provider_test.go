@@ -40,6 +40,17 @@ func TestGet(t *testing.T) {
 		require.Empty(t, generator.generated)
 	})
 
+	t.Run("CorruptProof", func(t *testing.T) {
+		provider, generator := setupWithTestData(dataDir, prestate)
+		path := filepath.Join(dataDir, proofsDir, "9.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"post": "0x45fd`), 0o644))
+		_, err := provider.Get(context.Background(), 9)
+		require.ErrorContains(t, err, "failed to read proof")
+		require.ErrorContains(t, err, path)
+		require.FileExists(t, path, "should keep the corrupt proof")
+		require.Empty(t, generator.generated)
+	})
+
 	t.Run("IgnoreUnknownFields", func(t *testing.T) {
 		provider, generator := setupWithTestData(dataDir, prestate)
 		value, err := provider.Get(context.Background(), 2)
@@ -0,0 +1,77 @@
+package fault
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// gameFactory is the part of the dispute game factory needed to discover the games it has created.
+type gameFactory interface {
+	GameCount(opts *bind.CallOpts) (*big.Int, error)
+	GameAtIndex(opts *bind.CallOpts, index *big.Int) (struct {
+		Proxy     common.Address
+		Timestamp *big.Int
+	}, error)
+}
+
+// factoryGameSource discovers the games created by a dispute game factory, in the order they were created.
+type factoryGameSource struct {
+	logger    log.Logger
+	factory   gameFactory
+	gameTypes []uint8
+	gameType  func(ctx context.Context, addr common.Address) (uint8, error)
+	newGame   func(ctx context.Context, addr common.Address) (*monitoredGame, error)
+
+	// known are the games already monitored, which are skipped when found in the factory.
+	known map[common.Address]bool
+	// next is the index of the first game in the factory that hasn't been checked.
+	next uint64
+}
+
+// NewGames returns the games created by the factory since it was last called, other than known games, games of
+// types not in gameTypes and, if enabled, games disputing an output that has already finalized. The first call
+// returns every game the factory has created.
+// If a game can't be loaded, the games found before it are returned with the error and it is retried on the next call.
+func (s *factoryGameSource) NewGames(ctx context.Context) ([]*monitoredGame, error) {
+	games, err := s.loadNewGames(ctx)
+	return skipFinalizedGames(ctx, games), err
+}
+
+func (s *factoryGameSource) loadNewGames(ctx context.Context) ([]*monitoredGame, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	count, err := s.factory.GameCount(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load game count: %w", err)
+	}
+	var games []*monitoredGame
+	for ; s.next < count.Uint64(); s.next++ {
+		info, err := s.factory.GameAtIndex(opts, new(big.Int).SetUint64(s.next))
+		if err != nil {
+			return games, fmt.Errorf("failed to load game %v: %w", s.next, err)
+		}
+		if s.known[info.Proxy] {
+			continue
+		}
+		if len(s.gameTypes) > 0 {
+			gameType, err := s.gameType(ctx, info.Proxy)
+			if err != nil {
+				return games, fmt.Errorf("game %v: %w", info.Proxy, err)
+			}
+			if !containsGameType(s.gameTypes, gameType) {
+				s.logger.Info("Skipping game with unmonitored game type", "game", info.Proxy, "gameType", gameType)
+				continue
+			}
+		}
+		game, err := s.newGame(ctx, info.Proxy)
+		if err != nil {
+			return games, fmt.Errorf("game %v: %w", info.Proxy, err)
+		}
+		games = append(games, game)
+	}
+	return games, nil
+}
@@ -0,0 +1,141 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFactoryGameSource(t *testing.T) {
+	gameA := common.Address{0xaa}
+	gameB := common.Address{0xbb}
+	gameC := common.Address{0xcc}
+
+	t.Run("ReturnsExistingGames", func(t *testing.T) {
+		source, factory := setupFactoryGameSource(t)
+		factory.games = []common.Address{gameA, gameB}
+		games, err := source.NewGames(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []common.Address{gameA, gameB}, gameAddrs(games))
+	})
+
+	t.Run("OnlyReturnsNewGames", func(t *testing.T) {
+		source, factory := setupFactoryGameSource(t)
+		factory.games = []common.Address{gameA}
+		_, err := source.NewGames(context.Background())
+		require.NoError(t, err)
+
+		games, err := source.NewGames(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, games)
+
+		factory.games = append(factory.games, gameB)
+		games, err = source.NewGames(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []common.Address{gameB}, gameAddrs(games))
+	})
+
+	t.Run("SkipsKnownGames", func(t *testing.T) {
+		source, factory := setupFactoryGameSource(t)
+		source.known = map[common.Address]bool{gameA: true}
+		factory.games = []common.Address{gameA, gameB}
+		games, err := source.NewGames(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []common.Address{gameB}, gameAddrs(games))
+	})
+
+	t.Run("FiltersGameTypes", func(t *testing.T) {
+		source, factory := setupFactoryGameSource(t)
+		source.gameTypes = []uint8{1}
+		gameTypes := map[common.Address]uint8{gameA: 0, gameB: 1, gameC: 0}
+		source.gameType = func(_ context.Context, addr common.Address) (uint8, error) {
+			return gameTypes[addr], nil
+		}
+		factory.games = []common.Address{gameA, gameB, gameC}
+		games, err := source.NewGames(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []common.Address{gameB}, gameAddrs(games))
+	})
+
+	t.Run("RetriesGameThatFailedToLoad", func(t *testing.T) {
+		source, factory := setupFactoryGameSource(t)
+		loadErr := errors.New("boom")
+		failing := true
+		newGame := source.newGame
+		source.newGame = func(ctx context.Context, addr common.Address) (*monitoredGame, error) {
+			if addr == gameB && failing {
+				return nil, loadErr
+			}
+			return newGame(ctx, addr)
+		}
+		factory.games = []common.Address{gameA, gameB, gameC}
+		games, err := source.NewGames(context.Background())
+		require.ErrorIs(t, err, loadErr)
+		require.Equal(t, []common.Address{gameA}, gameAddrs(games))
+
+		failing = false
+		games, err = source.NewGames(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []common.Address{gameB, gameC}, gameAddrs(games))
+	})
+
+	t.Run("GameCountFails", func(t *testing.T) {
+		source, factory := setupFactoryGameSource(t)
+		factory.err = errors.New("boom")
+		_, err := source.NewGames(context.Background())
+		require.ErrorIs(t, err, factory.err)
+	})
+}
+
+func setupFactoryGameSource(t *testing.T) (*factoryGameSource, *stubGameFactory) {
+	logger := testlog.Logger(t, log.LvlDebug)
+	factory := &stubGameFactory{}
+	source := &factoryGameSource{
+		logger:  logger,
+		factory: factory,
+		gameType: func(_ context.Context, _ common.Address) (uint8, error) {
+			return 0, nil
+		},
+		newGame: func(_ context.Context, addr common.Address) (*monitoredGame, error) {
+			return &monitoredGame{logger: logger.New("game", addr), caller: &stubGameInfo{}, actor: &stubActor{}, addr: addr}, nil
+		},
+	}
+	return source, factory
+}
+
+func gameAddrs(games []*monitoredGame) []common.Address {
+	var addrs []common.Address
+	for _, game := range games {
+		addrs = append(addrs, game.addr)
+	}
+	return addrs
+}
+
+type stubGameFactory struct {
+	games []common.Address
+	err   error
+}
+
+func (f *stubGameFactory) GameCount(_ *bind.CallOpts) (*big.Int, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return big.NewInt(int64(len(f.games))), nil
+}
+
+func (f *stubGameFactory) GameAtIndex(_ *bind.CallOpts, index *big.Int) (struct {
+	Proxy     common.Address
+	Timestamp *big.Int
+}, error) {
+	return struct {
+		Proxy     common.Address
+		Timestamp *big.Int
+	}{Proxy: f.games[index.Uint64()], Timestamp: big.NewInt(0)}, nil
+}
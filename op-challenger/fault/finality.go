@@ -0,0 +1,90 @@
+package fault
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// ProposalsFetcher is a minimal interface around [bindings.FaultDisputeGameCaller] to load the disputed output.
+type ProposalsFetcher interface {
+	Proposals(opts *bind.CallOpts) (struct {
+		Starting bindings.IFaultDisputeGameOutputProposal
+		Disputed bindings.IFaultDisputeGameOutputProposal
+	}, error)
+}
+
+// OutputOracleCaller is a minimal interface around [bindings.L2OutputOracleCaller].
+type OutputOracleCaller interface {
+	GetL2Output(opts *bind.CallOpts, _l2OutputIndex *big.Int) (bindings.TypesOutputProposal, error)
+	FINALIZATIONPERIODSECONDS(opts *bind.CallOpts) (*big.Int, error)
+}
+
+// L1HeaderSource provides the latest L1 header.
+type L1HeaderSource interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error)
+}
+
+// FinalityChecker determines if the output disputed by a game has already passed the finalization period, in which
+// case withdrawals may have already been proven against it and disputing it is pointless.
+type FinalityChecker struct {
+	proposals ProposalsFetcher
+	oracle    OutputOracleCaller
+	l1        L1HeaderSource
+}
+
+func NewFinalityChecker(proposals ProposalsFetcher, oracle OutputOracleCaller, l1 L1HeaderSource) *FinalityChecker {
+	return &FinalityChecker{
+		proposals: proposals,
+		oracle:    oracle,
+		l1:        l1,
+	}
+}
+
+// NewFinalityCheckerFromBindings creates a FinalityChecker for the game at fdgAddr, using the L2OutputOracle the game
+// reads proposals from.
+func NewFinalityCheckerFromBindings(ctx context.Context, fdgAddr common.Address, client *ethclient.Client) (*FinalityChecker, error) {
+	game, err := bindings.NewFaultDisputeGameCaller(fdgAddr, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind the fault dispute game contract: %w", err)
+	}
+	oracleAddr, err := game.L2OUTPUTORACLE(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the L2 output oracle address: %w", err)
+	}
+	oracle, err := bindings.NewL2OutputOracleCaller(oracleAddr, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind the L2 output oracle contract: %w", err)
+	}
+	return NewFinalityChecker(game, oracle, client), nil
+}
+
+// IsDisputedOutputFinalized returns true if the output disputed by the game has finalized.
+func (f *FinalityChecker) IsDisputedOutputFinalized(ctx context.Context) (bool, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	proposals, err := f.proposals.Proposals(opts)
+	if err != nil {
+		return false, fmt.Errorf("failed to load proposals: %w", err)
+	}
+	output, err := f.oracle.GetL2Output(opts, proposals.Disputed.Index)
+	if err != nil {
+		return false, fmt.Errorf("failed to load disputed output %v: %w", proposals.Disputed.Index, err)
+	}
+	period, err := f.oracle.FINALIZATIONPERIODSECONDS(opts)
+	if err != nil {
+		return false, fmt.Errorf("failed to load finalization period: %w", err)
+	}
+	head, err := f.l1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to load latest L1 header: %w", err)
+	}
+	// Matches the OptimismPortal's check for whether an output has finalized.
+	finalizedAt := new(big.Int).Add(output.Timestamp, period)
+	return new(big.Int).SetUint64(head.Time).Cmp(finalizedAt) > 0, nil
+}
@@ -0,0 +1,94 @@
+package fault
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+type stubProposalsFetcher struct {
+	disputedIndex *big.Int
+	err           error
+}
+
+func (s *stubProposalsFetcher) Proposals(_ *bind.CallOpts) (struct {
+	Starting bindings.IFaultDisputeGameOutputProposal
+	Disputed bindings.IFaultDisputeGameOutputProposal
+}, error) {
+	var result struct {
+		Starting bindings.IFaultDisputeGameOutputProposal
+		Disputed bindings.IFaultDisputeGameOutputProposal
+	}
+	result.Disputed.Index = s.disputedIndex
+	return result, s.err
+}
+
+type stubOutputOracle struct {
+	outputs map[uint64]bindings.TypesOutputProposal
+	period  *big.Int
+	err     error
+}
+
+func (s *stubOutputOracle) GetL2Output(_ *bind.CallOpts, index *big.Int) (bindings.TypesOutputProposal, error) {
+	return s.outputs[index.Uint64()], s.err
+}
+
+func (s *stubOutputOracle) FINALIZATIONPERIODSECONDS(_ *bind.CallOpts) (*big.Int, error) {
+	return s.period, s.err
+}
+
+type stubL1HeaderSource struct {
+	time uint64
+}
+
+func (s *stubL1HeaderSource) HeaderByNumber(_ context.Context, _ *big.Int) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Time: s.time}, nil
+}
+
+func TestIsDisputedOutputFinalized(t *testing.T) {
+	proposalTime := uint64(1000)
+	period := uint64(100)
+	tests := []struct {
+		name      string
+		l1Time    uint64
+		finalized bool
+	}{
+		{name: "BeforePeriodEnds", l1Time: proposalTime + period - 1, finalized: false},
+		{name: "AtPeriodEnd", l1Time: proposalTime + period, finalized: false},
+		{name: "AfterPeriodEnds", l1Time: proposalTime + period + 1, finalized: true},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			oracle := &stubOutputOracle{
+				outputs: map[uint64]bindings.TypesOutputProposal{
+					3: {Timestamp: new(big.Int).SetUint64(proposalTime)},
+				},
+				period: new(big.Int).SetUint64(period),
+			}
+			checker := NewFinalityChecker(&stubProposalsFetcher{disputedIndex: big.NewInt(3)}, oracle, &stubL1HeaderSource{time: test.l1Time})
+			finalized, err := checker.IsDisputedOutputFinalized(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, test.finalized, finalized)
+		})
+	}
+}
+
+func TestIsDisputedOutputFinalized_Errors(t *testing.T) {
+	l1 := &stubL1HeaderSource{time: 5000}
+	t.Run("Proposals", func(t *testing.T) {
+		checker := NewFinalityChecker(&stubProposalsFetcher{err: errMock}, &stubOutputOracle{}, l1)
+		_, err := checker.IsDisputedOutputFinalized(context.Background())
+		require.ErrorIs(t, err, errMock)
+	})
+	t.Run("Oracle", func(t *testing.T) {
+		checker := NewFinalityChecker(&stubProposalsFetcher{disputedIndex: big.NewInt(1)}, &stubOutputOracle{err: errMock}, l1)
+		_, err := checker.IsDisputedOutputFinalized(context.Background())
+		require.ErrorIs(t, err, errMock)
+	})
+}
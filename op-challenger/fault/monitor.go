@@ -2,9 +2,11 @@ package fault
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -17,13 +19,56 @@ type Actor interface {
 	Act(ctx context.Context) error
 }
 
-func MonitorGame(ctx context.Context, logger log.Logger, agreeWithProposedOutput bool, actor Actor, caller GameInfo) error {
-	logger.Info("Monitoring fault dispute game", "agreeWithOutput", agreeWithProposedOutput)
+// monitoredGame is a game progressed by monitorGames.
+type monitoredGame struct {
+	addr                    common.Address
+	logger                  log.Logger
+	agreeWithProposedOutput bool
+	actor                   Actor
+	caller                  GameInfo
+	finality                *FinalityChecker
+	expiry                  uint64 // Unix timestamp by which the game's clocks will have expired
+}
+
+// gameSource provides games to monitor as they are found.
+type gameSource interface {
+	// NewGames returns the games found since it was last called.
+	NewGames(ctx context.Context) ([]*monitoredGame, error)
+}
+
+// monitorGames progresses each of games until they are all complete.
+// If source is not nil, games it provides are added as they are found and monitoring continues until ctx is done.
+// Games are acted on in order of expiry so that when responses are delayed, such as when the challenger first
+// starts, the games closest to their clocks expiring are responded to first.
+func monitorGames(ctx context.Context, logger log.Logger, games []*monitoredGame, source gameSource) error {
+	logger.Info("Monitoring fault dispute games", "count", len(games), "discovery", source != nil)
+	games = prioritizeGames(games)
+	for _, game := range games {
+		game.logger.Info("Monitoring fault dispute game", "agreeWithOutput", game.agreeWithProposedOutput, "expiry", game.expiry)
+	}
 
-	for {
-		done := progressGame(ctx, logger, agreeWithProposedOutput, actor, caller)
-		if done {
-			return nil
+	for len(games) > 0 || source != nil {
+		if source != nil {
+			found, err := source.NewGames(ctx)
+			if err != nil {
+				logger.Warn("Unable to load new games", "err", err)
+			}
+			for _, game := range found {
+				game.logger.Info("Monitoring new fault dispute game", "agreeWithOutput", game.agreeWithProposedOutput, "expiry", game.expiry)
+			}
+			if len(found) > 0 {
+				games = prioritizeGames(append(games, found...))
+			}
+		}
+		var remaining []*monitoredGame
+		for _, game := range games {
+			if !progressGame(ctx, game.logger, game.agreeWithProposedOutput, game.actor, game.caller) {
+				remaining = append(remaining, game)
+			}
+		}
+		games = remaining
+		if len(games) == 0 && source == nil {
+			break
 		}
 		select {
 		case <-time.After(300 * time.Millisecond):
@@ -32,6 +77,18 @@ func MonitorGame(ctx context.Context, logger log.Logger, agreeWithProposedOutput
 			return ctx.Err()
 		}
 	}
+	return nil
+}
+
+// prioritizeGames returns a copy of games ordered by expiry, earliest first.
+// Games with the same expiry retain their original order.
+func prioritizeGames(games []*monitoredGame) []*monitoredGame {
+	sorted := make([]*monitoredGame, len(games))
+	copy(sorted, games)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].expiry < sorted[j].expiry
+	})
+	return sorted
 }
 
 // progressGame checks the current state of the game, and attempts to progress it by performing moves, steps or resolving
@@ -13,14 +13,102 @@ import (
 
 func TestMonitorExitsWhenContextDone(t *testing.T) {
 	logger := testlog.Logger(t, log.LvlDebug)
-	actor := &stubActor{}
-	gameInfo := &stubGameInfo{}
+	games := []*monitoredGame{{logger: logger, agreeWithProposedOutput: true, actor: &stubActor{}, caller: &stubGameInfo{}}}
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
-	err := MonitorGame(ctx, logger, true, actor, gameInfo)
+	err := monitorGames(ctx, logger, games, nil)
 	require.ErrorIs(t, err, context.Canceled)
 }
 
+func TestMonitorGamesActsOnGamesClosestToExpiryFirst(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlDebug)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var order []uint64
+	newGame := func(expiry uint64) *monitoredGame {
+		return &monitoredGame{
+			logger: logger,
+			actor: &stubActor{onAct: func() {
+				order = append(order, expiry)
+				if len(order) == 3 {
+					cancel()
+				}
+			}},
+			caller: &stubGameInfo{},
+			expiry: expiry,
+		}
+	}
+	games := []*monitoredGame{newGame(30), newGame(10), newGame(20)}
+	err := monitorGames(ctx, logger, games, nil)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []uint64{10, 20, 30}, order)
+}
+
+func TestMonitorGamesExitsWhenAllGamesComplete(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlDebug)
+	inProgress := &stubGameInfo{}
+	complete := &stubGameInfo{status: types.GameStatusChallengerWon}
+	completeActor := &stubActor{}
+	inProgressActor := &stubActor{}
+	games := []*monitoredGame{
+		{logger: logger, actor: completeActor, caller: complete, expiry: 10},
+		{logger: logger, actor: inProgressActor, caller: inProgress, expiry: 20},
+	}
+	// Resolve the second game once the first has been acted on twice to check completed games are not revisited.
+	inProgressActor.onAct = func() {
+		if inProgressActor.callCount == 2 {
+			inProgress.status = types.GameStatusDefenderWon
+		}
+	}
+	err := monitorGames(context.Background(), logger, games, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, completeActor.callCount)
+	require.Equal(t, 2, inProgressActor.callCount)
+}
+
+func TestMonitorGamesActsOnDiscoveredGames(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlDebug)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	discoveredActor := &stubActor{onAct: cancel}
+	source := &stubGameSource{
+		found: [][]*monitoredGame{
+			nil,
+			{{logger: logger, actor: discoveredActor, caller: &stubGameInfo{}}},
+		},
+	}
+	err := monitorGames(ctx, logger, nil, source)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, discoveredActor.callCount)
+	require.Equal(t, 2, source.calls)
+}
+
+func TestMonitorGamesKeepsRunningWithDiscovery(t *testing.T) {
+	logger := testlog.Logger(t, log.LvlDebug)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	complete := &stubGameInfo{status: types.GameStatusChallengerWon}
+	source := &stubGameSource{}
+	source.onCall = func() {
+		// Only stop once discovery continued after the only game completed.
+		if source.calls == 3 {
+			cancel()
+		}
+	}
+	games := []*monitoredGame{{logger: logger, actor: &stubActor{}, caller: complete}}
+	err := monitorGames(ctx, logger, games, source)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestPrioritizeGames(t *testing.T) {
+	a := &monitoredGame{expiry: 20}
+	b := &monitoredGame{expiry: 10}
+	c := &monitoredGame{expiry: 20}
+	games := []*monitoredGame{a, b, c}
+	require.Equal(t, []*monitoredGame{b, a, c}, prioritizeGames(games))
+	require.Equal(t, []*monitoredGame{a, b, c}, games, "should not modify the original slice")
+}
+
 func TestProgressGameAndLogState(t *testing.T) {
 	logger, _, actor, gameInfo := setupProgressGameTest(t)
 	done := progressGame(context.Background(), logger, true, actor, gameInfo)
@@ -113,10 +201,14 @@ func setupProgressGameTest(t *testing.T) (log.Logger, *testlog.CapturingHandler,
 type stubActor struct {
 	callCount int
 	err       error
+	onAct     func()
 }
 
 func (a *stubActor) Act(ctx context.Context) error {
 	a.callCount++
+	if a.onAct != nil {
+		a.onAct()
+	}
 	return a.err
 }
 
@@ -133,3 +225,21 @@ func (s *stubGameInfo) GetGameStatus(ctx context.Context) (types.GameStatus, err
 func (s *stubGameInfo) LogGameInfo(ctx context.Context) {
 	s.logCount++
 }
+
+type stubGameSource struct {
+	// found are the games returned by each call, after which no further games are found.
+	found  [][]*monitoredGame
+	calls  int
+	onCall func()
+}
+
+func (s *stubGameSource) NewGames(_ context.Context) ([]*monitoredGame, error) {
+	s.calls++
+	if s.onCall != nil {
+		s.onCall()
+	}
+	if s.calls > len(s.found) {
+		return nil, nil
+	}
+	return s.found[s.calls-1], nil
+}
@@ -3,6 +3,7 @@ package fault
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
@@ -11,6 +12,8 @@ import (
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr/metrics"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 )
@@ -23,10 +26,9 @@ type Service interface {
 }
 
 type service struct {
-	agent                   *Agent
-	agreeWithProposedOutput bool
-	caller                  *FaultCaller
-	logger                  log.Logger
+	games  []*monitoredGame
+	source gameSource
+	logger log.Logger
 }
 
 // NewService creates a new Service.
@@ -41,11 +43,129 @@ func NewService(ctx context.Context, logger log.Logger, cfg *config.Config) (*se
 		return nil, fmt.Errorf("failed to dial L1: %w", err)
 	}
 
+	games := []*monitoredGame{}
+	for _, gameCfg := range gameConfigs(cfg) {
+		if len(cfg.GameTypes) > 0 {
+			gameType, err := loadGameType(ctx, gameCfg.GameAddress, client)
+			if err != nil {
+				return nil, fmt.Errorf("game %v: %w", gameCfg.GameAddress, err)
+			}
+			if !containsGameType(cfg.GameTypes, gameType) {
+				logger.Info("Skipping game with unmonitored game type", "game", gameCfg.GameAddress, "gameType", gameType)
+				continue
+			}
+		}
+		game, err := newMonitoredGame(ctx, logger, gameCfg, client, txMgr)
+		if err != nil {
+			return nil, fmt.Errorf("game %v: %w", gameCfg.GameAddress, err)
+		}
+		games = append(games, game)
+	}
+	var source gameSource
+	if cfg.GameFactoryAddress != (common.Address{}) {
+		source, err = newFactoryGameSource(logger, cfg, client, txMgr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &service{
+		games:  games,
+		source: source,
+		logger: logger,
+	}, nil
+}
+
+// newFactoryGameSource creates a source of the games created by the factory at cfg.GameFactoryAddress. Each game is
+// monitored with cfg, keeping cannon data in its own directory, and games configured directly are skipped.
+func newFactoryGameSource(logger log.Logger, cfg *config.Config, client *ethclient.Client, txMgr txmgr.TxManager) (*factoryGameSource, error) {
+	factory, err := bindings.NewDisputeGameFactoryCaller(cfg.GameFactoryAddress, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind the dispute game factory contract: %w", err)
+	}
+	known := make(map[common.Address]bool)
+	for _, gameCfg := range gameConfigs(cfg) {
+		known[gameCfg.GameAddress] = true
+	}
+	return &factoryGameSource{
+		logger:    logger.New("factory", cfg.GameFactoryAddress),
+		factory:   factory,
+		gameTypes: cfg.GameTypes,
+		gameType: func(ctx context.Context, addr common.Address) (uint8, error) {
+			return loadGameType(ctx, addr, client)
+		},
+		newGame: func(ctx context.Context, addr common.Address) (*monitoredGame, error) {
+			gameCfg := *cfg
+			gameCfg.GameAddress = addr
+			gameCfg.AdditionalGames = nil
+			gameCfg.CannonDatadir = CannonGameDatadir(cfg, addr)
+			return newMonitoredGame(ctx, logger, &gameCfg, client, txMgr)
+		},
+		known: known,
+	}, nil
+}
+
+// gameConfigs returns the config for each game configured directly, rather than found via the game factory.
+// Additional games share the config of the primary game but cannon data for each is kept in a separate subdirectory
+// of the cannon datadir.
+func gameConfigs(cfg *config.Config) []*config.Config {
+	var cfgs []*config.Config
+	if cfg.GameAddress != (common.Address{}) {
+		cfgs = append(cfgs, cfg)
+	}
+	for _, addr := range cfg.AdditionalGames {
+		gameCfg := *cfg
+		gameCfg.GameAddress = addr
+		gameCfg.AdditionalGames = nil
+		gameCfg.CannonDatadir = CannonGameDatadir(cfg, addr)
+		cfgs = append(cfgs, &gameCfg)
+	}
+	return cfgs
+}
+
+// CannonGameDatadir returns the directory cannon data for game is kept in when monitoring games with cfg. The primary
+// game uses the cannon datadir itself and any other game a subdirectory named after its address.
+// Returns an empty string if cfg has no cannon datadir.
+func CannonGameDatadir(cfg *config.Config, game common.Address) string {
+	if cfg.CannonDatadir == "" || game == cfg.GameAddress {
+		return cfg.CannonDatadir
+	}
+	return filepath.Join(cfg.CannonDatadir, game.Hex())
+}
+
+// loadGameType returns the type of the game at addr.
+func loadGameType(ctx context.Context, addr common.Address, client *ethclient.Client) (uint8, error) {
+	contract, err := bindings.NewFaultDisputeGameCaller(addr, client)
+	if err != nil {
+		return 0, fmt.Errorf("failed to bind the fault dispute game contract: %w", err)
+	}
+	gameType, err := contract.GameType(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, fmt.Errorf("failed to load game type: %w", err)
+	}
+	return gameType, nil
+}
+
+func containsGameType(gameTypes []uint8, gameType uint8) bool {
+	for _, t := range gameTypes {
+		if t == gameType {
+			return true
+		}
+	}
+	return false
+}
+
+// newMonitoredGame creates the trace provider and oracle updater for the game in cfg.GameAddress.
+func newMonitoredGame(ctx context.Context, logger log.Logger, cfg *config.Config, client *ethclient.Client, txMgr txmgr.TxManager) (*monitoredGame, error) {
 	var trace types.TraceProvider
 	var updater types.OracleUpdater
+	var err error
 	switch cfg.TraceType {
 	case config.TraceTypeCannon:
-		trace, err = cannon.NewTraceProvider(ctx, logger, cfg, client)
+		if cfg.CannonFixtureDir != "" {
+			trace, err = cannon.NewFixtureTraceProvider(cfg)
+		} else {
+			trace, err = cannon.NewTraceProvider(ctx, logger, cfg, client)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("create cannon trace provider: %w", err)
 		}
@@ -59,19 +179,25 @@ func NewService(ctx context.Context, logger log.Logger, cfg *config.Config) (*se
 	default:
 		return nil, fmt.Errorf("unsupported trace type: %v", cfg.TraceType)
 	}
+	if cfg.TraceProviderWrapper != nil {
+		trace = cfg.TraceProviderWrapper(trace)
+	}
 
-	return newTypedService(ctx, logger, cfg, client, trace, updater, txMgr)
+	return newTypedGame(ctx, logger, cfg, client, trace, updater, txMgr)
 }
 
-// newTypedService creates a new Service from a provided trace provider.
-func newTypedService(ctx context.Context, logger log.Logger, cfg *config.Config, client *ethclient.Client, provider types.TraceProvider, updater types.OracleUpdater, txMgr txmgr.TxManager) (*service, error) {
+// newTypedGame creates a new monitoredGame from a provided trace provider.
+func newTypedGame(ctx context.Context, logger log.Logger, cfg *config.Config, client *ethclient.Client, provider types.TraceProvider, updater types.OracleUpdater, txMgr txmgr.TxManager) (*monitoredGame, error) {
 	contract, err := bindings.NewFaultDisputeGameCaller(cfg.GameAddress, client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to bind the fault dispute game contract: %w", err)
 	}
 
-	loader := NewLoader(contract)
 	gameLogger := logger.New("game", cfg.GameAddress)
+	var loader Loader = NewLoader(contract)
+	if cfg.StateDumpDir != "" {
+		loader = newStateDumpLoader(loader, gameLogger, cfg.StateDumpDir, cfg.GameAddress)
+	}
 	responder, err := NewFaultResponder(gameLogger, txMgr, cfg.GameAddress)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create the responder: %w", err)
@@ -82,17 +208,69 @@ func newTypedService(ctx context.Context, logger log.Logger, cfg *config.Config,
 		return nil, fmt.Errorf("failed to bind the fault contract: %w", err)
 	}
 
+	var finality *FinalityChecker
+	if cfg.SkipFinalizedOutputs {
+		finality, err = NewFinalityCheckerFromBindings(ctx, cfg.GameAddress, client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the finality checker: %w", err)
+		}
+	}
+
+	expiry, err := loadGameExpiry(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
 	agent := NewAgent(loader, cfg.GameDepth, provider, responder, updater, cfg.AgreeWithProposedOutput, gameLogger)
 
-	return &service{
-		agent:                   agent,
+	return &monitoredGame{
+		addr:                    cfg.GameAddress,
+		logger:                  gameLogger,
 		agreeWithProposedOutput: cfg.AgreeWithProposedOutput,
+		actor:                   agent,
 		caller:                  caller,
-		logger:                  gameLogger,
+		finality:                finality,
+		expiry:                  expiry,
 	}, nil
 }
 
-// MonitorGame monitors the fault dispute game and attempts to progress it.
+// loadGameExpiry returns the time by which the game's clocks will have expired, as a unix timestamp.
+// Each player's clock has half the game duration so all clocks have expired once the full duration has passed.
+func loadGameExpiry(ctx context.Context, contract *bindings.FaultDisputeGameCaller) (uint64, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	createdAt, err := contract.CreatedAt(opts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load game creation time: %w", err)
+	}
+	duration, err := contract.GAMEDURATION(opts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load game duration: %w", err)
+	}
+	return createdAt + duration, nil
+}
+
+// MonitorGame monitors the fault dispute games and attempts to progress them.
+// If a game factory is configured, games it creates are monitored as they are found and monitoring continues until
+// ctx is done. Otherwise it returns once all games are complete.
 func (s *service) MonitorGame(ctx context.Context) error {
-	return MonitorGame(ctx, s.logger, s.agreeWithProposedOutput, s.agent, s.caller)
+	return monitorGames(ctx, s.logger, skipFinalizedGames(ctx, s.games), s.source)
+}
+
+// skipFinalizedGames returns the games in games, except those disputing an output that has already finalized if
+// enabled. Games are only checked when they start being monitored, so a game whose output finalizes while it is
+// being played continues to be played.
+func skipFinalizedGames(ctx context.Context, games []*monitoredGame) []*monitoredGame {
+	var remaining []*monitoredGame
+	for _, game := range games {
+		if game.finality != nil {
+			if finalized, err := game.finality.IsDisputedOutputFinalized(ctx); err != nil {
+				game.logger.Warn("Unable to check if disputed output is finalized", "err", err)
+			} else if finalized {
+				game.logger.Warn("Skipping game because the disputed output has already finalized")
+				continue
+			}
+		}
+		remaining = append(remaining, game)
+	}
+	return remaining
 }
@@ -0,0 +1,37 @@
+package fault
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCannonGameDatadir(t *testing.T) {
+	primary := common.Address{0xaa}
+	additional := common.Address{0xbb}
+
+	t.Run("PrimaryGame", func(t *testing.T) {
+		cfg := &config.Config{GameAddress: primary, CannonDatadir: "/data"}
+		require.Equal(t, "/data", CannonGameDatadir(cfg, primary))
+	})
+
+	t.Run("AdditionalGame", func(t *testing.T) {
+		cfg := &config.Config{GameAddress: primary, CannonDatadir: "/data"}
+		require.Equal(t, filepath.Join("/data", additional.Hex()), CannonGameDatadir(cfg, additional))
+	})
+
+	t.Run("NoCannon", func(t *testing.T) {
+		cfg := &config.Config{GameAddress: primary}
+		require.Empty(t, CannonGameDatadir(cfg, additional))
+	})
+
+	t.Run("MatchesGameConfigs", func(t *testing.T) {
+		cfg := &config.Config{GameAddress: primary, AdditionalGames: []common.Address{additional}, CannonDatadir: "/data"}
+		for _, gameCfg := range gameConfigs(cfg) {
+			require.Equal(t, gameCfg.CannonDatadir, CannonGameDatadir(cfg, gameCfg.GameAddress))
+		}
+	})
+}
@@ -0,0 +1,114 @@
+package fault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ClaimView is a single claim as last loaded by the challenger.
+type ClaimView struct {
+	Index       int         `json:"index"`
+	ParentIndex int         `json:"parentIndex"`
+	Position    uint64      `json:"position"` // Generalized index of the claim's position
+	Claim       common.Hash `json:"claim"`
+	Countered   bool        `json:"countered"`
+	Clock       uint64      `json:"clock"`
+}
+
+// GameView is the challenger's view of the claims in a game, as written to the state dump directory.
+type GameView struct {
+	Game   common.Address `json:"game"`
+	Claims []ClaimView    `json:"claims"`
+}
+
+// StateDumpPath returns the file the challenger writes its view of game to when dumping state to dir.
+func StateDumpPath(dir string, game common.Address) string {
+	return filepath.Join(dir, game.Hex()+".json")
+}
+
+// ReadGameView reads the challenger's view of game from the state dump directory dir.
+// Returns an error satisfying os.IsNotExist if the challenger hasn't loaded the game's claims yet.
+func ReadGameView(dir string, game common.Address) (GameView, error) {
+	data, err := os.ReadFile(StateDumpPath(dir, game))
+	if err != nil {
+		return GameView{}, err
+	}
+	var view GameView
+	if err := json.Unmarshal(data, &view); err != nil {
+		return GameView{}, fmt.Errorf("decode state dump for game %v: %w", game, err)
+	}
+	return view, nil
+}
+
+func newGameView(game common.Address, claims []types.Claim) GameView {
+	view := GameView{Game: game, Claims: make([]ClaimView, 0, len(claims))}
+	for _, claim := range claims {
+		view.Claims = append(view.Claims, ClaimView{
+			Index:       claim.ContractIndex,
+			ParentIndex: claim.ParentContractIndex,
+			Position:    claim.ToGIndex(),
+			Claim:       claim.Value,
+			Countered:   claim.Countered,
+			Clock:       claim.Clock,
+		})
+	}
+	return view
+}
+
+// writeGameView writes view to path, replacing the previous view so readers never see a partially written file.
+func writeGameView(path string, view GameView) error {
+	data, err := json.MarshalIndent(view, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode state dump: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("create state dump dir: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("write state dump: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("replace state dump: %w", err)
+	}
+	return nil
+}
+
+// stateDumpLoader is a [Loader] that writes the claims it loads to the state dump directory, so the claims the agent
+// acts on can be compared with the claims on chain when debugging.
+type stateDumpLoader struct {
+	Loader
+	logger log.Logger
+	game   common.Address
+	path   string
+}
+
+// newStateDumpLoader creates a [stateDumpLoader] that dumps the claims of game loaded by loader to dir.
+func newStateDumpLoader(loader Loader, logger log.Logger, dir string, game common.Address) *stateDumpLoader {
+	return &stateDumpLoader{
+		Loader: loader,
+		logger: logger,
+		game:   game,
+		path:   StateDumpPath(dir, game),
+	}
+}
+
+// FetchClaims fetches all claims from the fault dispute game and dumps them. Failing to dump the claims is logged
+// rather than returned so debugging output can't stop the challenger acting on the game.
+func (l *stateDumpLoader) FetchClaims(ctx context.Context) ([]types.Claim, error) {
+	claims, err := l.Loader.FetchClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeGameView(l.path, newGameView(l.game, claims)); err != nil {
+		l.logger.Warn("Failed to dump game state", "path", l.path, "err", err)
+	}
+	return claims, nil
+}
@@ -0,0 +1,87 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+type stubLoader struct {
+	claims []types.Claim
+	err    error
+}
+
+func (s *stubLoader) FetchClaims(_ context.Context) ([]types.Claim, error) {
+	return s.claims, s.err
+}
+
+func TestStateDumpLoader(t *testing.T) {
+	game := common.Address{0xaa}
+	claims := []types.Claim{
+		{
+			ClaimData:           types.ClaimData{Value: common.Hash{0x01}, Position: types.NewPositionFromGIndex(1)},
+			Countered:           true,
+			Clock:               10,
+			ContractIndex:       0,
+			ParentContractIndex: math.MaxUint32,
+		},
+		{
+			ClaimData:           types.ClaimData{Value: common.Hash{0x02}, Position: types.NewPositionFromGIndex(2)},
+			Clock:               20,
+			ContractIndex:       1,
+			ParentContractIndex: 0,
+		},
+	}
+
+	t.Run("DumpsLoadedClaims", func(t *testing.T) {
+		dir := t.TempDir()
+		loader := newStateDumpLoader(&stubLoader{claims: claims}, testlog.Logger(t, log.LvlInfo), dir, game)
+		loaded, err := loader.FetchClaims(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, claims, loaded)
+
+		view, err := ReadGameView(dir, game)
+		require.NoError(t, err)
+		require.Equal(t, GameView{
+			Game: game,
+			Claims: []ClaimView{
+				{Index: 0, ParentIndex: math.MaxUint32, Position: 1, Claim: common.Hash{0x01}, Countered: true, Clock: 10},
+				{Index: 1, ParentIndex: 0, Position: 2, Claim: common.Hash{0x02}, Clock: 20},
+			},
+		}, view)
+	})
+
+	t.Run("ReplacesPreviousDump", func(t *testing.T) {
+		dir := t.TempDir()
+		stub := &stubLoader{claims: claims}
+		loader := newStateDumpLoader(stub, testlog.Logger(t, log.LvlInfo), dir, game)
+		_, err := loader.FetchClaims(context.Background())
+		require.NoError(t, err)
+
+		stub.claims = claims[:1]
+		_, err = loader.FetchClaims(context.Background())
+		require.NoError(t, err)
+		view, err := ReadGameView(dir, game)
+		require.NoError(t, err)
+		require.Len(t, view.Claims, 1)
+	})
+
+	t.Run("NoDumpWhenLoadFails", func(t *testing.T) {
+		dir := t.TempDir()
+		loadErr := errors.New("boom")
+		loader := newStateDumpLoader(&stubLoader{err: loadErr}, testlog.Logger(t, log.LvlInfo), dir, game)
+		_, err := loader.FetchClaims(context.Background())
+		require.ErrorIs(t, err, loadErr)
+
+		_, err = ReadGameView(dir, game)
+		require.True(t, os.IsNotExist(err))
+	})
+}
@@ -0,0 +1,106 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrInjectedFault is returned by trace providers wrapped by TraceFaults when a failure is injected.
+var ErrInjectedFault = errors.New("injected trace provider fault")
+
+// TraceFaults controls the faults injected into the trace providers it wraps.
+// Faults only apply to Get, so the claims the challenger posts are affected but the data used to step is not.
+// Faults can be changed at any time, including while a wrapped provider is in use.
+type TraceFaults struct {
+	lock        sync.Mutex
+	failures    int
+	failedGets  int
+	corruptions map[uint64]common.Hash
+	delay       time.Duration
+}
+
+// NewTraceFaults creates a TraceFaults with no faults injected.
+func NewTraceFaults() *TraceFaults {
+	return &TraceFaults{
+		corruptions: make(map[uint64]common.Hash),
+	}
+}
+
+// Wrap returns a trace provider that delegates to provider, subject to the injected faults.
+func (f *TraceFaults) Wrap(provider types.TraceProvider) types.TraceProvider {
+	return &faultInjectingTraceProvider{
+		TraceProvider: provider,
+		faults:        f,
+	}
+}
+
+// FailNextGets makes the next n calls to Get fail with ErrInjectedFault.
+func (f *TraceFaults) FailNextGets(n int) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.failures = n
+}
+
+// CorruptGet makes Get return value for trace index i instead of the real claim value.
+func (f *TraceFaults) CorruptGet(i uint64, value common.Hash) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.corruptions[i] = value
+}
+
+// DelayGets makes every call to Get wait for delay, or until the context is done, before responding.
+func (f *TraceFaults) DelayGets(delay time.Duration) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.delay = delay
+}
+
+// FailedGets returns the number of Get calls that failed with ErrInjectedFault.
+func (f *TraceFaults) FailedGets() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.failedGets
+}
+
+// next determines the fault to apply to a Get call for trace index i, consuming an injected failure if any remain.
+func (f *TraceFaults) next(i uint64) (fail bool, corrupt *common.Hash, delay time.Duration) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.failures > 0 {
+		f.failures--
+		f.failedGets++
+		return true, nil, f.delay
+	}
+	if value, ok := f.corruptions[i]; ok {
+		return false, &value, f.delay
+	}
+	return false, nil, f.delay
+}
+
+type faultInjectingTraceProvider struct {
+	types.TraceProvider
+	faults *TraceFaults
+}
+
+func (p *faultInjectingTraceProvider) Get(ctx context.Context, i uint64) (common.Hash, error) {
+	fail, corrupt, delay := p.faults.next(i)
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return common.Hash{}, ctx.Err()
+		}
+	}
+	if fail {
+		return common.Hash{}, ErrInjectedFault
+	}
+	if corrupt != nil {
+		return *corrupt, nil
+	}
+	return p.TraceProvider.Get(ctx, i)
+}
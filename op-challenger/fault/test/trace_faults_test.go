@@ -0,0 +1,92 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceFaults(t *testing.T) {
+	ctx := context.Background()
+	setup := func() (*TraceFaults, *alphabet.AlphabetTraceProvider) {
+		return NewTraceFaults(), alphabet.NewTraceProvider("abcdefgh", 3)
+	}
+
+	t.Run("NoFaults", func(t *testing.T) {
+		faults, provider := setup()
+		wrapped := faults.Wrap(provider)
+		expected, err := provider.Get(ctx, 3)
+		require.NoError(t, err)
+		actual, err := wrapped.Get(ctx, 3)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+		require.Zero(t, faults.FailedGets())
+	})
+
+	t.Run("FailNextGets", func(t *testing.T) {
+		faults, provider := setup()
+		wrapped := faults.Wrap(provider)
+		faults.FailNextGets(2)
+		for i := 0; i < 2; i++ {
+			_, err := wrapped.Get(ctx, 3)
+			require.ErrorIs(t, err, ErrInjectedFault)
+		}
+		_, err := wrapped.Get(ctx, 3)
+		require.NoError(t, err)
+		require.Equal(t, 2, faults.FailedGets())
+	})
+
+	t.Run("CorruptGet", func(t *testing.T) {
+		faults, provider := setup()
+		wrapped := faults.Wrap(provider)
+		corrupt := common.Hash{0xbd}
+		faults.CorruptGet(3, corrupt)
+		actual, err := wrapped.Get(ctx, 3)
+		require.NoError(t, err)
+		require.Equal(t, corrupt, actual)
+
+		// Other indices are unaffected
+		expected, err := provider.Get(ctx, 4)
+		require.NoError(t, err)
+		actual, err = wrapped.Get(ctx, 4)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("OnlyGetIsAffected", func(t *testing.T) {
+		faults, provider := setup()
+		wrapped := faults.Wrap(provider)
+		faults.FailNextGets(1)
+		faults.CorruptGet(3, common.Hash{0xbd})
+		expected, expectedProof, err := provider.GetPreimage(ctx, 3)
+		require.NoError(t, err)
+		actual, actualProof, err := wrapped.GetPreimage(ctx, 3)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+		require.Equal(t, expectedProof, actualProof)
+	})
+
+	t.Run("DelayGets", func(t *testing.T) {
+		faults, provider := setup()
+		wrapped := faults.Wrap(provider)
+		faults.DelayGets(50 * time.Millisecond)
+		start := time.Now()
+		_, err := wrapped.Get(ctx, 3)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("DelayStopsOnContextDone", func(t *testing.T) {
+		faults, provider := setup()
+		wrapped := faults.Wrap(provider)
+		faults.DelayGets(time.Hour)
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := wrapped.Get(ctx, 3)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
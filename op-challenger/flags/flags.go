@@ -2,6 +2,7 @@ package flags
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
@@ -10,6 +11,7 @@ import (
 	oplog "github.com/ethereum-optimism/optimism/op-service/log"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/urfave/cli/v2"
 )
 
@@ -30,9 +32,24 @@ var (
 	}
 	DGFAddressFlag = &cli.StringFlag{
 		Name:    "game-address",
-		Usage:   "Address of the Fault Game contract.",
+		Usage:   "Address of the Fault Game contract. Required if game-factory-address is not set.",
 		EnvVars: prefixEnvVars("GAME_ADDRESS"),
 	}
+	GameFactoryAddressFlag = &cli.StringFlag{
+		Name:    "game-factory-address",
+		Usage:   "Address of the Dispute Game Factory contract. Games it creates, including those created before starting, are monitored as they are found. Required if game-address is not set.",
+		EnvVars: prefixEnvVars("GAME_FACTORY_ADDRESS"),
+	}
+	AdditionalGamesFlag = &cli.StringSliceFlag{
+		Name:    "additional-game-address",
+		Usage:   "Address of a further Fault Game contract to monitor. May be repeated. Games closest to expiring are acted on first.",
+		EnvVars: prefixEnvVars("ADDITIONAL_GAME_ADDRESSES"),
+	}
+	GameTypesFlag = &cli.IntSliceFlag{
+		Name:    "game-type",
+		Usage:   "Only monitor games of this type. May be repeated. Games of any type are monitored if not set.",
+		EnvVars: prefixEnvVars("GAME_TYPES"),
+	}
 	TraceTypeFlag = &cli.GenericFlag{
 		Name:    "trace-type",
 		Usage:   "The trace type. Valid options: " + openum.EnumString(config.TraceTypes),
@@ -89,12 +106,21 @@ var (
 		EnvVars: prefixEnvVars("CANNON_SNAPSHOT_FREQ"),
 		Value:   config.DefaultCannonSnapshotFreq,
 	}
+	SkipFinalizedOutputsFlag = &cli.BoolFlag{
+		Name:    "skip-finalized-outputs",
+		Usage:   "Skip games disputing an output that has already passed the L2OutputOracle finalization period",
+		EnvVars: prefixEnvVars("SKIP_FINALIZED_OUTPUTS"),
+	}
+	StateDumpDirFlag = &cli.StringFlag{
+		Name:    "state-dump-dir",
+		Usage:   "Debug only: directory to write the claims last loaded for each game to, one JSON file per game address",
+		EnvVars: prefixEnvVars("STATE_DUMP_DIR"),
+	}
 )
 
 // requiredFlags are checked by [CheckRequired]
 var requiredFlags = []cli.Flag{
 	L1EthRpcFlag,
-	DGFAddressFlag,
 	TraceTypeFlag,
 	AgreeWithProposedOutputFlag,
 	GameDepthFlag,
@@ -102,6 +128,10 @@ var requiredFlags = []cli.Flag{
 
 // optionalFlags is a list of unchecked cli flags
 var optionalFlags = []cli.Flag{
+	DGFAddressFlag,
+	GameFactoryAddressFlag,
+	AdditionalGamesFlag,
+	GameTypesFlag,
 	AlphabetFlag,
 	CannonBinFlag,
 	CannonServerFlag,
@@ -109,6 +139,8 @@ var optionalFlags = []cli.Flag{
 	CannonDatadirFlag,
 	CannonL2Flag,
 	CannonSnapshotFreqFlag,
+	SkipFinalizedOutputsFlag,
+	StateDumpDirFlag,
 }
 
 func init() {
@@ -127,6 +159,9 @@ func CheckRequired(ctx *cli.Context) error {
 			return fmt.Errorf("flag %s is required", f.Names()[0])
 		}
 	}
+	if !ctx.IsSet(DGFAddressFlag.Name) && !ctx.IsSet(GameFactoryAddressFlag.Name) {
+		return fmt.Errorf("flag %s or %s is required", DGFAddressFlag.Name, GameFactoryAddressFlag.Name)
+	}
 	gameType := config.TraceType(strings.ToLower(ctx.String(TraceTypeFlag.Name)))
 	switch gameType {
 	case config.TraceTypeCannon:
@@ -160,9 +195,36 @@ func NewConfigFromCLI(ctx *cli.Context) (*config.Config, error) {
 	if err := CheckRequired(ctx); err != nil {
 		return nil, err
 	}
-	dgfAddress, err := opservice.ParseAddress(ctx.String(DGFAddressFlag.Name))
-	if err != nil {
-		return nil, err
+	var dgfAddress common.Address
+	if ctx.IsSet(DGFAddressFlag.Name) {
+		addr, err := opservice.ParseAddress(ctx.String(DGFAddressFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+		dgfAddress = addr
+	}
+	var gameFactoryAddress common.Address
+	if ctx.IsSet(GameFactoryAddressFlag.Name) {
+		addr, err := opservice.ParseAddress(ctx.String(GameFactoryAddressFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+		gameFactoryAddress = addr
+	}
+	var additionalGames []common.Address
+	for _, addr := range ctx.StringSlice(AdditionalGamesFlag.Name) {
+		gameAddress, err := opservice.ParseAddress(addr)
+		if err != nil {
+			return nil, err
+		}
+		additionalGames = append(additionalGames, gameAddress)
+	}
+	var gameTypes []uint8
+	for _, gameType := range ctx.IntSlice(GameTypesFlag.Name) {
+		if gameType < 0 || gameType > math.MaxUint8 {
+			return nil, fmt.Errorf("invalid game type: %v", gameType)
+		}
+		gameTypes = append(gameTypes, uint8(gameType))
 	}
 
 	txMgrConfig := txmgr.ReadCLIConfig(ctx)
@@ -174,6 +236,9 @@ func NewConfigFromCLI(ctx *cli.Context) (*config.Config, error) {
 		L1EthRpc:                ctx.String(L1EthRpcFlag.Name),
 		TraceType:               traceTypeFlag,
 		GameAddress:             dgfAddress,
+		AdditionalGames:         additionalGames,
+		GameFactoryAddress:      gameFactoryAddress,
+		GameTypes:               gameTypes,
 		AlphabetTrace:           ctx.String(AlphabetFlag.Name),
 		CannonBin:               ctx.String(CannonBinFlag.Name),
 		CannonServer:            ctx.String(CannonServerFlag.Name),
@@ -183,6 +248,8 @@ func NewConfigFromCLI(ctx *cli.Context) (*config.Config, error) {
 		CannonSnapshotFreq:      ctx.Uint(CannonSnapshotFreqFlag.Name),
 		AgreeWithProposedOutput: ctx.Bool(AgreeWithProposedOutputFlag.Name),
 		GameDepth:               ctx.Int(GameDepthFlag.Name),
+		SkipFinalizedOutputs:    ctx.Bool(SkipFinalizedOutputsFlag.Name),
+		StateDumpDir:            ctx.String(StateDumpDirFlag.Name),
 		TxMgrConfig:             txMgrConfig,
 	}, nil
 }
@@ -0,0 +1,345 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	op_challenger "github.com/ethereum-optimism/optimism/op-challenger"
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/disputegame"
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Depth of the alphabet games created by the devnet factory.
+const alphabetGameDepth = 4
+
+// Alphabet claimed by dishonest games.
+const dishonestAlphabet = "abcdexyz"
+
+var (
+	RPCFlag = &cli.StringFlag{
+		Name:     "rpc-url",
+		Usage:    "L1 RPC URL",
+		Required: true,
+		EnvVars:  []string{"L1_RPC_URL"},
+	}
+	PrivateKeyFlag = &cli.StringFlag{
+		Name:     "private-key",
+		Usage:    "Hex encoded private key used to create games",
+		Required: true,
+		EnvVars:  []string{"PRIVATE_KEY"},
+	}
+	ChallengerKeyFlag = &cli.StringFlag{
+		Name:     "challenger-private-key",
+		Usage:    "Hex encoded private key used by the challenger",
+		Required: true,
+		EnvVars:  []string{"CHALLENGER_PRIVATE_KEY"},
+	}
+	DeploymentsFlag = &cli.StringFlag{
+		Name:      "deployments",
+		Usage:     "Path to the L1 deployments file, such as .devnet/addresses.json",
+		Required:  true,
+		TakesFile: true,
+		EnvVars:   []string{"DEPLOYMENTS"},
+	}
+	IntervalFlag = &cli.DurationFlag{
+		Name:    "interval",
+		Usage:   "Time between creating new games",
+		Value:   5 * time.Minute,
+		EnvVars: []string{"INTERVAL"},
+	}
+	HonestProbabilityFlag = &cli.Float64Flag{
+		Name:    "honest-probability",
+		Usage:   "Probability that a created game has a valid root claim",
+		Value:   0.5,
+		EnvVars: []string{"HONEST_PROBABILITY"},
+	}
+	LoadDurationFlag = &cli.DurationFlag{
+		Name: "load-duration",
+		Usage: "Run a load test instead of soaking: create games for this long, alternating valid and invalid root claims, " +
+			"with a single challenger playing all of them, then report how the challenger kept up once every game resolves",
+		EnvVars: []string{"LOAD_DURATION"},
+	}
+	MetricsAddrFlag = &cli.StringFlag{
+		Name:    "metrics.addr",
+		Usage:   "Metrics listening address",
+		Value:   "0.0.0.0",
+		EnvVars: []string{"METRICS_ADDR"},
+	}
+	MetricsPortFlag = &cli.IntFlag{
+		Name:    "metrics.port",
+		Usage:   "Metrics listening port",
+		Value:   7300,
+		EnvVars: []string{"METRICS_PORT"},
+	}
+)
+
+// Soak test for the dispute game system. Creates an alphabet game every interval with a randomly honest or
+// dishonest root claim while a single honest challenger plays every game, exposing metrics as the games play out.
+func main() {
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(isatty.IsTerminal(os.Stderr.Fd()))))
+
+	app := &cli.App{
+		Name:   "dispute-soak",
+		Usage:  "Continuously create dispute games on a devnet and let the challenger play them",
+		Flags:  []cli.Flag{RPCFlag, PrivateKeyFlag, ChallengerKeyFlag, DeploymentsFlag, IntervalFlag, HonestProbabilityFlag, LoadDurationFlag, MetricsAddrFlag, MetricsPortFlag},
+		Action: soak,
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("Application failed", "err", err)
+	}
+}
+
+func soak(cliCtx *cli.Context) error {
+	ctx, cancel := signal.NotifyContext(cliCtx.Context, os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	logger := log.New()
+
+	registry := opmetrics.NewRegistry()
+	m := disputegame.NewMetrics(registry)
+	go func() {
+		addr, port := cliCtx.String(MetricsAddrFlag.Name), cliCtx.Int(MetricsPortFlag.Name)
+		logger.Info("Starting metrics server", "addr", addr, "port", port)
+		if err := opmetrics.ListenAndServe(ctx, registry, addr, port); err != nil {
+			logger.Error("Metrics server failed", "err", err)
+		}
+	}()
+
+	factory, err := newFactory(ctx, cliCtx)
+	if err != nil {
+		return err
+	}
+	factory.SetMetrics(m)
+	claimant, err := challengerAddress(cliCtx)
+	if err != nil {
+		return err
+	}
+	if duration := cliCtx.Duration(LoadDurationFlag.Name); duration > 0 {
+		return runLoad(ctx, logger, cliCtx, factory, claimant, duration)
+	}
+
+	stopChallenger := startChallenger(ctx, logger, cliCtx, factory)
+	defer stopChallenger()
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	ticker := time.NewTicker(cliCtx.Duration(IntervalFlag.Name))
+	defer ticker.Stop()
+	for {
+		honest := rand.Float64() < cliCtx.Float64(HonestProbabilityFlag.Name)
+		game, err := createGame(ctx, factory, honest)
+		if err != nil {
+			logger.Error("Failed to create game", "err", err)
+		} else {
+			logger.Info("Created game", "game", game.Addr(), "honest", honest)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				watchGame(ctx, logger.New("game", game.Addr()), factory, game, claimant, honest)
+			}()
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func createGame(ctx context.Context, factory *disputegame.FactoryCore, honest bool) (*disputegame.GameCore, error) {
+	alphabet := dishonestAlphabet
+	if honest {
+		alphabet = disputegame.CorrectAlphabet
+	}
+	addr, err := factory.CreateAlphabetGame(ctx, alphabet)
+	if err != nil {
+		return nil, err
+	}
+	return factory.Game(addr)
+}
+
+// watchGame records the latency of the moves the challenger, sending from claimant, makes in game until it is
+// resolved and checks the outcome matches whether the root claim was honest.
+func watchGame(ctx context.Context, logger log.Logger, factory *disputegame.FactoryCore, game *disputegame.GameCore, claimant common.Address, honest bool) {
+	creation, err := factory.GameCreation(ctx, game.Addr())
+	if err != nil {
+		logger.Error("Failed to load game creation", "err", err)
+		return
+	}
+	if err := game.WatchMoveLatency(ctx, creation, claimant); err != nil {
+		if ctx.Err() == nil {
+			logger.Error("Failed to watch challenger moves", "err", err)
+		}
+		return
+	}
+	status, err := game.WaitForResolution(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.Error("Failed to wait for game to resolve", "err", err)
+		}
+		return
+	}
+	if expected := expectedStatus(honest); status != expected {
+		logger.Error("Game resolved with unexpected status", "status", status, "expected", expected)
+		return
+	}
+	logger.Info("Game resolved", "status", status)
+}
+
+// challengerConfig returns the config for an honest challenger playing every alphabet game created by factory.
+func challengerConfig(cliCtx *cli.Context, factory *disputegame.FactoryCore) config.Config {
+	txmgrCfg := txmgr.NewCLIConfig(cliCtx.String(RPCFlag.Name))
+	txmgrCfg.PrivateKey = cliCtx.String(ChallengerKeyFlag.Name)
+	txmgrCfg.NumConfirmations = 1
+	cfg := config.NewConfig(cliCtx.String(RPCFlag.Name), common.Address{}, config.TraceTypeAlphabet, true, alphabetGameDepth)
+	cfg.GameFactoryAddress = factory.FactoryAddress()
+	cfg.GameTypes = []uint8{factory.AlphabetGameType()}
+	cfg.AlphabetTrace = disputegame.CorrectAlphabet
+	cfg.TxMgrConfig = txmgrCfg
+	return cfg
+}
+
+// challengerAddress returns the address the challenger sends transactions from.
+func challengerAddress(cliCtx *cli.Context) (common.Address, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(cliCtx.String(ChallengerKeyFlag.Name), "0x"))
+	if err != nil {
+		return common.Address{}, fmt.Errorf("parse challenger private key: %w", err)
+	}
+	return crypto.PubkeyToAddress(key.PublicKey), nil
+}
+
+// expectedStatus returns the status a game should resolve with when played by an honest challenger.
+func expectedStatus(honest bool) disputegame.Status {
+	if honest {
+		return disputegame.StatusDefenderWins
+	}
+	return disputegame.StatusChallengerWins
+}
+
+type loadGame struct {
+	game   *disputegame.GameCore
+	honest bool
+}
+
+// runLoad creates a game every interval until duration has passed, alternating between valid and invalid root claims,
+// while a single challenger, started before the first game is created, discovers and plays every game. Once every
+// game has resolved, reports how the challenger kept up and fails if the clock of any claim expired without the
+// challenger making the honest move.
+func runLoad(ctx context.Context, logger log.Logger, cliCtx *cli.Context, factory *disputegame.FactoryCore, claimant common.Address, duration time.Duration) error {
+	var games []loadGame
+	stopChallenger := startChallenger(ctx, logger, cliCtx, factory)
+	defer stopChallenger()
+	ticker := time.NewTicker(cliCtx.Duration(IntervalFlag.Name))
+	defer ticker.Stop()
+	end := time.Now().Add(duration)
+	for i := 0; time.Now().Before(end); i++ {
+		honest := i%2 == 0
+		game, err := createGame(ctx, factory, honest)
+		if err != nil {
+			logger.Error("Failed to create game", "err", err)
+		} else {
+			logger.Info("Created game", "game", game.Addr(), "honest", honest)
+			games = append(games, loadGame{game: game, honest: honest})
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+	logger.Info("Load complete, waiting for games to resolve", "games", len(games))
+	throughputGames := make([]disputegame.ThroughputGame, 0, len(games))
+	for _, game := range games {
+		status, err := game.game.WaitForResolution(ctx)
+		if err != nil {
+			return fmt.Errorf("wait for game %v to resolve: %w", game.game.Addr(), err)
+		}
+		if expected := expectedStatus(game.honest); status != expected {
+			logger.Error("Game resolved with unexpected status", "game", game.game.Addr(), "status", status, "expected", expected)
+		}
+		trace := alphabet.NewTraceProvider(disputegame.CorrectAlphabet, alphabetGameDepth)
+		throughputGames = append(throughputGames, disputegame.ThroughputGame{
+			Addr:     game.game.Addr(),
+			MaxDepth: alphabetGameDepth,
+			Honest:   disputegame.NewTraceStrategy(alphabetGameDepth, trace, game.honest, disputegame.NoDelay),
+		})
+	}
+
+	report, err := factory.LoadThroughputReport(ctx, throughputGames, claimant)
+	if err != nil {
+		return err
+	}
+	logger.Info("Load report", "games", report.Games, "maxConcurrent", report.MaxConcurrent, "missedClocks", len(report.MissedClocks))
+	for _, game := range games {
+		response, ok := report.FirstResponse[game.game.Addr()]
+		logger.Info("Time to first response", "game", game.game.Addr(), "responded", ok, "duration", response)
+	}
+	for _, missed := range report.MissedClocks {
+		logger.Error("Clock expired without the honest move", "game", missed.Game, "claim", missed.ClaimIndex, "deadline", missed.Deadline)
+	}
+	if len(report.MissedClocks) > 0 {
+		return fmt.Errorf("challenger missed %v honest moves", len(report.MissedClocks))
+	}
+	return nil
+}
+
+// startChallenger starts a single challenger playing every alphabet game created by factory, including games created
+// after it starts, returning a function that stops it and waits for it to exit.
+func startChallenger(ctx context.Context, logger log.Logger, cliCtx *cli.Context, factory *disputegame.FactoryCore) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	cfg := challengerConfig(cliCtx, factory)
+	go func() {
+		defer close(done)
+		if err := op_challenger.Main(ctx, logger.New("role", "challenger"), &cfg); err != nil && ctx.Err() == nil {
+			logger.Error("Challenger failed", "err", err)
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func newFactory(ctx context.Context, cliCtx *cli.Context) (*disputegame.FactoryCore, error) {
+	client, err := ethclient.DialContext(ctx, cliCtx.String(RPCFlag.Name))
+	if err != nil {
+		return nil, fmt.Errorf("dial L1: %w", err)
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load chain ID: %w", err)
+	}
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(cliCtx.String(PrivateKeyFlag.Name), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("create transactor: %w", err)
+	}
+	deployments, err := genesis.NewL1Deployments(cliCtx.String(DeploymentsFlag.Name))
+	if err != nil {
+		return nil, fmt.Errorf("load deployments: %w", err)
+	}
+	return disputegame.NewFactoryCore(ctx, client, opts, deployments)
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli/v2"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/disputegame"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	RPCFlag = &cli.StringFlag{
+		Name:     "rpc-url",
+		Usage:    "L1 RPC URL",
+		Required: true,
+		EnvVars:  []string{"L1_RPC_URL"},
+	}
+	PrivateKeyFlag = &cli.StringFlag{
+		Name:     "private-key",
+		Usage:    "Hex encoded private key used to sign transactions",
+		Required: true,
+		EnvVars:  []string{"PRIVATE_KEY"},
+	}
+	DeploymentsFlag = &cli.StringFlag{
+		Name:      "deployments",
+		Usage:     "Path to the L1 deployments file, such as .devnet/addresses.json",
+		Required:  true,
+		TakesFile: true,
+		EnvVars:   []string{"DEPLOYMENTS"},
+	}
+	GameFlag = &cli.StringFlag{
+		Name:     "game",
+		Usage:    "Address of the dispute game",
+		Required: true,
+	}
+	AlphabetFlag = &cli.StringFlag{
+		Name:  "alphabet",
+		Usage: "Alphabet used to calculate the root claim",
+		Value: disputegame.CorrectAlphabet,
+	}
+	ParentIndexFlag = &cli.Int64Flag{
+		Name:     "parent-index",
+		Usage:    "Index of the claim to move against",
+		Required: true,
+	}
+	ClaimFlag = &cli.StringFlag{
+		Name:     "claim",
+		Usage:    "Claim value to post",
+		Required: true,
+	}
+	DefendFlag = &cli.BoolFlag{
+		Name:  "defend",
+		Usage: "Defend the parent claim instead of attacking it",
+	}
+)
+
+var globalFlags = []cli.Flag{RPCFlag, PrivateKeyFlag, DeploymentsFlag}
+
+// Utility for manually playing dispute games against a devnet, built on the same logic as the e2e test helpers.
+func main() {
+	log.Root().SetHandler(log.StreamHandler(os.Stderr, log.TerminalFormat(isatty.IsTerminal(os.Stderr.Fd()))))
+
+	app := &cli.App{
+		Name:  "disputegame-util",
+		Usage: "Create and play dispute games for devnet debugging",
+		Commands: []*cli.Command{
+			{
+				Name:   "create-alphabet",
+				Usage:  "Create a new alphabet game",
+				Flags:  append([]cli.Flag{AlphabetFlag}, globalFlags...),
+				Action: createAlphabet,
+			},
+			{
+				Name:   "move",
+				Usage:  "Attack or defend a claim",
+				Flags:  append([]cli.Flag{GameFlag, ParentIndexFlag, ClaimFlag, DefendFlag}, globalFlags...),
+				Action: move,
+			},
+			{
+				Name:   "resolve",
+				Usage:  "Resolve a game",
+				Flags:  append([]cli.Flag{GameFlag}, globalFlags...),
+				Action: resolve,
+			},
+			{
+				Name:   "list",
+				Usage:  "List all games created by the factory",
+				Flags:  globalFlags,
+				Action: list,
+			},
+			{
+				Name:   "show",
+				Usage:  "Show the status and claims of a game",
+				Flags:  append([]cli.Flag{GameFlag}, globalFlags...),
+				Action: show,
+			},
+		},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		log.Crit("Application failed", "err", err)
+	}
+}
+
+func newFactory(ctx *cli.Context) (*disputegame.FactoryCore, error) {
+	client, err := ethclient.DialContext(ctx.Context, ctx.String(RPCFlag.Name))
+	if err != nil {
+		return nil, fmt.Errorf("dial L1: %w", err)
+	}
+	chainID, err := client.ChainID(ctx.Context)
+	if err != nil {
+		return nil, fmt.Errorf("load chain ID: %w", err)
+	}
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(ctx.String(PrivateKeyFlag.Name), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("create transactor: %w", err)
+	}
+	deployments, err := genesis.NewL1Deployments(ctx.String(DeploymentsFlag.Name))
+	if err != nil {
+		return nil, fmt.Errorf("load deployments: %w", err)
+	}
+	return disputegame.NewFactoryCore(ctx.Context, client, opts, deployments)
+}
+
+func newGame(ctx *cli.Context) (*disputegame.GameCore, error) {
+	factory, err := newFactory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	addr := ctx.String(GameFlag.Name)
+	if !common.IsHexAddress(addr) {
+		return nil, fmt.Errorf("invalid game address: %v", addr)
+	}
+	return factory.Game(common.HexToAddress(addr))
+}
+
+func createAlphabet(ctx *cli.Context) error {
+	factory, err := newFactory(ctx)
+	if err != nil {
+		return err
+	}
+	addr, err := factory.CreateAlphabetGame(ctx.Context, ctx.String(AlphabetFlag.Name))
+	if err != nil {
+		return err
+	}
+	fmt.Println(addr)
+	return nil
+}
+
+func move(ctx *cli.Context) error {
+	game, err := newGame(ctx)
+	if err != nil {
+		return err
+	}
+	claim := common.HexToHash(ctx.String(ClaimFlag.Name))
+	return game.SendMove(ctx.Context, ctx.Int64(ParentIndexFlag.Name), claim, !ctx.Bool(DefendFlag.Name))
+}
+
+func resolve(ctx *cli.Context) error {
+	game, err := newGame(ctx)
+	if err != nil {
+		return err
+	}
+	if err := game.SendResolve(ctx.Context); err != nil {
+		return err
+	}
+	status, err := game.LoadStatus(ctx.Context)
+	if err != nil {
+		return err
+	}
+	fmt.Println(status)
+	return nil
+}
+
+func list(ctx *cli.Context) error {
+	factory, err := newFactory(ctx)
+	if err != nil {
+		return err
+	}
+	addrs, err := factory.GameAddresses(ctx.Context)
+	if err != nil {
+		return err
+	}
+	for i, addr := range addrs {
+		game, err := factory.Game(addr)
+		if err != nil {
+			return err
+		}
+		status, err := game.LoadStatus(ctx.Context)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%d\t%v\t%v\n", i, addr, status)
+	}
+	return nil
+}
+
+func show(ctx *cli.Context) error {
+	game, err := newGame(ctx)
+	if err != nil {
+		return err
+	}
+	status, err := game.LoadStatus(ctx.Context)
+	if err != nil {
+		return err
+	}
+	claims, err := game.LoadClaims(ctx.Context)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Game: %v\nStatus: %v\nClaims:\n", game.Addr(), status)
+	for i, claim := range claims {
+		pos := types.NewPositionFromGIndex(claim.Position.Uint64())
+		fmt.Printf("%d\tparent: %d\tdepth: %d\tindex: %d\tcountered: %v\tclaim: %v\n",
+			i, int32(claim.ParentIndex), pos.Depth(), pos.IndexAtDepth(), claim.Countered, common.Hash(claim.Claim))
+	}
+	return nil
+}
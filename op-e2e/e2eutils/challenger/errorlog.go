@@ -0,0 +1,36 @@
+package challenger
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// errorLog records the errors attached to error logs from the challenger, such as transactions that failed to send
+// because gas estimation reverted, while forwarding every log to delegate.
+type errorLog struct {
+	delegate log.Handler
+
+	lock sync.Mutex
+	errs []error
+}
+
+func (e *errorLog) Log(r *log.Record) error {
+	if r.Lvl <= log.LvlError {
+		for i := 0; i+1 < len(r.Ctx); i += 2 {
+			if err, ok := r.Ctx[i+1].(error); ok && r.Ctx[i] == "err" {
+				e.lock.Lock()
+				e.errs = append(e.errs, err)
+				e.lock.Unlock()
+			}
+		}
+	}
+	return e.delegate.Log(r)
+}
+
+// Errors returns every error logged so far.
+func (e *errorLog) Errors() []error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return append([]error(nil), e.errs...)
+}
@@ -3,29 +3,92 @@ package challenger
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	op_challenger "github.com/ethereum-optimism/optimism/op-challenger"
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/test"
 	"github.com/ethereum-optimism/optimism/op-node/testlog"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/require"
 )
 
 type Helper struct {
 	log    log.Logger
+	errLog *errorLog
 	cancel func()
 	errors chan error
+	proxy  *failingProxy
+	addr   common.Address
+
+	cfg *config.Config
+
+	exitLock sync.Mutex
+	exited   bool
+	// exitErr is the error the challenger exited with, once exited is set.
+	exitErr error
 }
 
 type Option func(config2 *config.Config)
 
+// WithTraceFaults wraps the challenger's trace provider so faults can be injected via faults while it runs.
+func WithTraceFaults(faults *test.TraceFaults) Option {
+	return func(c *config.Config) {
+		c.TraceProviderWrapper = faults.Wrap
+	}
+}
+
+// WithCannonFixture makes the challenger read cannon proofs from the pre-generated fixture in dir instead of
+// executing cannon, so neither cannon nor op-program need to be built.
+func WithCannonFixture(dir string) Option {
+	return func(c *config.Config) {
+		c.CannonFixtureDir = dir
+		c.CannonBin = ""
+		c.CannonServer = ""
+		c.CannonAbsolutePreState = ""
+	}
+}
+
+// WithAdditionalGames makes the challenger monitor games in addition to its configured game address.
+func WithAdditionalGames(games ...common.Address) Option {
+	return func(c *config.Config) {
+		c.AdditionalGames = append(c.AdditionalGames, games...)
+	}
+}
+
+// WithGameFactory makes the challenger discover the games to play from the dispute game factory at factory instead
+// of being given a game address.
+func WithGameFactory(factory common.Address) Option {
+	return func(c *config.Config) {
+		c.GameAddress = common.Address{}
+		c.GameFactoryAddress = factory
+	}
+}
+
+// WithGameTypes makes the challenger only monitor games of the given types.
+func WithGameTypes(gameTypes ...uint8) Option {
+	return func(c *config.Config) {
+		c.GameTypes = append(c.GameTypes, gameTypes...)
+	}
+}
+
 func NewChallenger(t *testing.T, ctx context.Context, l1Endpoint string, name string, options ...Option) *Helper {
 	log := testlog.Logger(t, log.LvlInfo).New("role", name)
+	errLog := &errorLog{delegate: log.GetHandler()}
+	log.SetHandler(errLog)
 	log.Info("Creating challenger", "l1", l1Endpoint)
+	// Route L1 connections via a proxy so tests can simulate the network failing
+	proxy, l1Endpoint, err := newFailingProxy(log, l1Endpoint)
+	require.NoError(t, err, "start L1 proxy")
 	txmgrCfg := txmgr.NewCLIConfig(l1Endpoint)
 	txmgrCfg.NumConfirmations = 1
 	txmgrCfg.ReceiptQueryInterval = 1 * time.Second
@@ -34,11 +97,15 @@ func NewChallenger(t *testing.T, ctx context.Context, l1Endpoint string, name st
 		AlphabetTrace:           "",
 		AgreeWithProposedOutput: true,
 		TxMgrConfig:             txmgrCfg,
+		// Always dump state so tests can compare the challenger's view of games with the chain
+		StateDumpDir: t.TempDir(),
 	}
 	for _, option := range options {
 		option(cfg)
 	}
 	require.NotEmpty(t, cfg.TxMgrConfig.PrivateKey, "Missing private key for TxMgrConfig")
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(cfg.TxMgrConfig.PrivateKey, "0x"))
+	require.NoError(t, err, "invalid private key for TxMgrConfig")
 	require.NoError(t, cfg.Check(), "op-challenger config should be valid")
 
 	if cfg.CannonBin != "" {
@@ -49,6 +116,10 @@ func NewChallenger(t *testing.T, ctx context.Context, l1Endpoint string, name st
 		_, err := os.Stat(cfg.CannonServer)
 		require.NoError(t, err, "op-program should be built. Make sure you've run make cannon-prestate")
 	}
+	if cfg.CannonFixtureDir != "" {
+		_, err := os.Stat(cfg.CannonFixtureDir)
+		require.NoError(t, err, "cannon fixture should exist")
+	}
 	if cfg.CannonAbsolutePreState != "" {
 		_, err := os.Stat(cfg.CannonAbsolutePreState)
 		require.NoError(t, err, "cannon pre-state should be built. Make sure you've run make cannon-prestate")
@@ -62,20 +133,94 @@ func NewChallenger(t *testing.T, ctx context.Context, l1Endpoint string, name st
 	}()
 	return &Helper{
 		log:    log,
+		errLog: errLog,
 		cancel: cancel,
 		errors: errCh,
+		proxy:  proxy,
+		addr:   crypto.PubkeyToAddress(key.PublicKey),
+
+		cfg: cfg,
 	}
 }
 
+// Address returns the address the challenger sends transactions from.
+func (h *Helper) Address() common.Address {
+	return h.addr
+}
+
+// LoggedErrors returns the errors the challenger has logged at error level or above, such as moves that failed
+// because their gas estimation reverted and so were never sent.
+func (h *Helper) LoggedErrors() []error {
+	return h.errLog.Errors()
+}
+
+// CannonDatadir returns the data directory used by the challenger's cannon trace provider, or an empty string if
+// the challenger doesn't use cannon.
+func (h *Helper) CannonDatadir() string {
+	return h.cfg.CannonDatadir
+}
+
+// CannonGameDatadir returns the directory the challenger keeps cannon data for game in, or an empty string if the
+// challenger doesn't use cannon. The challenger's primary game uses the cannon data dir itself and each additional
+// game uses a subdirectory named after the game's address.
+func (h *Helper) CannonGameDatadir(game common.Address) string {
+	return fault.CannonGameDatadir(h.cfg, game)
+}
+
+// GameView returns the claims of game as last loaded by the challenger.
+// Returns an error satisfying os.IsNotExist if the challenger hasn't loaded the game's claims yet.
+func (h *Helper) GameView(game common.Address) (fault.GameView, error) {
+	return fault.ReadGameView(h.cfg.StateDumpDir, game)
+}
+
+// SetNetworkFailing sets whether the challenger's connection to L1 is failing.
+// While failing, all existing connections are dropped and new ones are refused.
+func (h *Helper) SetNetworkFailing(failing bool) {
+	h.log.Info("Setting L1 network failing", "failing", failing)
+	h.proxy.SetFailing(failing)
+}
+
+// CheckRunning returns an error if the challenger has stopped, wrapping the error it exited with if there was one.
+// The challenger only stops by itself if it fails, so this can be used to check it survives conditions under test.
+func (h *Helper) CheckRunning() error {
+	h.exitLock.Lock()
+	defer h.exitLock.Unlock()
+	if !h.exited {
+		select {
+		case err := <-h.errors:
+			h.exited = true
+			h.exitErr = err
+		default:
+			return nil
+		}
+	}
+	if h.exitErr == nil {
+		return errors.New("challenger stopped")
+	}
+	return fmt.Errorf("challenger stopped: %w", h.exitErr)
+}
+
 func (h *Helper) Close() error {
 	h.cancel()
+	defer h.proxy.Close()
+	h.exitLock.Lock()
+	defer h.exitLock.Unlock()
+	if h.exited {
+		return filterCanceled(h.exitErr)
+	}
 	select {
 	case <-time.After(1 * time.Minute):
 		return errors.New("timed out while stopping challenger")
 	case err := <-h.errors:
-		if !errors.Is(err, context.Canceled) {
-			return err
-		}
+		h.exited = true
+		h.exitErr = err
+		return filterCanceled(err)
+	}
+}
+
+func filterCanceled(err error) error {
+	if errors.Is(err, context.Canceled) {
 		return nil
 	}
+	return err
 }
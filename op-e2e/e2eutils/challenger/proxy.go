@@ -0,0 +1,146 @@
+package challenger
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// failingProxy forwards TCP connections to a target address and can be toggled to simulate the network failing.
+// Forwarding raw connections supports both HTTP and WebSocket RPC endpoints.
+type failingProxy struct {
+	log      log.Logger
+	target   string
+	listener net.Listener
+	failing  atomic.Bool
+
+	lock  sync.Mutex
+	conns map[net.Conn]struct{}
+	wg    sync.WaitGroup
+}
+
+// newFailingProxy starts a proxy to the host of endpoint and returns it along with the equivalent endpoint URL
+// that routes through the proxy.
+func newFailingProxy(logger log.Logger, endpoint string) (*failingProxy, string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, "", fmt.Errorf("parse endpoint %v: %w", endpoint, err)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, "", fmt.Errorf("listen: %w", err)
+	}
+	p := &failingProxy{
+		log:      logger,
+		target:   u.Host,
+		listener: listener,
+		conns:    make(map[net.Conn]struct{}),
+	}
+	p.wg.Add(1)
+	go p.acceptLoop()
+	u.Host = listener.Addr().String()
+	return p, u.String(), nil
+}
+
+// SetFailing sets whether the network is failing. While failing, existing connections are closed and new
+// connections are closed immediately after being accepted.
+func (p *failingProxy) SetFailing(failing bool) {
+	p.failing.Store(failing)
+	if failing {
+		p.closeConns()
+	}
+}
+
+func (p *failingProxy) Close() error {
+	// Fail any connections that are still being established so they don't outlive the proxy.
+	p.failing.Store(true)
+	err := p.listener.Close()
+	p.closeConns()
+	p.wg.Wait()
+	return err
+}
+
+func (p *failingProxy) acceptLoop() {
+	defer p.wg.Done()
+	for {
+		conn, err := p.listener.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return
+		} else if err != nil {
+			p.log.Warn("Failed to accept connection", "err", err)
+			continue
+		}
+		if p.failing.Load() {
+			_ = conn.Close()
+			continue
+		}
+		p.wg.Add(1)
+		go p.forward(conn)
+	}
+}
+
+func (p *failingProxy) forward(conn net.Conn) {
+	defer p.wg.Done()
+	upstream, err := net.Dial("tcp", p.target)
+	if err != nil {
+		p.log.Warn("Failed to connect to upstream", "target", p.target, "err", err)
+		_ = conn.Close()
+		return
+	}
+	if !p.track(conn, upstream) {
+		return
+	}
+	defer p.untrack(conn, upstream)
+
+	done := make(chan struct{}, 2)
+	copyConn := func(dst, src net.Conn) {
+		_, _ = io.Copy(dst, src)
+		done <- struct{}{}
+	}
+	go copyConn(upstream, conn)
+	go copyConn(conn, upstream)
+	// Closing both sides once either direction finishes unblocks the other copy.
+	<-done
+	_ = conn.Close()
+	_ = upstream.Close()
+	<-done
+}
+
+// track records the connections so they can be closed when the network fails.
+// Returns false and closes the connections if the network started failing while connecting.
+func (p *failingProxy) track(conns ...net.Conn) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.failing.Load() {
+		for _, conn := range conns {
+			_ = conn.Close()
+		}
+		return false
+	}
+	for _, conn := range conns {
+		p.conns[conn] = struct{}{}
+	}
+	return true
+}
+
+func (p *failingProxy) untrack(conns ...net.Conn) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for _, conn := range conns {
+		delete(p.conns, conn)
+	}
+}
+
+func (p *failingProxy) closeConns() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for conn := range p.conns {
+		_ = conn.Close()
+	}
+}
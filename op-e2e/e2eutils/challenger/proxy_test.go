@@ -0,0 +1,74 @@
+package challenger
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailingProxy(t *testing.T) {
+	echo, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = echo.Close() })
+	go func() {
+		for {
+			conn, err := echo.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	proxy, endpoint, err := newFailingProxy(testlog.Logger(t, log.LvlInfo), "ws://"+echo.Addr().String()+"/path")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = proxy.Close() })
+	u, err := url.Parse(endpoint)
+	require.NoError(t, err)
+	require.Equal(t, "ws", u.Scheme)
+	require.Equal(t, "/path", u.Path)
+	require.NotEqual(t, echo.Addr().String(), u.Host)
+
+	roundTrip := func(conn net.Conn) error {
+		_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+		if _, err := conn.Write([]byte("hello\n")); err != nil {
+			return err
+		}
+		line, err := bufio.NewReader(conn).ReadString('\n')
+		if err != nil {
+			return err
+		}
+		if line != "hello\n" {
+			return errors.New("unexpected response: " + line)
+		}
+		return nil
+	}
+
+	conn, err := net.Dial("tcp", u.Host)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, roundTrip(conn), "should forward while not failing")
+
+	proxy.SetFailing(true)
+	require.Error(t, roundTrip(conn), "existing connection should be closed")
+	failedConn, err := net.Dial("tcp", u.Host)
+	require.NoError(t, err)
+	defer failedConn.Close()
+	require.Error(t, roundTrip(failedConn), "new connections should be refused")
+
+	proxy.SetFailing(false)
+	restoredConn, err := net.Dial("tcp", u.Host)
+	require.NoError(t, err)
+	defer restoredConn.Close()
+	require.NoError(t, roundTrip(restoredConn), "should forward once restored")
+}
@@ -0,0 +1,71 @@
+package disputegame
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/challenger"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// StartAdversarialAlphabetGame creates an alphabet game with an invalid root claim from claimedAlphabet, sent from
+// the adversary's account, and starts a scripted adversary that defends the root claim using the same trace.
+// All of the adversary's moves are sent from its account, so the game can be played out against an honest
+// challenger started with StartHonestChallenger. The returned game helper sends transactions from the helper's
+// usual account.
+func (h *FactoryHelper) StartAdversarialAlphabetGame(ctx context.Context, adversaryKey *ecdsa.PrivateKey, claimedAlphabet string, options ...DishonestOption) (*AlphabetGameHelper, *DishonestHelper) {
+	h.require.NotEqual(CorrectAlphabet, claimedAlphabet, "adversary must claim an invalid alphabet")
+	createCtx, cancel := h.withTimeout(ctx, 3*time.Minute)
+	defer cancel()
+	chainID, err := h.client.ChainID(createCtx)
+	h.require.NoError(err)
+	adversaryOpts, err := bind.NewKeyedTransactorWithChainID(adversaryKey, chainID)
+	h.require.NoError(err)
+
+	h.waitForProposals(createCtx)
+	h.requireOutputNotFinalized(createCtx)
+	l1Head := h.checkpointL1Block(createCtx)
+	rootClaim, err := alphabetRootClaim(createCtx, claimedAlphabet)
+	h.require.NoError(err)
+	addr, err := h.createGame(createCtx, adversaryOpts, h.alphabetType, rootClaim, makeExtraData(l1Head.Uint64()))
+	h.require.NoError(err, "create adversarial alphabet game")
+
+	game := &AlphabetGameHelper{
+		FaultGameHelper: h.newGameHelper(createCtx, addr, alphabetGameDepth),
+		claimedAlphabet: claimedAlphabet,
+	}
+	adversaryGame := h.newGameHelperWithOpts(createCtx, addr, alphabetGameDepth, adversaryOpts)
+	adversary := newDishonestHelper(&adversaryGame, game.TraceProvider(claimedAlphabet), options...)
+	adversary.Start(ctx)
+	return game, adversary
+}
+
+// StartHonestChallenger starts a challenger that plays the correct alphabet trace and agrees with the proposed
+// output, so it disputes any invalid root claim. options are applied after the defaults and must supply the
+// private key.
+func (g *AlphabetGameHelper) StartHonestChallenger(ctx context.Context, l1Endpoint string, name string, options ...challenger.Option) *challenger.Helper {
+	opts := []challenger.Option{
+		func(c *config.Config) {
+			c.AgreeWithProposedOutput = true
+			c.AlphabetTrace = CorrectAlphabet
+		},
+	}
+	return g.StartChallenger(ctx, l1Endpoint, name, append(opts, options...)...)
+}
+
+// StartConcurrentHonestChallengers starts two honest challengers on the game at the same time, sending transactions
+// from firstKey and secondKey respectively. Use RequireNoDoubleMoves with both senders to check they did not repeat
+// each other's moves.
+func (g *AlphabetGameHelper) StartConcurrentHonestChallengers(ctx context.Context, l1Endpoint string, firstKey string, secondKey string) (*challenger.Helper, *challenger.Helper) {
+	g.require.NotEqual(firstKey, secondKey, "concurrent challengers must use different keys")
+	withKey := func(key string) challenger.Option {
+		return func(c *config.Config) {
+			c.TxMgrConfig.PrivateKey = key
+		}
+	}
+	first := g.StartHonestChallenger(ctx, l1Endpoint, "Challenger1", withKey(firstKey))
+	second := g.StartHonestChallenger(ctx, l1Endpoint, "Challenger2", withKey(secondKey))
+	return first, second
+}
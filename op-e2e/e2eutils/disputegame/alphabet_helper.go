@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/challenger"
 )
 
@@ -29,5 +31,17 @@ func (g *AlphabetGameHelper) StartChallenger(ctx context.Context, l1Endpoint str
 	g.t.Cleanup(func() {
 		_ = c.Close()
 	})
+	g.watchChallenger(c)
 	return c
 }
+
+// TraceProvider creates an alphabet trace provider for this game using the specified alphabet.
+func (g *AlphabetGameHelper) TraceProvider(alphabetTrace string) types.TraceProvider {
+	return alphabet.NewTraceProvider(alphabetTrace, uint64(g.maxDepth))
+}
+
+// HonestStrategy returns a strategy making the moves an honest player must make in this game, playing the correct
+// alphabet and supporting the root claim only if it is valid.
+func (g *AlphabetGameHelper) HonestStrategy() Strategy {
+	return NewTraceStrategy(g.maxDepth, g.TraceProvider(CorrectAlphabet), g.claimedAlphabet == CorrectAlphabet, NoDelay)
+}
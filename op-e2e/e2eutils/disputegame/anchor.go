@@ -0,0 +1,33 @@
+package disputegame
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AnchorRoot returns the output root and L2 block number that new games created by the helper start from.
+// This version of the game has no anchor state registry, instead the starting output is the proposal in the
+// L2OutputOracle immediately prior to the output being disputed.
+// There is no setter: the oracle only accepts outputs from the proposer, in order, for the next L2 block due, so a
+// test can't choose the anchor a game starts from.
+func (h *FactoryHelper) AnchorRoot(ctx context.Context) (common.Hash, *big.Int) {
+	opts := &bind.CallOpts{Context: ctx}
+	disputedIdx, err := h.l2oo.GetL2OutputIndexAfter(opts, new(big.Int).SetUint64(disputedL2BlockNumber))
+	h.require.NoError(err, "find disputed output index")
+	h.require.NotZero(disputedIdx.Uint64(), "no output prior to the disputed output")
+	anchor, err := h.l2oo.GetL2Output(opts, new(big.Int).Sub(disputedIdx, big.NewInt(1)))
+	h.require.NoError(err, "load anchor output")
+	return anchor.OutputRoot, anchor.L2BlockNumber
+}
+
+// RequireAnchorRoot requires that the anchor new games start from is the expected output.
+// Resolving a game doesn't modify the L2OutputOracle, so with this version of the contracts resolution never updates
+// the anchor.
+func (h *FactoryHelper) RequireAnchorRoot(ctx context.Context, expectedRoot common.Hash, expectedBlock *big.Int) {
+	root, l2BlockNum := h.AnchorRoot(ctx)
+	h.require.Equal(expectedRoot, root, "unexpected anchor root")
+	h.require.Zerof(expectedBlock.Cmp(l2BlockNum), "anchor block should be %v but was %v", expectedBlock, l2BlockNum)
+}
@@ -0,0 +1,44 @@
+package disputegame
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RequireBisectionMidpoint waits for a claim to be made against the claim at parentIdx and requires that it bisects
+// the parent's range of the trace. The claim must commit to the trace index at the midpoint of the range, and its
+// value must be the correct alphabet's value at that index.
+func (g *AlphabetGameHelper) RequireBisectionMidpoint(ctx context.Context, parentIdx int64) {
+	parent := g.getClaim(ctx, parentIdx)
+	parentPos := types.NewPositionFromGIndex(parent.Position.Uint64())
+	g.require.Lessf(parentPos.Depth(), g.maxDepth, "claim %v is at max depth so can't be bisected", parentIdx)
+	childIdx := g.waitForClaimIndex(ctx, func(claim ContractClaim) bool {
+		return int64(claim.ParentIndex) == parentIdx
+	})
+	child := g.getClaim(ctx, childIdx)
+	attack, err := isAttack(parent, child)
+	g.require.NoErrorf(err, "classify claim %v", childIdx)
+
+	expected := bisectionMidpoint(parentPos, attack, g.maxDepth)
+	childPos := types.NewPositionFromGIndex(child.Position.Uint64())
+	g.require.Equalf(expected, childPos.TraceIndex(g.maxDepth),
+		"claim %v against claim %v should commit to the midpoint trace index", childIdx, parentIdx)
+	value, err := g.TraceProvider(CorrectAlphabet).Get(ctx, expected)
+	g.require.NoErrorf(err, "load correct value at trace index %v", expected)
+	g.require.Equalf(value, common.Hash(child.Claim),
+		"claim %v against claim %v has the wrong value for trace index %v", childIdx, parentIdx, expected)
+}
+
+// bisectionMidpoint returns the trace index a claim bisecting the range of the claim at parent commits to.
+// The claim at parent commits to the last trace index of its range of 2^(maxDepth-depth) indices. An attack
+// disagrees with it so bisects that range, whereas a defense agrees so bisects the following range of the same size.
+func bisectionMidpoint(parent types.Position, isAttack bool, maxDepth int) uint64 {
+	span := uint64(1) << (maxDepth - parent.Depth())
+	end := (uint64(parent.IndexAtDepth())+1)*span - 1
+	if isAttack {
+		return end - span/2
+	}
+	return end + span/2
+}
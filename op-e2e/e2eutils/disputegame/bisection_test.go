@@ -0,0 +1,39 @@
+package disputegame
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBisectionMidpoint(t *testing.T) {
+	const maxDepth = 3
+	tests := []struct {
+		name     string
+		parent   types.Position
+		isAttack bool
+		expected uint64
+	}{
+		{name: "AttackRoot", parent: types.NewPosition(0, 0), isAttack: true, expected: 3},
+		{name: "AttackDepth1", parent: types.NewPosition(1, 0), isAttack: true, expected: 1},
+		{name: "DefendDepth1", parent: types.NewPosition(1, 0), isAttack: false, expected: 5},
+		{name: "AttackDepth2", parent: types.NewPosition(2, 2), isAttack: true, expected: 4},
+		{name: "DefendDepth2", parent: types.NewPosition(2, 2), isAttack: false, expected: 6},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, bisectionMidpoint(test.parent, test.isAttack, maxDepth))
+
+			// The midpoint must be the trace index of the position the contract requires for the move
+			var child types.Position
+			if test.isAttack {
+				child = test.parent.Attack()
+			} else {
+				child = test.parent.Defend()
+			}
+			require.Equal(t, test.expected, child.TraceIndex(maxDepth))
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	getRequiredBondSelector = crypto.Keccak256([]byte("getRequiredBond(uint128)"))[:4]
+	claimDataSelector       = crypto.Keccak256([]byte("claimData(uint256)"))[:4]
+
+	// bondedClaimDataOutputs is the layout of claimData in game versions that support bonds, which record the
+	// claimant and bond alongside each claim.
+	bondedClaimDataOutputs = mustArguments("uint32", "address", "address", "uint128", "bytes32", "uint128", "uint128")
+)
+
+func mustArguments(typeNames ...string) abi.Arguments {
+	var args abi.Arguments
+	for _, name := range typeNames {
+		typ, err := abi.NewType(name, "", nil)
+		if err != nil {
+			panic(err)
+		}
+		args = append(args, abi.Argument{Type: typ})
+	}
+	return args
+}
+
+// moveBond returns the value to attach to a move against the claim at claimIdx so it pays the bond required at the
+// new claim's position. No value is required if the game contract does not support bonds.
+func (g *FaultGameHelper) moveBond(ctx context.Context, claimIdx int64, isAttack bool) (*big.Int, error) {
+	if !g.SupportsBonds(ctx) {
+		return nil, nil
+	}
+	parent, err := g.loadBondedClaim(ctx, claimIdx)
+	if err != nil {
+		return nil, err
+	}
+	pos := types.NewPositionFromGIndex(parent.position.Uint64())
+	if isAttack {
+		pos = pos.Attack()
+	} else {
+		pos = pos.Defend()
+	}
+	return g.requiredBond(ctx, pos)
+}
+
+func (g *FaultGameHelper) requiredBond(ctx context.Context, position types.Position) (*big.Int, error) {
+	data := append(append([]byte{}, getRequiredBondSelector...), common.BigToHash(new(big.Int).SetUint64(position.ToGIndex())).Bytes()...)
+	result, err := g.client.CallContract(ctx, ethereum.CallMsg{To: &g.addr, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("call getRequiredBond: %w", err)
+	}
+	return new(big.Int).SetBytes(result), nil
+}
+
+type bondedClaim struct {
+	counteredBy common.Address
+	claimant    common.Address
+	bond        *big.Int
+	position    *big.Int
+}
+
+// loadBondedClaim loads the claim at claimIdx using the claimData layout of game versions that support bonds.
+func (g *FaultGameHelper) loadBondedClaim(ctx context.Context, claimIdx int64) (bondedClaim, error) {
+	data := append(append([]byte{}, claimDataSelector...), common.BigToHash(big.NewInt(claimIdx)).Bytes()...)
+	result, err := g.client.CallContract(ctx, ethereum.CallMsg{To: &g.addr, Data: data}, nil)
+	if err != nil {
+		return bondedClaim{}, fmt.Errorf("load claim %v: %w", claimIdx, err)
+	}
+	values, err := bondedClaimDataOutputs.Unpack(result)
+	if err != nil {
+		return bondedClaim{}, fmt.Errorf("decode claim %v: %w", claimIdx, err)
+	}
+	return bondedClaim{
+		counteredBy: values[1].(common.Address),
+		claimant:    values[2].(common.Address),
+		bond:        values[3].(*big.Int),
+		position:    values[5].(*big.Int),
+	}, nil
+}
@@ -0,0 +1,24 @@
+package disputegame
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateGameCalldata(t *testing.T) {
+	extraData := makeExtraData(1234)
+	data, err := createGameCalldata(cannonGameType, common.Hash{0xaa}, extraData)
+	require.NoError(t, err)
+
+	factoryAbi, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	require.NoError(t, err)
+	method, err := factoryAbi.MethodById(data[:4])
+	require.NoError(t, err)
+	require.Equal(t, "create", method.Name)
+	args, err := method.Inputs.Unpack(data[4:])
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{cannonGameType, [32]byte(common.Hash{0xaa}), extraData}, args)
+}
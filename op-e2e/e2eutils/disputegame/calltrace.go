@@ -0,0 +1,345 @@
+package disputegame
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/cannon"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ErrCallTracingUnsupported is returned when the RPC endpoint doesn't provide the debug namespace.
+var ErrCallTracingUnsupported = errors.New("debug namespace not available")
+
+// WithCallTracing makes the helper write the call trace of any transaction it sends that reverts to dir, or to the
+// directory in OP_E2E_ARTIFACTS_DIR if dir is empty. Tracing is skipped, with a warning, if the L1 endpoint doesn't
+// provide the debug namespace.
+func WithCallTracing(dir string) FactoryOption {
+	return func(h *FactoryHelper) {
+		h.callTracing = true
+		h.callTraceDir = dir
+	}
+}
+
+// CallTraceFiles returns the path of every call trace written by the helper and the games it created, in the order
+// they were written. Requires the helper to be created with WithCallTracing.
+func (h *FactoryHelper) CallTraceFiles() []string {
+	h.require.True(h.callTracing, "call tracing not enabled")
+	return h.callTracer.Files()
+}
+
+// enableCallTracing configures the helper's factory to trace reverted transactions, if supported by the endpoint.
+func (h *FactoryHelper) enableCallTracing(ctx context.Context, deployments *genesis.L1Deployments) {
+	dir := h.callTraceDir
+	if dir == "" {
+		dir = os.Getenv(ArtifactsDirEnvVar)
+	}
+	if dir == "" {
+		h.t.Logf("WARNING: call tracing enabled but no directory to write traces to")
+		return
+	}
+	tracer, err := NewCallTracer(ctx, h.client, filepath.Join(dir, unsafeFileNameChars.ReplaceAllString(h.t.Name(), "_")), deployments)
+	if errors.Is(err, ErrCallTracingUnsupported) {
+		h.t.Logf("WARNING: call tracing disabled: %v", err)
+		return
+	}
+	h.require.NoError(err)
+	h.callTracer = tracer
+	h.SetCallTracer(tracer)
+}
+
+// CallTracer writes the call trace of reverted transactions, fetched from the debug namespace, to a directory.
+// Traces are pruned to the frames within labeled contracts so only the game, VM, preimage oracle and other known
+// deployments remain. A nil CallTracer traces nothing.
+type CallTracer struct {
+	rpc    *rpc.Client
+	dir    string
+	labels map[common.Address]string
+
+	lock  sync.Mutex
+	files []string
+}
+
+// CallTrace is the file written for a reverted transaction.
+type CallTrace struct {
+	Method string `json:"method"`
+	// TxHash is the hash of the reverted transaction, or empty if it was rejected before being sent, in which case
+	// the call was traced instead.
+	TxHash common.Hash `json:"txHash,omitempty"`
+	Error  string      `json:"error"`
+	Trace  *CallFrame  `json:"trace"`
+}
+
+// CallFrame is a call in the tree reported by geth's callTracer, with the contract called labeled if it is known.
+type CallFrame struct {
+	Type         string         `json:"type"`
+	From         common.Address `json:"from"`
+	To           common.Address `json:"to"`
+	Label        string         `json:"label,omitempty"`
+	Value        *hexutil.Big   `json:"value,omitempty"`
+	Input        hexutil.Bytes  `json:"input"`
+	Output       hexutil.Bytes  `json:"output,omitempty"`
+	Error        string         `json:"error,omitempty"`
+	RevertReason string         `json:"revertReason,omitempty"`
+	Calls        []*CallFrame   `json:"calls,omitempty"`
+}
+
+// NewCallTracer creates a CallTracer writing traces to dir, with contracts labeled by their name in deployments.
+// Returns ErrCallTracingUnsupported if client's endpoint doesn't provide the debug namespace.
+func NewCallTracer(ctx context.Context, client *ethclient.Client, dir string, deployments *genesis.L1Deployments) (*CallTracer, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	var modules map[string]string
+	if err := client.Client().CallContext(ctx, &modules, "rpc_modules"); err != nil {
+		return nil, fmt.Errorf("%w: list modules: %v", ErrCallTracingUnsupported, err)
+	}
+	if _, ok := modules["debug"]; !ok {
+		return nil, ErrCallTracingUnsupported
+	}
+	labels := make(map[common.Address]string)
+	if deployments != nil {
+		deployments.ForEach(func(name string, addr common.Address) {
+			if addr != (common.Address{}) {
+				labels[addr] = name
+			}
+		})
+	}
+	return &CallTracer{rpc: client.Client(), dir: dir, labels: labels}, nil
+}
+
+// Files returns the path of every trace written, in the order they were written.
+func (c *CallTracer) Files() []string {
+	if c == nil {
+		return nil
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return append([]string{}, c.files...)
+}
+
+// traceRevert writes the trace of the reverted method call to the tracer's directory. If rcpt is nil the transaction
+// was rejected before being sent, so msg is traced against the latest block instead. labels identifies additional
+// contracts, such as the game being played.
+func (c *CallTracer) traceRevert(ctx context.Context, method string, rcpt *ethtypes.Receipt, msg ethereum.CallMsg, labels map[common.Address]string, cause error) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	tracerCfg := map[string]any{"tracer": "callTracer"}
+	var frame CallFrame
+	var err error
+	result := CallTrace{Method: method, Error: cause.Error()}
+	if rcpt != nil {
+		result.TxHash = rcpt.TxHash
+		err = c.rpc.CallContext(ctx, &frame, "debug_traceTransaction", rcpt.TxHash, tracerCfg)
+	} else {
+		err = c.rpc.CallContext(ctx, &frame, "debug_traceCall", toTraceCallArg(msg), "latest", tracerCfg)
+	}
+	if err != nil {
+		return fmt.Errorf("trace %v: %w", method, err)
+	}
+	allLabels := make(map[common.Address]string, len(c.labels)+len(labels))
+	for addr, label := range c.labels {
+		allLabels[addr] = label
+	}
+	for addr, label := range labels {
+		allLabels[addr] = label
+	}
+	result.Trace = pruneCallFrame(&frame, allLabels, true)
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode %v trace: %w", method, err)
+	}
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return fmt.Errorf("create call trace dir: %w", err)
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	path := filepath.Join(c.dir, fmt.Sprintf("%03d-%v.calltrace.json", len(c.files), method))
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("write %v trace: %w", method, err)
+	}
+	c.files = append(c.files, path)
+	return nil
+}
+
+// shouldTrace returns true if tracing is enabled and the failure is a revert, either of the transaction with receipt
+// rcpt or, if rcpt is nil, reported by the node when the transaction was rejected.
+func (c *CallTracer) shouldTrace(rcpt *ethtypes.Receipt, cause error) bool {
+	if c == nil {
+		return false
+	}
+	if rcpt != nil {
+		return rcpt.Status == ethtypes.ReceiptStatusFailed
+	}
+	_, ok := extractRevertData(cause)
+	return ok
+}
+
+// pruneCallFrame labels frame and its calls using labels, removing calls to unlabeled contracts unless they lead to
+// a labeled contract. The top level frame is always kept if keepRoot is true. Returns nil if the frame is removed.
+func pruneCallFrame(frame *CallFrame, labels map[common.Address]string, keepRoot bool) *CallFrame {
+	pruned := *frame
+	pruned.Label = labels[frame.To]
+	pruned.Calls = nil
+	for _, call := range frame.Calls {
+		if kept := pruneCallFrame(call, labels, false); kept != nil {
+			pruned.Calls = append(pruned.Calls, kept)
+		}
+	}
+	if !keepRoot && pruned.Label == "" && len(pruned.Calls) == 0 {
+		return nil
+	}
+	return &pruned
+}
+
+func toTraceCallArg(msg ethereum.CallMsg) map[string]any {
+	arg := map[string]any{
+		"from":  msg.From,
+		"to":    msg.To,
+		"input": hexutil.Bytes(msg.Data),
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	return arg
+}
+
+// SetCallTracer sets the tracer used to record reverted transactions sent by this factory and any games it
+// subsequently creates. Tracing is disabled if tracer is nil.
+func (c *FactoryCore) SetCallTracer(tracer *CallTracer) {
+	c.callTracer = tracer
+}
+
+// SetCallTracer sets the tracer used to record reverted transactions sent by the game.
+func (g *GameCore) SetCallTracer(tracer *CallTracer) {
+	g.callTracer = tracer
+}
+
+// traceFactoryRevert records the trace of the factory method call with args if it failed with cause because it
+// reverted. rcpt is the receipt of the reverted transaction, or nil if it was rejected before being sent.
+// cause is returned, including any failure to trace it.
+func (c *FactoryCore) traceFactoryRevert(ctx context.Context, opts *bind.TransactOpts, rcpt *ethtypes.Receipt, cause error, method string, args ...any) error {
+	if !c.callTracer.shouldTrace(rcpt, cause) {
+		return cause
+	}
+	msg, err := packCall(bindings.DisputeGameFactoryMetaData, opts, c.factoryAddr, method, args...)
+	if err == nil {
+		err = c.callTracer.traceRevert(ctx, method, rcpt, msg, nil, cause)
+	}
+	if err != nil {
+		return fmt.Errorf("%w (call trace failed: %v)", cause, err)
+	}
+	return cause
+}
+
+// traceGameRevert is the equivalent of traceFactoryRevert for the game's methods. The game, its VM and, for cannon
+// games, the preimage oracle are labeled in the trace.
+func (g *GameCore) traceGameRevert(ctx context.Context, opts *bind.TransactOpts, rcpt *ethtypes.Receipt, cause error, method string, args ...any) error {
+	if !g.callTracer.shouldTrace(rcpt, cause) {
+		return cause
+	}
+	msg, err := packCall(bindings.FaultDisputeGameMetaData, opts, g.addr, method, args...)
+	if err == nil {
+		err = g.callTracer.traceRevert(ctx, method, rcpt, msg, g.contractLabels(ctx), cause)
+	}
+	if err != nil {
+		return fmt.Errorf("%w (call trace failed: %v)", cause, err)
+	}
+	return cause
+}
+
+// contractLabels returns labels for the game and the contracts it calls.
+func (g *GameCore) contractLabels(ctx context.Context) map[common.Address]string {
+	labels := map[common.Address]string{g.addr: "FaultDisputeGame"}
+	opts := &bind.CallOpts{Context: ctx}
+	vm, err := g.game.VM(opts)
+	if err != nil {
+		return labels
+	}
+	labels[vm] = "VM"
+	mips, err := bindings.NewMIPSCaller(vm, g.client)
+	if err != nil {
+		return labels
+	}
+	// Only the MIPS VM has a preimage oracle so the call fails for the alphabet VM.
+	if oracle, err := mips.Oracle(opts); err == nil && oracle != (common.Address{}) {
+		labels[vm] = "MIPS"
+		labels[oracle] = "PreimageOracle"
+	}
+	return labels
+}
+
+func packCall(metadata *bind.MetaData, opts *bind.TransactOpts, to common.Address, method string, args ...any) (ethereum.CallMsg, error) {
+	contractAbi, err := metadata.GetAbi()
+	if err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("load abi: %w", err)
+	}
+	data, err := contractAbi.Pack(method, args...)
+	if err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("pack %v call: %w", method, err)
+	}
+	value := opts.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	return ethereum.CallMsg{From: opts.From, To: &to, Value: value, Data: data}, nil
+}
+
+// RequireStepRevertTraced plays game, which must have been created from a cannon fixture, down to max depth and then
+// steps against the leaf claim with the correct prestate but a corrupted memory proof, so the step reverts inside the
+// MIPS VM rather than the game. It requires that a call trace of the step is written with the failing MIPS frame
+// labeled. Requires the helper to be created with WithCallTracing and an endpoint providing the debug namespace.
+func (h *FactoryHelper) RequireStepRevertTraced(ctx context.Context, game *CannonGameHelper) {
+	h.require.NotEmpty(game.fixtureDir, "game must be created from a cannon fixture")
+	h.require.NotNil(h.callTracer, "call tracing not enabled")
+	claimIdx := int64(0)
+	for i := 1; i <= game.maxDepth; i++ {
+		game.Attack(ctx, claimIdx, common.Hash{0xaa, byte(i)})
+		claimIdx = game.ClaimCount(ctx) - 1
+	}
+
+	// The leaf is the first trace index so is attacked from the absolute prestate with the proof for the first step.
+	provider, err := cannon.NewFixtureTraceProvider(&config.Config{CannonFixtureDir: game.fixtureDir, CannonDatadir: h.t.TempDir()})
+	h.require.NoError(err, "create fixture trace provider")
+	stateData, proof, err := provider.GetPreimage(ctx, 0)
+	h.require.NoError(err, "load proof for first step")
+	// Corrupt the leaf of the memory proof for the instruction, which is always checked.
+	proof = append([]byte{}, proof...)
+	proof[0] ^= 0xff
+	tracesBefore := len(h.CallTraceFiles())
+	err = game.TryStep(ctx, claimIdx, true, stateData, proof)
+	h.require.Error(err, "step with corrupted proof should revert")
+
+	files := h.CallTraceFiles()
+	h.require.Len(files, tracesBefore+1, "reverted step should be traced")
+	data, err := os.ReadFile(files[len(files)-1])
+	h.require.NoError(err)
+	var trace CallTrace
+	h.require.NoError(json.Unmarshal(data, &trace), "decode call trace")
+	h.require.Equal("step", trace.Method)
+	h.require.Equal("FaultDisputeGame", trace.Trace.Label)
+	var vmFrame *CallFrame
+	for _, call := range trace.Trace.Calls {
+		if call.Label == "MIPS" {
+			vmFrame = call
+		}
+	}
+	h.require.NotNil(vmFrame, "call trace should include the MIPS VM")
+	h.require.NotEmpty(vmFrame.Error, "MIPS VM call should have reverted")
+}
@@ -0,0 +1,135 @@
+package disputegame
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	tracedGame   = common.Address{0x01}
+	tracedVM     = common.Address{0x02}
+	tracedOracle = common.Address{0x03}
+	tracedOther  = common.Address{0x04}
+)
+
+func tracedCallTree() *CallFrame {
+	return &CallFrame{
+		Type: "CALL",
+		To:   tracedGame,
+		Calls: []*CallFrame{
+			{Type: "STATICCALL", To: tracedOther},
+			{Type: "CALL", To: tracedVM, Error: "execution reverted", Calls: []*CallFrame{
+				{Type: "STATICCALL", To: tracedOracle},
+				{Type: "STATICCALL", To: tracedOther},
+			}},
+			{Type: "CALL", To: tracedOther, Calls: []*CallFrame{
+				{Type: "CALL", To: tracedOracle},
+			}},
+		},
+	}
+}
+
+func TestPruneCallFrame(t *testing.T) {
+	labels := map[common.Address]string{
+		tracedGame:   "FaultDisputeGame",
+		tracedVM:     "MIPS",
+		tracedOracle: "PreimageOracle",
+	}
+	pruned := pruneCallFrame(tracedCallTree(), labels, true)
+	require.Equal(t, "FaultDisputeGame", pruned.Label)
+	require.Len(t, pruned.Calls, 2)
+
+	vm := pruned.Calls[0]
+	require.Equal(t, "MIPS", vm.Label)
+	require.Equal(t, "execution reverted", vm.Error)
+	require.Len(t, vm.Calls, 1)
+	require.Equal(t, "PreimageOracle", vm.Calls[0].Label)
+
+	// Unlabeled frames are kept when they lead to a labeled contract.
+	other := pruned.Calls[1]
+	require.Empty(t, other.Label)
+	require.Len(t, other.Calls, 1)
+	require.Equal(t, "PreimageOracle", other.Calls[0].Label)
+
+	unlabeledRoot := pruneCallFrame(&CallFrame{To: tracedOther}, labels, true)
+	require.NotNil(t, unlabeledRoot, "root frame should always be kept")
+	require.Nil(t, pruneCallFrame(&CallFrame{To: tracedOther}, labels, false))
+}
+
+func TestShouldTrace(t *testing.T) {
+	revert := rpcDataError{data: "0x12345678"}
+	var disabled *CallTracer
+	require.False(t, disabled.shouldTrace(nil, revert))
+
+	tracer := &CallTracer{}
+	require.True(t, tracer.shouldTrace(nil, revert))
+	require.False(t, tracer.shouldTrace(nil, errors.New("connection refused")))
+	require.True(t, tracer.shouldTrace(&ethtypes.Receipt{Status: ethtypes.ReceiptStatusFailed}, errors.New("status 0")))
+	require.False(t, tracer.shouldTrace(&ethtypes.Receipt{Status: ethtypes.ReceiptStatusSuccessful}, errors.New("timeout")))
+}
+
+type rpcDataError struct {
+	data string
+}
+
+func (e rpcDataError) Error() string          { return "execution reverted" }
+func (e rpcDataError) ErrorData() interface{} { return e.data }
+
+type stubDebugAPI struct{}
+
+func (stubDebugAPI) TraceCall(_ context.Context, _ map[string]any, _ string, _ map[string]any) (*CallFrame, error) {
+	return tracedCallTree(), nil
+}
+
+func TestTraceRevertWritesLabeledTrace(t *testing.T) {
+	server := rpc.NewServer()
+	t.Cleanup(server.Stop)
+	require.NoError(t, server.RegisterName("debug", stubDebugAPI{}))
+	client := ethclient.NewClient(rpc.DialInProc(server))
+	t.Cleanup(client.Close)
+
+	dir := t.TempDir()
+	ctx := context.Background()
+	tracer, err := NewCallTracer(ctx, client, dir, &genesis.L1Deployments{DisputeGameFactoryProxy: tracedOther})
+	require.NoError(t, err)
+	labels := map[common.Address]string{tracedGame: "FaultDisputeGame", tracedVM: "MIPS"}
+	err = tracer.traceRevert(ctx, "step", nil, ethereum.CallMsg{To: &tracedGame}, labels, errors.New("step reverted"))
+	require.NoError(t, err)
+
+	files := tracer.Files()
+	require.Len(t, files, 1)
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	var trace CallTrace
+	require.NoError(t, json.Unmarshal(data, &trace))
+	require.Equal(t, "step", trace.Method)
+	require.Equal(t, "step reverted", trace.Error)
+	require.Equal(t, common.Hash{}, trace.TxHash)
+	require.Equal(t, "FaultDisputeGame", trace.Trace.Label)
+	// Every call is to a labeled contract now the deployments are included, other than the oracle.
+	require.Len(t, trace.Trace.Calls, 3)
+	require.Equal(t, "DisputeGameFactoryProxy", trace.Trace.Calls[0].Label)
+	require.Equal(t, "MIPS", trace.Trace.Calls[1].Label)
+	require.Len(t, trace.Trace.Calls[1].Calls, 1)
+}
+
+func TestNewCallTracerRequiresDebugNamespace(t *testing.T) {
+	server := rpc.NewServer()
+	t.Cleanup(server.Stop)
+	client := ethclient.NewClient(rpc.DialInProc(server))
+	t.Cleanup(client.Close)
+
+	_, err := NewCallTracer(context.Background(), client, t.TempDir(), nil)
+	require.ErrorIs(t, err, ErrCallTracingUnsupported)
+}
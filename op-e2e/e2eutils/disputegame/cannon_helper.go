@@ -2,20 +2,43 @@ package disputegame
 
 import (
 	"context"
+	"errors"
+	"os"
+	"time"
 
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/cannon"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/challenger"
 )
 
 type CannonGameHelper struct {
 	FaultGameHelper
+	// fixtureDir is the pre-generated cannon trace the game was created from, if any.
+	fixtureDir string
+	// absolutePreState is the state challengers run cannon from, if not the op-program prestate.
+	absolutePreState string
+	// expectedTraceDir is a trace of the game's program generated in-process when the game was created, if any.
+	expectedTraceDir string
 }
 
 func (g *CannonGameHelper) StartChallenger(ctx context.Context, l1Endpoint string, l2Endpoint string, name string, options ...challenger.Option) *challenger.Helper {
+	opts := append(g.defaultChallengerOptions(l2Endpoint), options...)
+	c := challenger.NewChallenger(g.t, ctx, l1Endpoint, name, opts...)
+	g.t.Cleanup(func() {
+		_ = c.Close()
+	})
+	g.watchChallenger(c)
+	return c
+}
+
+// defaultChallengerOptions configures a challenger to play the game with cannon, using the fixture the game was
+// created from or the game's own prestate if any.
+func (g *CannonGameHelper) defaultChallengerOptions(l2Endpoint string) []challenger.Option {
 	opts := []challenger.Option{
 		func(c *config.Config) {
 			c.GameAddress = g.addr
-			c.GameDepth = cannonGameDepth
+			c.GameDepth = g.maxDepth
 			c.TraceType = config.TraceTypeCannon
 			c.AgreeWithProposedOutput = false
 			c.CannonL2 = l2Endpoint
@@ -26,10 +49,43 @@ func (g *CannonGameHelper) StartChallenger(ctx context.Context, l1Endpoint strin
 			c.CannonSnapshotFreq = config.DefaultCannonSnapshotFreq
 		},
 	}
-	opts = append(opts, options...)
-	c := challenger.NewChallenger(g.t, ctx, l1Endpoint, name, opts...)
-	g.t.Cleanup(func() {
-		_ = c.Close()
+	if g.absolutePreState != "" {
+		opts = append(opts, func(c *config.Config) {
+			c.CannonAbsolutePreState = g.absolutePreState
+		})
+	}
+	if g.fixtureDir != "" {
+		opts = append(opts, challenger.WithCannonFixture(g.fixtureDir))
+	}
+	return opts
+}
+
+// ExpectedTrace returns a trace provider for the trace generated when the game was created, to check the claims made
+// by challengers executing cannon against.
+func (g *CannonGameHelper) ExpectedTrace() types.TraceProvider {
+	g.require.NotEmpty(g.expectedTraceDir, "game has no expected trace")
+	provider, err := cannon.NewFixtureTraceProvider(&config.Config{CannonFixtureDir: g.expectedTraceDir, CannonDatadir: g.t.TempDir()})
+	g.require.NoError(err, "create expected trace provider")
+	return provider
+}
+
+// RequireCannonInputsMatchGame waits for the challenger c to record the inputs it runs cannon with and requires that
+// they use the game's L1 head rather than the challenger's own view of L1.
+func (g *CannonGameHelper) RequireCannonInputsMatchGame(ctx context.Context, c *challenger.Helper) {
+	ctx, cancel := g.withTimeout(ctx, time.Minute)
+	defer cancel()
+	dir := c.CannonDatadir()
+	g.require.NotEmpty(dir, "challenger does not use cannon")
+	var inputs cannon.Inputs
+	err := g.waitFor(ctx, time.Second, func() (bool, error) {
+		var err error
+		inputs, err = cannon.ReadInputs(dir)
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return err == nil, err
 	})
-	return c
+	g.require.NoError(err, "cannon inputs were not recorded")
+	g.require.Equal(g.L1Head(ctx), inputs.L1Head, "cannon should use the game's L1 head")
+	g.require.Equal(g.L2BlockNumber(ctx), inputs.L2BlockNumber.Uint64(), "cannon should use the game's L2 block number")
 }
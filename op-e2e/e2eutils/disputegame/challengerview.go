@@ -0,0 +1,82 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/challenger"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AssertChallengerViewMatchesChain fails the test unless the claims of game as last loaded by c match the claims on
+// chain, listing every field that differs. The challenger reloads claims each time it acts on the game so its view
+// is allowed to lag the chain briefly, but must catch up within 30 seconds.
+func (h *FactoryHelper) AssertChallengerViewMatchesChain(ctx context.Context, c *challenger.Helper, game common.Address) {
+	ctx, cancel := h.withTimeout(ctx, 30*time.Second)
+	defer cancel()
+	core, err := h.Game(game)
+	h.require.NoError(err)
+	var diffs []string
+	err = waitFor(ctx, h.clock, time.Second, func() (bool, error) {
+		claims, err := core.LoadClaims(ctx)
+		if err != nil {
+			return false, err
+		}
+		view, err := c.GameView(game)
+		if os.IsNotExist(err) {
+			diffs = []string{"  challenger has not loaded the game's claims"}
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		diffs = diffChallengerView(claims, view)
+		return len(diffs) == 0, nil
+	})
+	if len(diffs) > 0 {
+		h.require.Failf("challenger view differs from chain", "game %v:\n%v", game, strings.Join(diffs, "\n"))
+	}
+	h.require.NoErrorf(err, "compare challenger view of game %v", game)
+}
+
+// diffChallengerView describes each field of each claim in the challenger's view that differs from the claims on
+// chain, along with any claims only one side has.
+func diffChallengerView(chain []ContractClaim, view fault.GameView) []string {
+	var diffs []string
+	diff := func(idx int, field string, onChain interface{}, viewed interface{}) {
+		diffs = append(diffs, fmt.Sprintf("  claim %v %v: chain has %v but challenger has %v", idx, field, onChain, viewed))
+	}
+	for i := 0; i < len(chain) || i < len(view.Claims); i++ {
+		if i >= len(view.Claims) {
+			diffs = append(diffs, fmt.Sprintf("  claim %v: on chain but missing from challenger view", i))
+			continue
+		}
+		if i >= len(chain) {
+			diffs = append(diffs, fmt.Sprintf("  claim %v: in challenger view but not on chain", i))
+			continue
+		}
+		claim, viewed := chain[i], view.Claims[i]
+		if viewed.Index != i {
+			diff(i, "index", i, viewed.Index)
+		}
+		if int(claim.ParentIndex) != viewed.ParentIndex {
+			diff(i, "parent index", claim.ParentIndex, viewed.ParentIndex)
+		}
+		if claim.Position.Uint64() != viewed.Position {
+			diff(i, "position", claim.Position, viewed.Position)
+		}
+		if common.Hash(claim.Claim) != viewed.Claim {
+			diff(i, "claim", common.Hash(claim.Claim), viewed.Claim)
+		}
+		if claim.Countered != viewed.Countered {
+			diff(i, "countered", claim.Countered, viewed.Countered)
+		}
+		if claim.Clock.Uint64() != viewed.Clock {
+			diff(i, "clock", claim.Clock, viewed.Clock)
+		}
+	}
+	return diffs
+}
@@ -0,0 +1,51 @@
+package disputegame
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffChallengerView(t *testing.T) {
+	chain := []ContractClaim{
+		{ParentIndex: math.MaxUint32, Claim: common.Hash{0x01}, Position: big.NewInt(1), Clock: big.NewInt(10), Countered: true},
+		{ParentIndex: 0, Claim: common.Hash{0x02}, Position: big.NewInt(2), Clock: big.NewInt(20)},
+	}
+	matching := func() fault.GameView {
+		return fault.GameView{
+			Game: common.Address{0xaa},
+			Claims: []fault.ClaimView{
+				{Index: 0, ParentIndex: math.MaxUint32, Position: 1, Claim: common.Hash{0x01}, Countered: true, Clock: 10},
+				{Index: 1, ParentIndex: 0, Position: 2, Claim: common.Hash{0x02}, Clock: 20},
+			},
+		}
+	}
+
+	t.Run("Matching", func(t *testing.T) {
+		require.Empty(t, diffChallengerView(chain, matching()))
+	})
+
+	t.Run("FieldDiffers", func(t *testing.T) {
+		view := matching()
+		view.Claims[1].Countered = true
+		view.Claims[1].Claim = common.Hash{0xff}
+		require.Equal(t, []string{
+			"  claim 1 claim: chain has " + common.Hash{0x02}.String() + " but challenger has " + common.Hash{0xff}.String(),
+			"  claim 1 countered: chain has false but challenger has true",
+		}, diffChallengerView(chain, view))
+	})
+
+	t.Run("MissingFromView", func(t *testing.T) {
+		view := matching()
+		view.Claims = view.Claims[:1]
+		require.Equal(t, []string{"  claim 1: on chain but missing from challenger view"}, diffChallengerView(chain, view))
+	})
+
+	t.Run("NotOnChain", func(t *testing.T) {
+		require.Equal(t, []string{"  claim 1: in challenger view but not on chain"}, diffChallengerView(chain[:1], matching()))
+	})
+}
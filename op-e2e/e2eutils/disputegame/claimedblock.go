@@ -0,0 +1,67 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// RequireClaimedBlockProposed fails the test unless the L2 block number claimed in the game's extra data is covered
+// by the output proposals the game recorded at creation and those proposals still match the L2OutputOracle.
+// The game disputes the first output proposed at or after the claimed block, so the starting output must be before
+// the claimed block and the disputed output at or after it.
+func (g *FaultGameHelper) RequireClaimedBlockProposed(ctx context.Context) {
+	opts := &bind.CallOpts{Context: ctx}
+	claimed := g.L2BlockNumber(ctx)
+	proposals, err := g.game.Proposals(opts)
+	g.require.NoError(err, "load game proposals")
+	g.require.NoErrorf(checkClaimedBlockCovered(claimed, proposals.Starting, proposals.Disputed), "game %v", g.addr)
+
+	l2ooAddr, err := g.game.L2OUTPUTORACLE(opts)
+	g.require.NoError(err, "load L2OutputOracle address")
+	l2oo, err := bindings.NewL2OutputOracleCaller(l2ooAddr, g.client)
+	g.require.NoError(err)
+	for _, proposal := range []bindings.IFaultDisputeGameOutputProposal{proposals.Starting, proposals.Disputed} {
+		output, err := l2oo.GetL2Output(opts, proposal.Index)
+		g.require.NoErrorf(err, "load output %v", proposal.Index)
+		g.require.Equalf(proposal.L2BlockNumber.Uint64(), output.L2BlockNumber.Uint64(),
+			"game %v recorded output %v with a different L2 block number to the L2OutputOracle", g.addr, proposal.Index)
+		g.require.Equalf(common.Hash(proposal.OutputRoot), common.Hash(output.OutputRoot),
+			"game %v recorded output %v with a different output root to the L2OutputOracle", g.addr, proposal.Index)
+	}
+}
+
+// RequireClaimedBlockTime fails the test unless the L2 blocks for the claimed block and the disputed output were
+// produced blockTime seconds apart from the L2 genesis block, so anything converting between L2 block numbers and
+// timestamps with the default e2e block time picks the wrong block.
+// l2Client must be connected to a node of a chain starting at L2 block 0.
+func (g *FaultGameHelper) RequireClaimedBlockTime(ctx context.Context, l2Client *ethclient.Client, blockTime uint64) {
+	genesis, err := l2Client.HeaderByNumber(ctx, big.NewInt(0))
+	g.require.NoError(err, "load L2 genesis block")
+	proposals, err := g.game.Proposals(&bind.CallOpts{Context: ctx})
+	g.require.NoError(err, "load game proposals")
+	for _, blockNum := range []uint64{g.L2BlockNumber(ctx), proposals.Disputed.L2BlockNumber.Uint64()} {
+		header, err := l2Client.HeaderByNumber(ctx, new(big.Int).SetUint64(blockNum))
+		g.require.NoErrorf(err, "load L2 block %v", blockNum)
+		g.require.Equalf(genesis.Time+blockNum*blockTime, header.Time, "L2 block %v has unexpected timestamp with block time %v",
+			blockNum, blockTime)
+	}
+}
+
+func checkClaimedBlockCovered(claimed uint64, starting bindings.IFaultDisputeGameOutputProposal, disputed bindings.IFaultDisputeGameOutputProposal) error {
+	if disputed.Index.Uint64() != starting.Index.Uint64()+1 {
+		return fmt.Errorf("disputed output %v does not follow starting output %v", disputed.Index, starting.Index)
+	}
+	if starting.L2BlockNumber.Uint64() >= claimed {
+		return fmt.Errorf("starting output %v is for L2 block %v, not before claimed block %v", starting.Index, starting.L2BlockNumber, claimed)
+	}
+	if disputed.L2BlockNumber.Uint64() < claimed {
+		return fmt.Errorf("disputed output %v is for L2 block %v, before claimed block %v", disputed.Index, disputed.L2BlockNumber, claimed)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package disputegame
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckClaimedBlockCovered(t *testing.T) {
+	proposal := func(idx uint64, l2BlockNum uint64) bindings.IFaultDisputeGameOutputProposal {
+		return bindings.IFaultDisputeGameOutputProposal{Index: new(big.Int).SetUint64(idx), L2BlockNumber: new(big.Int).SetUint64(l2BlockNum)}
+	}
+
+	t.Run("DisputedAtClaimedBlock", func(t *testing.T) {
+		require.NoError(t, checkClaimedBlockCovered(8, proposal(3, 6), proposal(4, 8)))
+	})
+
+	t.Run("DisputedAfterClaimedBlock", func(t *testing.T) {
+		require.NoError(t, checkClaimedBlockCovered(8, proposal(1, 6), proposal(2, 12)))
+	})
+
+	t.Run("StartingAtClaimedBlock", func(t *testing.T) {
+		err := checkClaimedBlockCovered(8, proposal(1, 8), proposal(2, 12))
+		require.ErrorContains(t, err, "not before claimed block 8")
+	})
+
+	t.Run("DisputedBeforeClaimedBlock", func(t *testing.T) {
+		err := checkClaimedBlockCovered(8, proposal(1, 4), proposal(2, 6))
+		require.ErrorContains(t, err, "before claimed block 8")
+	})
+
+	t.Run("NonConsecutiveOutputs", func(t *testing.T) {
+		err := checkClaimedBlockCovered(8, proposal(1, 6), proposal(3, 12))
+		require.ErrorContains(t, err, "does not follow starting output")
+	})
+}
@@ -0,0 +1,30 @@
+package disputegame
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+)
+
+// waitFor is equivalent to utils.WaitFor but uses the supplied clock to schedule polling.
+// This allows tests to control exactly when each poll occurs, for example in combination with L1 time travel.
+func waitFor(ctx context.Context, clk clock.Clock, rate time.Duration, cb func() (bool, error)) error {
+	tick := clk.NewTicker(rate)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-tick.Ch():
+			done, err := cb()
+			if err != nil {
+				return err
+			}
+			if done {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package disputegame
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForUsesClock(t *testing.T) {
+	clk := clock.NewDeterministicClock(time.Unix(0, 0))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	calls := make(chan struct{}, 10)
+	var done atomic.Bool
+	result := make(chan error, 1)
+	go func() {
+		result <- waitFor(ctx, clk, time.Second, func() (bool, error) {
+			calls <- struct{}{}
+			return done.Load(), nil
+		})
+	}()
+	require.True(t, clk.WaitForNewPendingTaskWithTimeout(5*time.Second), "ticker should be created")
+
+	// Nothing should happen until the clock advances
+	require.Never(t, func() bool { return len(calls) > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+
+	clk.AdvanceTime(time.Second)
+	<-calls
+
+	done.Store(true)
+	clk.AdvanceTime(time.Second)
+	<-calls
+	require.NoError(t, <-result)
+}
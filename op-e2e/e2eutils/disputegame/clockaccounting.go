@@ -0,0 +1,57 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RequireClockAccounting fails the test unless every claim's clock is consistent with the chess clock rules.
+// Each move is charged to the player making it, so a claim's accumulated duration must equal the duration on its
+// grandparent's clock, which belongs to the same player, plus the time elapsed since its parent was made.
+func (g *FaultGameHelper) RequireClockAccounting(ctx context.Context) {
+	claims := g.GetAllClaims(ctx)
+	err := checkClockAccounting(claims, g.creation.Timestamp, g.GameDuration(ctx))
+	g.require.NoErrorf(err, "game %v has inconsistent clocks", g.addr)
+}
+
+// checkClockAccounting verifies the clocks of claims, in claim index order, for a game created at createdAt.
+// No clock may have accumulated more than half the game duration, as that is the time available to each player.
+func checkClockAccounting(claims []ContractClaim, createdAt uint64, gameDuration time.Duration) error {
+	if len(claims) == 0 {
+		return fmt.Errorf("no root claim")
+	}
+	root := claims[0]
+	if root.ClockDuration() != 0 {
+		return fmt.Errorf("root claim has accumulated duration %v", root.ClockDuration())
+	}
+	if root.ClockTimestamp() != createdAt {
+		return fmt.Errorf("root claim made at %v but game created at %v", root.ClockTimestamp(), createdAt)
+	}
+	for i := 1; i < len(claims); i++ {
+		claim := claims[i]
+		if int(claim.ParentIndex) >= i {
+			return fmt.Errorf("claim %v responds to later claim %v", i, claim.ParentIndex)
+		}
+		parent := claims[claim.ParentIndex]
+		if claim.ClockTimestamp() < parent.ClockTimestamp() {
+			return fmt.Errorf("claim %v made at %v before its parent %v at %v",
+				i, claim.ClockTimestamp(), claim.ParentIndex, parent.ClockTimestamp())
+		}
+		// Moves against the root claim start the challenger's clock from zero.
+		var previous time.Duration
+		if claim.ParentIndex != 0 {
+			previous = claims[parent.ParentIndex].ClockDuration()
+		}
+		elapsed := time.Duration(claim.ClockTimestamp()-parent.ClockTimestamp()) * time.Second
+		if expected := previous + elapsed; claim.ClockDuration() != expected {
+			return fmt.Errorf("claim %v has accumulated duration %v but expected %v (%v previously used plus %v elapsed)",
+				i, claim.ClockDuration(), expected, previous, elapsed)
+		}
+		if claim.ClockDuration() > gameDuration/2 {
+			return fmt.Errorf("claim %v has accumulated duration %v, more than half the game duration %v",
+				i, claim.ClockDuration(), gameDuration)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package disputegame
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckClockAccounting(t *testing.T) {
+	const createdAt = 1000
+	const gameDuration = 100 * time.Second
+	claimWithClock := func(parentIdx uint32, duration uint64, timestamp uint64) ContractClaim {
+		clock := new(big.Int).Lsh(new(big.Int).SetUint64(duration), 64)
+		return ContractClaim{
+			ParentIndex: parentIdx,
+			Position:    big.NewInt(1),
+			Clock:       clock.Or(clock, new(big.Int).SetUint64(timestamp)),
+		}
+	}
+	// Alternating moves, with the challenger taking 10s and 5s and the defender 7s.
+	honest := func() []ContractClaim {
+		return []ContractClaim{
+			claimWithClock(math.MaxUint32, 0, createdAt),
+			claimWithClock(0, 10, createdAt+10),
+			claimWithClock(1, 7, createdAt+17),
+			claimWithClock(2, 15, createdAt+22),
+		}
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		require.NoError(t, checkClockAccounting(honest(), createdAt, gameDuration))
+	})
+
+	t.Run("ValidCounterToRoot", func(t *testing.T) {
+		claims := append(honest(), claimWithClock(0, 30, createdAt+30))
+		require.NoError(t, checkClockAccounting(claims, createdAt, gameDuration))
+	})
+
+	t.Run("NoRootClaim", func(t *testing.T) {
+		require.ErrorContains(t, checkClockAccounting(nil, createdAt, gameDuration), "no root claim")
+	})
+
+	t.Run("RootWithDuration", func(t *testing.T) {
+		claims := honest()
+		claims[0] = claimWithClock(math.MaxUint32, 1, createdAt)
+		require.ErrorContains(t, checkClockAccounting(claims, createdAt, gameDuration), "root claim has accumulated duration")
+	})
+
+	t.Run("RootNotAtCreation", func(t *testing.T) {
+		require.ErrorContains(t, checkClockAccounting(honest(), createdAt+1, gameDuration), "but game created at")
+	})
+
+	t.Run("ChargedToWrongPlayer", func(t *testing.T) {
+		claims := honest()
+		// Charging the defender's move with the challenger's previous time.
+		claims[2] = claimWithClock(1, 17, createdAt+17)
+		require.ErrorContains(t, checkClockAccounting(claims, createdAt, gameDuration), "claim 2 has accumulated duration 17s but expected 7s")
+	})
+
+	t.Run("PreviousTimeNotCarried", func(t *testing.T) {
+		claims := honest()
+		claims[3] = claimWithClock(2, 5, createdAt+22)
+		require.ErrorContains(t, checkClockAccounting(claims, createdAt, gameDuration), "claim 3 has accumulated duration 5s but expected 15s")
+	})
+
+	t.Run("MadeBeforeParent", func(t *testing.T) {
+		claims := honest()
+		claims[2] = claimWithClock(1, 0, createdAt+9)
+		require.ErrorContains(t, checkClockAccounting(claims, createdAt, gameDuration), "before its parent")
+	})
+
+	t.Run("ParentAfterClaim", func(t *testing.T) {
+		claims := honest()
+		claims[1].ParentIndex = 2
+		require.ErrorContains(t, checkClockAccounting(claims, createdAt, gameDuration), "responds to later claim")
+	})
+
+	t.Run("ExceedsHalfDuration", func(t *testing.T) {
+		claims := honest()
+		claims[1] = claimWithClock(0, 60, createdAt+60)
+		claims = claims[:2]
+		require.ErrorContains(t, checkClockAccounting(claims, createdAt, gameDuration), "more than half the game duration")
+	})
+}
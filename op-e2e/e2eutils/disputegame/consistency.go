@@ -0,0 +1,47 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// findInconsistentClaims returns an error for every claim made by claimant that commits to a different value for the
+// same trace index as an earlier claim by claimant. Each claim commits to the trace index of its rightmost descendant
+// at the max depth, so attack and defend paths through different parents can converge on the same index. An honest
+// player's trace only has one value for each index, so the later claim contradicts the earlier one and loses its bond.
+// moves must be the game's moves in order, so moves[i] created claims[i+1].
+func findInconsistentClaims(claims []ContractClaim, moves []GameMove, claimant common.Address, maxDepth int) ([]error, error) {
+	if len(moves) != len(claims)-1 {
+		return nil, fmt.Errorf("game has %v claims but %v moves", len(claims), len(moves))
+	}
+	first := make(map[uint64]int)
+	var inconsistent []error
+	for i := 1; i < len(claims); i++ {
+		if moves[i-1].Claimant != claimant {
+			continue
+		}
+		pos := types.NewPositionFromGIndex(claims[i].Position.Uint64())
+		traceIdx := pos.TraceIndex(maxDepth)
+		prev, ok := first[traceIdx]
+		if !ok {
+			first[traceIdx] = i
+			continue
+		}
+		if claims[prev].Claim != claims[i].Claim {
+			inconsistent = append(inconsistent, fmt.Errorf("claim %v at position %v has value %v but claim %v at position %v has value %v for trace index %v",
+				i, claims[i].Position, common.Hash(claims[i].Claim), prev, claims[prev].Position, common.Hash(claims[prev].Claim), traceIdx))
+		}
+	}
+	return inconsistent, nil
+}
+
+// RequireConsistentHonestClaims fails the test if claimant, an honest player, made two claims that commit to
+// different values for the same trace index. It is intended as an invariant checked at the end of tests.
+func (g *FaultGameHelper) RequireConsistentHonestClaims(ctx context.Context, claimant common.Address) {
+	inconsistent, err := findInconsistentClaims(g.GetAllClaims(ctx), g.Moves(ctx), claimant, g.maxDepth)
+	g.require.NoError(err)
+	g.require.Empty(inconsistent, "claimant %v made inconsistent claims in game %v", claimant, g.addr)
+}
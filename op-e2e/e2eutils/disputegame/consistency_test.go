@@ -0,0 +1,85 @@
+package disputegame
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInconsistentClaims(t *testing.T) {
+	const maxDepth = 3
+	claim := func(parentIdx uint32, pos types.Position, value common.Hash) ContractClaim {
+		return ContractClaim{ParentIndex: parentIdx, Claim: value, Position: new(big.Int).SetUint64(pos.ToGIndex()), Clock: big.NewInt(0)}
+	}
+	alice := common.Address{0xaa}
+	bob := common.Address{0xbb}
+	root := types.NewPosition(0, 0)
+	attackRoot := root.Attack()
+	defendAttack := attackRoot.Defend()
+
+	// Bob makes two different attacks on the root and Alice defends each of them, converging on the same position
+	// and so the same trace index through different parents.
+	converging := func(first, second common.Hash) []ContractClaim {
+		return []ContractClaim{
+			claim(math.MaxUint32, root, common.Hash{0x01}),
+			claim(0, attackRoot, common.Hash{0x02}),
+			claim(0, attackRoot, common.Hash{0x03}),
+			claim(1, defendAttack, first),
+			claim(2, defendAttack, second),
+		}
+	}
+	moves := []GameMove{{Claimant: bob}, {Claimant: bob}, {Claimant: alice}, {Claimant: alice}}
+
+	t.Run("ConvergingPathsSameValue", func(t *testing.T) {
+		inconsistent, err := findInconsistentClaims(converging(common.Hash{0xcc}, common.Hash{0xcc}), moves, alice, maxDepth)
+		require.NoError(t, err)
+		require.Empty(t, inconsistent)
+	})
+
+	t.Run("ConvergingPathsDifferentValues", func(t *testing.T) {
+		inconsistent, err := findInconsistentClaims(converging(common.Hash{0xcc}, common.Hash{0xdd}), moves, alice, maxDepth)
+		require.NoError(t, err)
+		require.Len(t, inconsistent, 1)
+		require.ErrorContains(t, inconsistent[0], "claim 4")
+		require.ErrorContains(t, inconsistent[0], "but claim 3")
+		require.ErrorContains(t, inconsistent[0], "trace index 5")
+	})
+
+	t.Run("OnlyClaimantChecked", func(t *testing.T) {
+		// Bob's two attacks on the root have different values for the same trace index but only Alice is honest.
+		inconsistent, err := findInconsistentClaims(converging(common.Hash{0xcc}, common.Hash{0xcc}), moves, bob, maxDepth)
+		require.NoError(t, err)
+		require.Len(t, inconsistent, 1)
+		require.ErrorContains(t, inconsistent[0], "claim 2")
+
+		inconsistent, err = findInconsistentClaims(converging(common.Hash{0xcc}, common.Hash{0xdd}), moves, common.Address{0xcc}, maxDepth)
+		require.NoError(t, err)
+		require.Empty(t, inconsistent)
+	})
+
+	t.Run("RightmostDescendant", func(t *testing.T) {
+		// Interior positions commit to the trace index of their rightmost descendant, so these all share index 3.
+		positions := []types.Position{types.NewPosition(1, 0), types.NewPosition(2, 1), types.NewPosition(3, 3)}
+		claims := []ContractClaim{claim(math.MaxUint32, root, common.Hash{0x01})}
+		var moves []GameMove
+		for i, pos := range positions {
+			require.EqualValues(t, 3, pos.TraceIndex(maxDepth))
+			claims = append(claims, claim(uint32(i), pos, common.Hash{byte(i)}))
+			moves = append(moves, GameMove{Claimant: alice})
+		}
+		inconsistent, err := findInconsistentClaims(claims, moves, alice, maxDepth)
+		require.NoError(t, err)
+		require.Len(t, inconsistent, 2)
+		require.ErrorContains(t, inconsistent[0], "claim 2")
+		require.ErrorContains(t, inconsistent[1], "claim 3")
+	})
+
+	t.Run("MismatchedMoves", func(t *testing.T) {
+		_, err := findInconsistentClaims(converging(common.Hash{0xcc}, common.Hash{0xcc}), moves[:1], alice, maxDepth)
+		require.ErrorContains(t, err, "5 claims but 1 moves")
+	})
+}
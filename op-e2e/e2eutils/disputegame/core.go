@@ -0,0 +1,516 @@
+package disputegame
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-service/client/utils"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// FactoryCore provides the dispute game factory operations used by FactoryHelper.
+// It reports failures as errors rather than failing a test so it can also be used by tools outside of tests.
+type FactoryCore struct {
+	client      *ethclient.Client
+	opts        *bind.TransactOpts
+	factory     *bindings.DisputeGameFactory
+	factoryAddr common.Address
+	blockOracle *bindings.BlockOracle
+	l2oo        *bindings.L2OutputOracleCaller
+	l2ooAddr    common.Address
+	// proxyAdminAddr is the ProxyAdmin that owns the L2OutputOracle proxy.
+	proxyAdminAddr common.Address
+	// alphabetType is the game type alphabet games are created with.
+	alphabetType uint8
+	clock        clock.Clock
+	metrics      Metricer
+	callTracer   *CallTracer
+	// subscriptions is true if client supports log subscriptions, otherwise waits fall back to polling.
+	subscriptions bool
+	// skipProposalWait is true if games are created without first waiting for output proposals.
+	skipProposalWait bool
+
+	creationsLock sync.Mutex
+	// creations records the creation details of games created by this factory core.
+	creations map[common.Address]GameCreation
+}
+
+// GameCreation identifies the L1 transaction and block a game was created in.
+type GameCreation struct {
+	BlockNumber uint64      `json:"blockNumber"`
+	TxHash      common.Hash `json:"txHash"`
+	Timestamp   uint64      `json:"timestamp"`
+}
+
+func NewFactoryCore(ctx context.Context, client *ethclient.Client, opts *bind.TransactOpts, deployments *genesis.L1Deployments) (*FactoryCore, error) {
+	if deployments == nil {
+		return nil, errors.New("no deployments")
+	}
+	factory, err := bindings.NewDisputeGameFactory(deployments.DisputeGameFactoryProxy, client)
+	if err != nil {
+		return nil, fmt.Errorf("create dispute game factory: %w", err)
+	}
+	blockOracle, err := bindings.NewBlockOracle(deployments.BlockOracle, client)
+	if err != nil {
+		return nil, fmt.Errorf("create block oracle: %w", err)
+	}
+	l2oo, err := bindings.NewL2OutputOracleCaller(deployments.L2OutputOracleProxy, client)
+	if err != nil {
+		return nil, fmt.Errorf("create l2oo caller: %w", err)
+	}
+	subscriptions, err := supportsSubscriptions(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return &FactoryCore{
+		client:         client,
+		opts:           opts,
+		factory:        factory,
+		factoryAddr:    deployments.DisputeGameFactoryProxy,
+		blockOracle:    blockOracle,
+		l2oo:           l2oo,
+		l2ooAddr:       deployments.L2OutputOracleProxy,
+		proxyAdminAddr: deployments.ProxyAdmin,
+		alphabetType:   alphabetGameType,
+		clock:          clock.SystemClock,
+		metrics:        NoopMetrics,
+		subscriptions:  subscriptions,
+		creations:      make(map[common.Address]GameCreation),
+	}, nil
+}
+
+// SetClock replaces the clock used to drive polling by this factory and any games it subsequently creates.
+func (c *FactoryCore) SetClock(clk clock.Clock) {
+	c.clock = clk
+}
+
+// SetAlphabetGameType sets the game type alphabet games are created with, allowing alphabet implementations
+// registered under other game types to be played.
+func (c *FactoryCore) SetAlphabetGameType(gameType uint8) {
+	c.alphabetType = gameType
+}
+
+// AlphabetGameType returns the game type alphabet games are created with.
+func (c *FactoryCore) AlphabetGameType() uint8 {
+	return c.alphabetType
+}
+
+// SetMetrics sets the metrics recorded by this factory and any games it subsequently creates.
+func (c *FactoryCore) SetMetrics(m Metricer) {
+	c.metrics = m
+}
+
+// WaitForProposals waits until the output oracle has the proposals required to create a game disputing
+// disputedL2BlockNumber: the first output at or after the disputed block and the output before it, which the game
+// starts from.
+func (c *FactoryCore) WaitForProposals(ctx context.Context) error {
+	return waitForOutputs(ctx, c.clock, c.l2oo, disputedL2BlockNumber)
+}
+
+// CheckpointL1Block stores the current L1 block in the oracle
+// Returns the L1 block number that was stored as the checkpoint
+func (c *FactoryCore) CheckpointL1Block(ctx context.Context) (*big.Int, error) {
+	tx, err := c.blockOracle.Checkpoint(c.opts)
+	if err != nil {
+		c.metrics.RecordTxFailure("checkpoint")
+		return nil, fmt.Errorf("send checkpoint tx: %w", err)
+	}
+	r, err := utils.WaitReceiptOK(ctx, c.client, tx.Hash())
+	if err != nil {
+		c.metrics.RecordTxFailure("checkpoint")
+		return nil, fmt.Errorf("failed to store block in block oracle: %w", err)
+	}
+	return new(big.Int).Sub(r.BlockNumber, big.NewInt(1)), nil
+}
+
+// IsBlockCheckpointed returns true if the hash of the L1 block blockNum is stored in the block oracle.
+func (c *FactoryCore) IsBlockCheckpointed(ctx context.Context, blockNum uint64) (bool, error) {
+	_, err := c.blockOracle.Load(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(blockNum))
+	if err == nil {
+		return true, nil
+	}
+	// The oracle reverts with BlockHashNotPresent for blocks that haven't been checkpointed.
+	if _, ok := extractRevertData(err); ok {
+		return false, nil
+	}
+	return false, fmt.Errorf("load block %v from block oracle: %w", blockNum, err)
+}
+
+// CreateGame creates a new dispute game via the factory and returns the address of the new game.
+func (c *FactoryCore) CreateGame(ctx context.Context, gameType uint8, rootClaim common.Hash, extraData []byte) (common.Address, error) {
+	return c.createGame(ctx, c.opts, gameType, rootClaim, extraData)
+}
+
+// createGame creates a new dispute game via the factory, sending the transaction with opts.
+func (c *FactoryCore) createGame(ctx context.Context, opts *bind.TransactOpts, gameType uint8, rootClaim common.Hash, extraData []byte) (common.Address, error) {
+	addr, _, err := c.createGameWithReceipt(ctx, opts, gameType, rootClaim, extraData)
+	return addr, err
+}
+
+// createGameWithReceipt creates a new dispute game via the factory, sending the transaction with opts, and returns
+// the receipt of the creation transaction along with the address of the new game.
+func (c *FactoryCore) createGameWithReceipt(ctx context.Context, opts *bind.TransactOpts, gameType uint8, rootClaim common.Hash, extraData []byte) (common.Address, *ethtypes.Receipt, error) {
+	tx, err := c.factory.Create(opts, gameType, rootClaim, extraData)
+	if err != nil {
+		c.metrics.RecordTxFailure("create")
+		err = c.traceFactoryRevert(ctx, opts, nil, err, "create", gameType, rootClaim, extraData)
+		return common.Address{}, nil, fmt.Errorf("create fault dispute game: %w", err)
+	}
+	rcpt, err := utils.WaitReceiptOK(ctx, c.client, tx.Hash())
+	if err != nil {
+		c.metrics.RecordTxFailure("create")
+		err = c.traceFactoryRevert(ctx, opts, rcpt, err, "create", gameType, rootClaim, extraData)
+		return common.Address{}, nil, fmt.Errorf("wait for create fault dispute game receipt to be OK: %w", err)
+	}
+	c.metrics.RecordGameCreated(gameType)
+	if len(rcpt.Logs) != 1 {
+		return common.Address{}, nil, fmt.Errorf("should have emitted a single DisputeGameCreated event but got %v logs", len(rcpt.Logs))
+	}
+	createdEvent, err := c.factory.ParseDisputeGameCreated(*rcpt.Logs[0])
+	if err != nil {
+		return common.Address{}, nil, fmt.Errorf("parse DisputeGameCreated event: %w", err)
+	}
+	creation, err := c.loadCreation(ctx, createdEvent.Raw)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	c.creationsLock.Lock()
+	defer c.creationsLock.Unlock()
+	c.creations[createdEvent.DisputeProxy] = creation
+	return createdEvent.DisputeProxy, rcpt, nil
+}
+
+// GameCreation returns the details of the transaction that created the game at addr.
+// Games not created via this factory core are found from the factory's DisputeGameCreated events.
+func (c *FactoryCore) GameCreation(ctx context.Context, addr common.Address) (GameCreation, error) {
+	c.creationsLock.Lock()
+	creation, ok := c.creations[addr]
+	c.creationsLock.Unlock()
+	if ok {
+		return creation, nil
+	}
+	iter, err := c.factory.FilterDisputeGameCreated(&bind.FilterOpts{Context: ctx}, []common.Address{addr}, nil, nil)
+	if err != nil {
+		return GameCreation{}, fmt.Errorf("filter DisputeGameCreated events: %w", err)
+	}
+	defer iter.Close()
+	if !iter.Next() {
+		if iter.Error() != nil {
+			return GameCreation{}, fmt.Errorf("load DisputeGameCreated event: %w", iter.Error())
+		}
+		return GameCreation{}, fmt.Errorf("no DisputeGameCreated event for game %v", addr)
+	}
+	return c.loadCreation(ctx, iter.Event.Raw)
+}
+
+func (c *FactoryCore) loadCreation(ctx context.Context, created ethtypes.Log) (GameCreation, error) {
+	header, err := c.client.HeaderByHash(ctx, created.BlockHash)
+	if err != nil {
+		return GameCreation{}, fmt.Errorf("load header of game creation block %v: %w", created.BlockHash, err)
+	}
+	return GameCreation{
+		BlockNumber: created.BlockNumber,
+		TxHash:      created.TxHash,
+		Timestamp:   header.Time,
+	}, nil
+}
+
+// TryCreateGame creates a new dispute game via the factory, first checking that creation would succeed so that an
+// error including the revert reason is returned if it would not.
+func (c *FactoryCore) TryCreateGame(ctx context.Context, gameType uint8, rootClaim common.Hash, extraData []byte) (common.Address, error) {
+	data, err := createGameCalldata(gameType, rootClaim, extraData)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if _, err := c.client.CallContract(ctx, ethereum.CallMsg{From: c.opts.From, To: &c.factoryAddr, Data: data}, nil); err != nil {
+		if reason, ok := decodeRevertReason(err); ok {
+			return common.Address{}, fmt.Errorf("create game reverted: %v: %w", reason, err)
+		}
+		return common.Address{}, fmt.Errorf("create game: %w", err)
+	}
+	return c.CreateGame(ctx, gameType, rootClaim, extraData)
+}
+
+// FactoryAddress returns the address of the dispute game factory.
+func (c *FactoryCore) FactoryAddress() common.Address {
+	return c.factoryAddr
+}
+
+// createGameCalldata returns the ABI encoded call to the factory's create method.
+func createGameCalldata(gameType uint8, rootClaim common.Hash, extraData []byte) ([]byte, error) {
+	factoryAbi, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("load factory abi: %w", err)
+	}
+	data, err := factoryAbi.Pack("create", gameType, rootClaim, extraData)
+	if err != nil {
+		return nil, fmt.Errorf("pack create call: %w", err)
+	}
+	return data, nil
+}
+
+// CreateAlphabetGame waits for proposals, checkpoints the current L1 block and then creates an alphabet game with
+// a root claim from claimedAlphabet.
+func (c *FactoryCore) CreateAlphabetGame(ctx context.Context, claimedAlphabet string) (common.Address, error) {
+	rootClaim, err := alphabetRootClaim(ctx, claimedAlphabet)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return c.createAlphabetGame(ctx, rootClaim, nil)
+}
+
+// CreateAlphabetGameWithRootClaim creates an alphabet game with rootClaim as the root claim as is, rather than
+// deriving it from an alphabet. Allows games to be created with root claims no trace could produce.
+func (c *FactoryCore) CreateAlphabetGameWithRootClaim(ctx context.Context, rootClaim common.Hash) (common.Address, error) {
+	return c.createAlphabetGame(ctx, rootClaim, nil)
+}
+
+// createAlphabetGame waits for proposals, checkpoints the current L1 block and then creates an alphabet game with
+// rootClaim, encoding its extra data with encoder unless it is nil.
+func (c *FactoryCore) createAlphabetGame(ctx context.Context, rootClaim common.Hash, encoder ExtraDataEncoder) (common.Address, error) {
+	if err := c.waitForProposalsIfRequired(ctx); err != nil {
+		return common.Address{}, fmt.Errorf("wait for proposals: %w", err)
+	}
+	l1Head, err := c.CheckpointL1Block(ctx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	extraData, err := gameExtraData(l1Head.Uint64(), encoder)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return c.CreateGame(ctx, c.alphabetType, rootClaim, extraData)
+}
+
+// GameAddresses returns the address of every game created by the factory, in creation order.
+func (c *FactoryCore) GameAddresses(ctx context.Context) ([]common.Address, error) {
+	count, err := c.factory.GameCount(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("load game count: %w", err)
+	}
+	addrs := make([]common.Address, 0, count.Int64())
+	for i := int64(0); i < count.Int64(); i++ {
+		game, err := c.factory.GameAtIndex(&bind.CallOpts{Context: ctx}, big.NewInt(i))
+		if err != nil {
+			return nil, fmt.Errorf("load game %v: %w", i, err)
+		}
+		addrs = append(addrs, game.Proxy)
+	}
+	return addrs, nil
+}
+
+// Game creates a GameCore for the existing game at addr.
+func (c *FactoryCore) Game(addr common.Address) (*GameCore, error) {
+	return c.gameWithOpts(addr, c.opts)
+}
+
+// gameWithOpts creates a GameCore for the existing game at addr that sends transactions with opts.
+func (c *FactoryCore) gameWithOpts(addr common.Address, opts *bind.TransactOpts) (*GameCore, error) {
+	game, err := NewGameCore(c.client, opts, addr, c.clock)
+	if err != nil {
+		return nil, err
+	}
+	game.subscriptions = c.subscriptions
+	game.metrics = c.metrics
+	game.callTracer = c.callTracer
+	return game, nil
+}
+
+// GameCore provides the fault dispute game operations used by FaultGameHelper.
+// It reports failures as errors rather than failing a test so it can also be used by tools outside of tests.
+type GameCore struct {
+	client  *ethclient.Client
+	opts    *bind.TransactOpts
+	game    *bindings.FaultDisputeGame
+	addr    common.Address
+	clock   clock.Clock
+	metrics Metricer
+	// callTracer records the call trace of reverted transactions, if set.
+	callTracer *CallTracer
+	// subscriptions is true if client supports log subscriptions, otherwise waits fall back to polling.
+	subscriptions bool
+	// skipProposalWait is true if games are created without first waiting for output proposals.
+	skipProposalWait bool
+	// probes caches the results of feature detection. It is shared by copies of the core.
+	probes *probeCache
+	// posted caches the claims already loaded to check for duplicate moves. It is shared by copies of the core.
+	posted *postedClaims
+}
+
+// probeCache caches the results of checking what the deployed contracts support, which don't change during a game.
+type probeCache struct {
+	lock sync.Mutex
+	// multicall records whether Multicall3 is deployed, once it has been checked.
+	multicall *bool
+	// functions records whether the game implements each function that has been probed, keyed by the probe calldata.
+	functions map[string]bool
+}
+
+func NewGameCore(client *ethclient.Client, opts *bind.TransactOpts, addr common.Address, clk clock.Clock) (*GameCore, error) {
+	game, err := bindings.NewFaultDisputeGame(addr, client)
+	if err != nil {
+		return nil, fmt.Errorf("create fault dispute game binding: %w", err)
+	}
+	return &GameCore{
+		client:  client,
+		opts:    opts,
+		game:    game,
+		addr:    addr,
+		clock:   clk,
+		metrics: NoopMetrics,
+		probes:  &probeCache{functions: make(map[string]bool)},
+		posted:  &postedClaims{},
+	}, nil
+}
+
+// Addr returns the address of the game contract.
+func (g *GameCore) Addr() common.Address {
+	return g.addr
+}
+
+// SetClock replaces the clock used to drive polling.
+// Timeouts are still applied using real time so a test that never advances its clock fails rather than hangs.
+func (g *GameCore) SetClock(c clock.Clock) {
+	g.clock = c
+}
+
+// waitFor polls cb at the specified rate, as measured by the game's clock, until it returns true or an error.
+func (g *GameCore) waitFor(ctx context.Context, rate time.Duration, cb func() (bool, error)) error {
+	return waitFor(ctx, g.clock, rate, cb)
+}
+
+// SetMetrics sets the metrics recorded by the game.
+func (g *GameCore) SetMetrics(m Metricer) {
+	g.metrics = m
+}
+
+// WaitForResolution waits until the game is resolved, by anyone, and returns its final status.
+// The resolution is recorded in the game's metrics.
+func (g *GameCore) WaitForResolution(ctx context.Context) (Status, error) {
+	var status Status
+	err := g.waitForGameLogs(ctx, time.Second, func() (bool, error) {
+		var err error
+		status, err = g.LoadStatus(ctx)
+		if err != nil {
+			return false, err
+		}
+		return status != StatusInProgress, nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("wait for game %v to resolve: %w", g.addr, err)
+	}
+	g.metrics.RecordGameResolved(status)
+	return status, nil
+}
+
+// LoadStatus returns the current status of the game.
+func (g *GameCore) LoadStatus(ctx context.Context) (Status, error) {
+	status, err := g.game.Status(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, fmt.Errorf("game status unavailable: %w", err)
+	}
+	return Status(status), nil
+}
+
+// LoadRootClaim returns the root claim of the game.
+func (g *GameCore) LoadRootClaim(ctx context.Context) (common.Hash, error) {
+	root, err := g.game.RootClaim(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("load root claim: %w", err)
+	}
+	return root, nil
+}
+
+// LoadClaims returns every claim in the game, in the order they were made.
+func (g *GameCore) LoadClaims(ctx context.Context) ([]ContractClaim, error) {
+	count, err := g.game.ClaimDataLen(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("retrieve number of claims: %w", err)
+	}
+	claims := make([]ContractClaim, 0, count.Int64())
+	for i := int64(0); i < count.Int64(); i++ {
+		claimData, err := g.game.ClaimData(&bind.CallOpts{Context: ctx}, big.NewInt(i))
+		if err != nil {
+			return nil, fmt.Errorf("retrieve claim %v: %w", i, err)
+		}
+		claims = append(claims, claimData)
+	}
+	return claims, nil
+}
+
+// SendMove attacks or defends the claim at claimIdx and waits for the transaction to be included.
+func (g *GameCore) SendMove(ctx context.Context, claimIdx int64, claim common.Hash, isAttack bool) error {
+	return g.sendMove(ctx, claimIdx, claim, isAttack, nil)
+}
+
+// sendMove posts a move with value attached, such as the bond required for the new claim.
+func (g *GameCore) sendMove(ctx context.Context, claimIdx int64, claim common.Hash, isAttack bool, value *big.Int) error {
+	_, err := g.sendMoveWithReceipt(ctx, claimIdx, claim, isAttack, value)
+	return err
+}
+
+// sendMoveWithReceipt posts a move with value attached and returns the receipt of the move transaction.
+func (g *GameCore) sendMoveWithReceipt(ctx context.Context, claimIdx int64, claim common.Hash, isAttack bool, value *big.Int) (*ethtypes.Receipt, error) {
+	opts := *g.opts
+	opts.Value = value
+	tx, err := g.game.Move(&opts, big.NewInt(claimIdx), claim, isAttack)
+	if err != nil {
+		g.metrics.RecordTxFailure("move")
+		err = g.traceGameRevert(ctx, &opts, nil, err, "move", big.NewInt(claimIdx), claim, isAttack)
+		return nil, fmt.Errorf("send move tx: %w", err)
+	}
+	rcpt, err := utils.WaitReceiptOK(ctx, g.client, tx.Hash())
+	if err != nil {
+		g.metrics.RecordTxFailure("move")
+		return nil, g.traceGameRevert(ctx, &opts, rcpt, err, "move", big.NewInt(claimIdx), claim, isAttack)
+	}
+	return rcpt, nil
+}
+
+// SendStep steps against the claim at claimIdx and waits for the transaction to be included.
+func (g *GameCore) SendStep(ctx context.Context, claimIdx int64, isAttack bool, stateData []byte, proof []byte) error {
+	tx, err := g.game.Step(g.opts, big.NewInt(claimIdx), isAttack, stateData, proof)
+	if err != nil {
+		g.metrics.RecordTxFailure("step")
+		err = g.traceGameRevert(ctx, g.opts, nil, err, "step", big.NewInt(claimIdx), isAttack, stateData, proof)
+		return fmt.Errorf("send step tx: %w", err)
+	}
+	rcpt, err := utils.WaitReceiptOK(ctx, g.client, tx.Hash())
+	if err != nil {
+		g.metrics.RecordTxFailure("step")
+		err = g.traceGameRevert(ctx, g.opts, rcpt, err, "step", big.NewInt(claimIdx), isAttack, stateData, proof)
+	}
+	return err
+}
+
+// SendResolve resolves the game and waits for the transaction to be included.
+func (g *GameCore) SendResolve(ctx context.Context) error {
+	_, err := g.sendResolve(ctx)
+	return err
+}
+
+func (g *GameCore) sendResolve(ctx context.Context) (*ethtypes.Receipt, error) {
+	tx, err := g.game.Resolve(g.opts)
+	if err != nil {
+		g.metrics.RecordTxFailure("resolve")
+		err = g.traceGameRevert(ctx, g.opts, nil, err, "resolve")
+		return nil, fmt.Errorf("send resolve tx: %w", err)
+	}
+	rcpt, err := utils.WaitReceiptOK(ctx, g.client, tx.Hash())
+	if err != nil {
+		g.metrics.RecordTxFailure("resolve")
+		err = g.traceGameRevert(ctx, g.opts, rcpt, err, "resolve")
+	}
+	return rcpt, err
+}
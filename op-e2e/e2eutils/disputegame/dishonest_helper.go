@@ -0,0 +1,126 @@
+package disputegame
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+)
+
+// DelayPolicy determines how long the dishonest actor waits before making a move at the specified depth.
+// remaining is the amount of time left on the actor's clock when the move is being considered.
+type DelayPolicy func(depth int, remaining time.Duration) time.Duration
+
+// NoDelay makes every move as soon as possible.
+func NoDelay(_ int, _ time.Duration) time.Duration {
+	return 0
+}
+
+// FixedDelay waits the same amount of chain time before every move.
+func FixedDelay(delay time.Duration) DelayPolicy {
+	return func(_ int, _ time.Duration) time.Duration {
+		return delay
+	}
+}
+
+// FractionOfRemainingClock waits until the specified fraction of the actor's remaining clock has been used.
+func FractionOfRemainingClock(fraction float64) DelayPolicy {
+	return func(_ int, remaining time.Duration) time.Duration {
+		return time.Duration(float64(remaining) * fraction)
+	}
+}
+
+// DelayPerDepth waits the amount of time specified for the depth of the move. Depths not included are not delayed.
+func DelayPerDepth(delays map[int]time.Duration) DelayPolicy {
+	return func(depth int, _ time.Duration) time.Duration {
+		return delays[depth]
+	}
+}
+
+// TimeAdvancer is able to move the L1 chain's time forward, such as the time travel clock used by the e2e system.
+type TimeAdvancer interface {
+	AdvanceTime(d time.Duration)
+}
+
+type DishonestOption func(d *DishonestHelper)
+
+// WithDelayPolicy sets the policy used to decide how long to wait before each move.
+func WithDelayPolicy(policy DelayPolicy) DishonestOption {
+	return func(d *DishonestHelper) {
+		d.delay = policy
+	}
+}
+
+// WithTimeAdvancer allows the dishonest actor to time travel L1 rather than waiting for chain time to pass.
+func WithTimeAdvancer(advancer TimeAdvancer) DishonestOption {
+	return func(d *DishonestHelper) {
+		d.advancer = advancer
+	}
+}
+
+// DishonestHelper is a scripted actor that supports the root claim by countering every claim that disputes it,
+// using its own (incorrect) trace.
+type DishonestHelper struct {
+	*FaultGameHelper
+	trace    types.TraceProvider
+	delay    DelayPolicy
+	advancer TimeAdvancer
+}
+
+// CreateDishonestHelper creates a scripted actor which plays the game using claimedAlphabet as its trace.
+func (g *AlphabetGameHelper) CreateDishonestHelper(claimedAlphabet string, options ...DishonestOption) *DishonestHelper {
+	return newDishonestHelper(&g.FaultGameHelper, g.TraceProvider(claimedAlphabet), options...)
+}
+
+func newDishonestHelper(g *FaultGameHelper, trace types.TraceProvider, options ...DishonestOption) *DishonestHelper {
+	d := &DishonestHelper{
+		FaultGameHelper: g,
+		trace:           trace,
+		delay:           NoDelay,
+	}
+	for _, option := range options {
+		option(d)
+	}
+	return d
+}
+
+// Start runs the dishonest actor in the background until the game is resolved or the test completes.
+func (d *DishonestHelper) Start(ctx context.Context) {
+	var options []StrategyOption
+	if d.advancer != nil {
+		options = append(options, WithStrategyTimeAdvancer(d.advancer))
+	}
+	d.StartStrategy(ctx, NewTraceStrategy(d.maxDepth, d.trace, true, d.delay), options...)
+}
+
+// AttackHonestClaimAt waits for the honest actor to post a claim at depth-1 and attacks it with a claim at depth.
+// If agree is true the attacking claim matches honestTrace at its position, so the honest actor should defend it,
+// otherwise it disagrees with honestTrace and the honest actor should attack it. Returns the index of the new claim.
+// The honest actor disputes the root claim, so depth must be even and less than the max depth so that the honest
+// actor responds with a move rather than a step. The actor should not be started when using this method as it
+// would counter the honest claim itself.
+func (d *DishonestHelper) AttackHonestClaimAt(ctx context.Context, depth int, honestTrace types.TraceProvider, agree bool) int64 {
+	d.require.Zerof(depth%2, "depth %v is not a level the honest actor disputes", depth)
+	d.require.Positive(depth, "depth must be below the root claim")
+	d.require.Lessf(depth, d.maxDepth, "depth must be less than the max depth of %v", d.maxDepth)
+	ctx, cancel := d.withTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	parentIdx := d.waitForClaimIndex(ctx, func(claim ContractClaim) bool {
+		pos := types.NewPositionFromGIndex(claim.Position.Uint64())
+		return pos.Depth() == depth-1
+	})
+	parent := d.getClaim(ctx, parentIdx)
+	parentPos := types.NewPositionFromGIndex(parent.Position.Uint64())
+	pos := parentPos.Attack()
+	value, err := honestTrace.Get(ctx, pos.TraceIndex(d.maxDepth))
+	d.require.NoErrorf(err, "get honest trace at position %v", pos.ToGIndex())
+	if !agree {
+		value[0] ^= 0xff
+	}
+	d.require.NoErrorf(d.move(ctx, parentIdx, value, true), "attack honest claim %v", parentIdx)
+
+	return d.waitForClaimIndex(ctx, func(claim ContractClaim) bool {
+		return int64(claim.ParentIndex) == parentIdx && claim.Position.Uint64() == pos.ToGIndex() && claim.Claim == value
+	})
+}
@@ -0,0 +1,110 @@
+package disputegame
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/challenger"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// StartDuplicateAlphabetGame creates a second alphabet game with the same root claim and disputed L2 block as game,
+// referencing a newer checkpointed L1 block. The two games differ only in their extra data.
+func (h *FactoryHelper) StartDuplicateAlphabetGame(ctx context.Context, game *AlphabetGameHelper) *AlphabetGameHelper {
+	addr := h.createDuplicateGame(ctx, &game.FaultGameHelper)
+	return &AlphabetGameHelper{
+		FaultGameHelper: h.newGameHelper(ctx, addr, game.maxDepth),
+		claimedAlphabet: game.claimedAlphabet,
+	}
+}
+
+// StartDuplicateCannonGame creates a second cannon game with the same root claim and disputed L2 block as game,
+// referencing a newer checkpointed L1 block. The two games differ only in their extra data.
+// Test program games can't be duplicated as their trace depends on the L1 head.
+func (h *FactoryHelper) StartDuplicateCannonGame(ctx context.Context, game *CannonGameHelper) *CannonGameHelper {
+	addr := h.createDuplicateGame(ctx, &game.FaultGameHelper)
+	return &CannonGameHelper{
+		FaultGameHelper: h.newGameHelper(ctx, addr, game.maxDepth),
+		fixtureDir:      game.fixtureDir,
+	}
+}
+
+func (h *FactoryHelper) createDuplicateGame(ctx context.Context, game *FaultGameHelper) common.Address {
+	ctx, cancel := h.withTimeout(ctx, time.Minute)
+	defer cancel()
+	opts := &bind.CallOpts{Context: ctx}
+	gameType, err := game.game.GameType(opts)
+	h.require.NoError(err, "load game type")
+	h.require.NotEqual(testProgramGameType, gameType, "test program games can't be duplicated")
+	rootClaim, err := game.LoadRootClaim(ctx)
+	h.require.NoError(err, "load root claim")
+	extraData, err := game.game.ExtraData(opts)
+	h.require.NoError(err, "load extra data")
+
+	l1Head := h.checkpointL1Block(ctx)
+	h.require.Greaterf(l1Head.Uint64(), game.L1HeadNum(ctx), "checkpoint should be newer than game %v's L1 head", game.addr)
+	extraData, err = replayExtraData(extraData, l1Head.Uint64())
+	h.require.NoError(err)
+	addr, err := h.CreateGame(ctx, gameType, rootClaim, extraData)
+	h.require.NoErrorf(err, "create duplicate of game %v", game.addr)
+	return addr
+}
+
+// RequireDuplicateGamesDistinguishable fails the test unless the summaries of game and duplicate show they are for
+// the same root claim and L2 block but different L1 heads.
+func (h *FactoryHelper) RequireDuplicateGamesDistinguishable(ctx context.Context, game *FaultGameHelper, duplicate *FaultGameHelper) {
+	summaries := make(map[common.Address]GameSummary)
+	for _, summary := range h.GameSummaries(ctx) {
+		h.require.Emptyf(summary.LoadError, "load summary of game %v", summary.Address)
+		summaries[summary.Address] = summary
+	}
+	original, ok := summaries[game.addr]
+	h.require.Truef(ok, "no summary for game %v", game.addr)
+	dup, ok := summaries[duplicate.addr]
+	h.require.Truef(ok, "no summary for game %v", duplicate.addr)
+	h.require.Equal(original.GameType, dup.GameType, "games should have the same type")
+	h.require.Equal(original.RootClaim, dup.RootClaim, "games should have the same root claim")
+	h.require.Equal(original.L2BlockNumber, dup.L2BlockNumber, "games should dispute the same L2 block")
+	h.require.Equal(original.DisputedOutputIndex, dup.DisputedOutputIndex, "games should dispute the same output")
+	h.require.NotEqual(original.L1HeadNumber, dup.L1HeadNumber, "games should have different L1 heads")
+}
+
+// RequireChallengerPlaysDuplicateGames creates a duplicate of game and starts a single challenger, configured by
+// options, monitoring both. Invalid claims are made down to max depth in both games, and the challenger must counter
+// each of them and step against the final one in both games, keeping the cannon data for each game in its own
+// directory. The challenger must agree with game's root claim, so game should be created from a cannon fixture.
+func (h *FactoryHelper) RequireChallengerPlaysDuplicateGames(ctx context.Context, game *CannonGameHelper, l1Endpoint string, l2Endpoint string, options ...challenger.Option) {
+	duplicate := h.StartDuplicateCannonGame(ctx, game)
+	h.RequireDuplicateGamesDistinguishable(ctx, &game.FaultGameHelper, &duplicate.FaultGameHelper)
+	opts := append([]challenger.Option{challenger.WithAdditionalGames(duplicate.addr)}, options...)
+	c := game.StartChallenger(ctx, l1Endpoint, l2Endpoint, "Defender", opts...)
+
+	games := []*CannonGameHelper{game, duplicate}
+	for _, g := range games {
+		g.Attack(ctx, 0, common.Hash{0xaa})
+	}
+	for claimIdx := int64(2); claimIdx < int64(game.maxDepth); claimIdx += 2 {
+		for _, g := range games {
+			g.WaitForClaimCount(ctx, claimIdx+1)
+			g.Attack(ctx, claimIdx, common.Hash{0xbb})
+		}
+	}
+	for _, g := range games {
+		g.WaitForClaimAtMaxDepth(ctx, true)
+	}
+
+	gameDir := c.CannonGameDatadir(game.addr)
+	duplicateDir := c.CannonGameDatadir(duplicate.addr)
+	h.require.NotEqual(gameDir, duplicateDir, "games should have separate cannon data dirs")
+	h.requireProofsWritten(gameDir, game.addr)
+	h.requireProofsWritten(duplicateDir, duplicate.addr)
+}
+
+// requireProofsWritten fails the test unless the cannon data dir for game contains at least one proof file.
+func (h *FactoryHelper) requireProofsWritten(dir string, game common.Address) {
+	proofs, err := filepath.Glob(filepath.Join(dir, "proofs", "*.json"))
+	h.require.NoError(err)
+	h.require.NotEmptyf(proofs, "no proofs written to %v for game %v", dir, game)
+}
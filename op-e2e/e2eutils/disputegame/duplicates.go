@@ -0,0 +1,117 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// claimKey identifies a claim by its parent, position and value. A test posting two claims with the same key is
+// almost always a bug in the test rather than something it meant to exercise.
+type claimKey struct {
+	parentIdx uint32
+	gindex    uint64
+	value     common.Hash
+}
+
+func keyOf(claim ContractClaim) claimKey {
+	return claimKey{
+		parentIdx: claim.ParentIndex,
+		gindex:    claim.Position.Uint64(),
+		value:     claim.Claim,
+	}
+}
+
+// indexClaims maps the key of each claim to the index of the first claim with that key.
+func indexClaims(claims []ContractClaim) map[claimKey]int {
+	index := make(map[claimKey]int, len(claims))
+	for i, claim := range claims {
+		if _, ok := index[keyOf(claim)]; !ok {
+			index[keyOf(claim)] = i
+		}
+	}
+	return index
+}
+
+// findDuplicateClaims returns an error for every claim with the same parent, position and value as an earlier claim.
+func findDuplicateClaims(claims []ContractClaim) []error {
+	index := indexClaims(claims)
+	var duplicates []error
+	for i, claim := range claims {
+		if first := index[keyOf(claim)]; first != i {
+			duplicates = append(duplicates, fmt.Errorf("claim %v duplicates claim %v (parent %v, position %v, value %v)",
+				i, first, claim.ParentIndex, claim.Position, common.Hash(claim.Claim)))
+		}
+	}
+	return duplicates
+}
+
+// existingMove returns the index of the claim that already makes the move with value against the claim at
+// parentIdx, if there is one. Like the contract, claims are identified by their position and value only, so a move
+// is rejected even if the existing claim has a different parent.
+func existingMove(claims []ContractClaim, parentIdx int64, value common.Hash, isAttack bool) (int, bool) {
+	if parentIdx < 0 || parentIdx >= int64(len(claims)) {
+		// Leave invalid parents for the contract to reject.
+		return 0, false
+	}
+	pos := types.NewPositionFromGIndex(claims[parentIdx].Position.Uint64())
+	if isAttack {
+		pos = pos.Attack()
+	} else {
+		pos = pos.Defend()
+	}
+	for i, claim := range claims {
+		if claim.Position.Uint64() == pos.ToGIndex() && claim.Claim == value {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// postedClaims caches claims loaded from a game. Claims are never removed and their parent, position and value never
+// change, so only claims added since the last load need to be requested.
+type postedClaims struct {
+	lock   sync.Mutex
+	claims []ContractClaim
+}
+
+// loadPostedClaims returns every claim in the game, only requesting claims added since the previous call.
+// The clock and countered flag of previously loaded claims are not updated so must not be relied on.
+func (g *GameCore) loadPostedClaims(ctx context.Context) ([]ContractClaim, error) {
+	g.posted.lock.Lock()
+	defer g.posted.lock.Unlock()
+	count, err := g.game.ClaimDataLen(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("retrieve number of claims: %w", err)
+	}
+	for i := int64(len(g.posted.claims)); i < count.Int64(); i++ {
+		claimData, err := g.game.ClaimData(&bind.CallOpts{Context: ctx}, big.NewInt(i))
+		if err != nil {
+			return nil, fmt.Errorf("retrieve claim %v: %w", i, err)
+		}
+		g.posted.claims = append(g.posted.claims, claimData)
+	}
+	return g.posted.claims, nil
+}
+
+// requireNewMove fails the test if the contract would reject the move with value against the claim at parentIdx
+// because a claim with the same value has already been posted at the move's position.
+func (g *FaultGameHelper) requireNewMove(ctx context.Context, parentIdx int64, value common.Hash, isAttack bool) {
+	claims, err := g.loadPostedClaims(ctx)
+	g.require.NoError(err, "load claims")
+	if idx, ok := existingMove(claims, parentIdx, value, isAttack); ok {
+		g.require.Failf("duplicate move", "claim %v already has value %v at the position of this move against claim %v",
+			idx, value, parentIdx)
+	}
+}
+
+// RequireNoDuplicateClaims fails the test if any two claims in the game have the same parent, position and value.
+// It is intended as an invariant checked at the end of tests.
+func (g *FaultGameHelper) RequireNoDuplicateClaims(ctx context.Context) {
+	g.require.Empty(findDuplicateClaims(g.GetAllClaims(ctx)), "game %v contains duplicate claims", g.addr)
+}
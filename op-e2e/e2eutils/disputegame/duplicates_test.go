@@ -0,0 +1,58 @@
+package disputegame
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDuplicateClaims(t *testing.T) {
+	claim := func(parentIdx uint32, gindex int64, value common.Hash) ContractClaim {
+		return ContractClaim{ParentIndex: parentIdx, Claim: value, Position: big.NewInt(gindex), Clock: big.NewInt(0)}
+	}
+	// Two claims at position 2 attack the root with different values and each is attacked with the same value.
+	claims := []ContractClaim{
+		claim(math.MaxUint32, 1, common.Hash{0xaa}),
+		claim(0, 2, common.Hash{0xbb}),
+		claim(0, 2, common.Hash{0xcc}),
+		claim(1, 4, common.Hash{0xdd}),
+		claim(2, 4, common.Hash{0xdd}),
+		claim(1, 6, common.Hash{0xdd}),
+	}
+
+	t.Run("NoDuplicates", func(t *testing.T) {
+		require.Empty(t, findDuplicateClaims(claims))
+	})
+
+	t.Run("ReportsEachDuplicate", func(t *testing.T) {
+		claims := append(claims, claim(0, 2, common.Hash{0xbb}), claim(1, 4, common.Hash{0xdd}), claim(0, 2, common.Hash{0xbb}))
+		duplicates := findDuplicateClaims(claims)
+		require.Len(t, duplicates, 3)
+		require.ErrorContains(t, duplicates[0], "claim 6 duplicates claim 1")
+		require.ErrorContains(t, duplicates[1], "claim 7 duplicates claim 3")
+		require.ErrorContains(t, duplicates[2], "claim 8 duplicates claim 1")
+	})
+
+	t.Run("ExistingMove", func(t *testing.T) {
+		idx, ok := existingMove(claims, 0, common.Hash{0xcc}, true)
+		require.True(t, ok)
+		require.Equal(t, 2, idx)
+
+		idx, ok = existingMove(claims, 1, common.Hash{0xdd}, false)
+		require.True(t, ok)
+		require.Equal(t, 5, idx)
+
+		_, ok = existingMove(claims, 0, common.Hash{0xdd}, true)
+		require.False(t, ok, "different value")
+		idx, ok = existingMove(claims, 2, common.Hash{0xdd}, true)
+		require.True(t, ok, "same position and value with a different parent")
+		require.Equal(t, 3, idx)
+		_, ok = existingMove(claims, 1, common.Hash{0xbb}, true)
+		require.False(t, ok, "different position")
+		_, ok = existingMove(claims, int64(len(claims)), common.Hash{0xdd}, true)
+		require.False(t, ok, "unknown parent")
+	})
+}
@@ -0,0 +1,56 @@
+package disputegame
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// RequiredGameDuration returns a game duration long enough for a scripted scenario of moves moves, each of which
+// may take up to perMove. Each player gets half the game duration, and since a script may have a single player
+// make every move, each half must allow for all of them.
+func RequiredGameDuration(moves int, perMove time.Duration) time.Duration {
+	return 2 * time.Duration(moves) * perMove
+}
+
+// WithGameDurationForMoves makes the helper create games with a duration long enough for a scripted scenario of
+// moves moves, each taking up to perMove, as calculated by RequiredGameDuration. Before each game is created, an
+// implementation with that duration is deployed and registered with the factory if the registered one differs.
+// Requires WithFactoryOwner.
+func WithGameDurationForMoves(moves int, perMove time.Duration) FactoryOption {
+	return func(h *FactoryHelper) {
+		h.gameDuration = RequiredGameDuration(moves, perMove)
+	}
+}
+
+// applyGameDuration registers an implementation for gameType with the configured game duration, if any. If a timeout
+// scale is configured, the duration is scaled by it, starting from the duration of the implementation registered when
+// the helper first created a game of gameType if no duration is configured.
+func (h *FactoryHelper) applyGameDuration(ctx context.Context, gameType uint8) {
+	if h.gameDuration == 0 && h.timeoutScale <= 1 {
+		return
+	}
+	h.require.NotNil(h.ownerOpts, "changing the game duration requires the factory owner to be configured")
+	impl, err := h.factory.GameImpls(&bind.CallOpts{Context: ctx}, gameType)
+	h.require.NoErrorf(err, "load implementation for game type %v", gameType)
+	registered := time.Duration(h.ImplementationBehaviour(ctx, impl).GameDuration) * time.Second
+	gameDuration := h.gameDuration
+	if gameDuration == 0 {
+		if h.baseGameDurations == nil {
+			h.baseGameDurations = make(map[uint8]time.Duration)
+		}
+		if _, ok := h.baseGameDurations[gameType]; !ok {
+			h.baseGameDurations[gameType] = registered
+		}
+		gameDuration = h.baseGameDurations[gameType]
+	}
+	gameDuration = h.timeoutScale.apply(gameDuration)
+	if registered == gameDuration {
+		return
+	}
+	impl, err = h.DeployImplementation(ctx, gameType, uint64(gameDuration/time.Second))
+	h.require.NoErrorf(err, "deploy implementation for game type %v", gameType)
+	h.SetImplementation(ctx, gameType, impl)
+	h.t.Logf("Registered implementation %v for game type %v with game duration %v", impl, gameType, gameDuration)
+}
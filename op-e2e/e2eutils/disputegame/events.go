@@ -0,0 +1,71 @@
+package disputegame
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RequireCreationEventIndexed fails the test unless every game of gameType created by the helper can be found by
+// filtering the factory's DisputeGameCreated events on the indexed game type topic, and by the indexed proxy topic
+// together with the game type. Off-chain indexers and monitoring rely on these filters to discover games rather
+// than parsing each creation receipt.
+func (h *FactoryHelper) RequireCreationEventIndexed(ctx context.Context, gameType uint8) {
+	ctx, cancel := h.withTimeout(ctx, time.Minute)
+	defer cancel()
+	games := h.createdGamesOfType(ctx, gameType)
+	h.require.NotEmptyf(games, "no games of type %v created by the helper", gameType)
+
+	opts := &bind.FilterOpts{Context: ctx}
+	iter, err := h.factory.FilterDisputeGameCreated(opts, nil, []uint8{gameType}, nil)
+	h.require.NoError(err, "filter DisputeGameCreated events by game type")
+	indexed := make(map[common.Address]common.Hash)
+	for iter.Next() {
+		h.require.Equalf(gameType, iter.Event.GameType, "filter by game type %v returned game %v of type %v",
+			gameType, iter.Event.DisputeProxy, iter.Event.GameType)
+		indexed[iter.Event.DisputeProxy] = iter.Event.Raw.TxHash
+	}
+	h.require.NoError(iter.Error(), "iterate DisputeGameCreated events by game type")
+	h.require.NoError(iter.Close())
+
+	for addr, creation := range games {
+		txHash, ok := indexed[addr]
+		h.require.Truef(ok, "game %v not found by filtering on game type %v", addr, gameType)
+		h.require.Equalf(creation.TxHash, txHash, "game %v indexed with the wrong creation tx", addr)
+
+		iter, err := h.factory.FilterDisputeGameCreated(opts, []common.Address{addr}, []uint8{gameType}, nil)
+		h.require.NoErrorf(err, "filter DisputeGameCreated events for game %v", addr)
+		count := 0
+		for iter.Next() {
+			count++
+		}
+		h.require.NoErrorf(iter.Error(), "iterate DisputeGameCreated events for game %v", addr)
+		h.require.NoError(iter.Close())
+		h.require.Equalf(1, count, "game %v should have exactly one creation event", addr)
+	}
+}
+
+// createdGamesOfType returns the creation details of each game of gameType created by the helper.
+func (h *FactoryHelper) createdGamesOfType(ctx context.Context, gameType uint8) map[common.Address]GameCreation {
+	h.creationsLock.Lock()
+	creations := make(map[common.Address]GameCreation, len(h.creations))
+	for addr, creation := range h.creations {
+		creations[addr] = creation
+	}
+	h.creationsLock.Unlock()
+
+	games := make(map[common.Address]GameCreation)
+	for addr, creation := range creations {
+		game, err := bindings.NewFaultDisputeGameCaller(addr, h.client)
+		h.require.NoError(err)
+		actual, err := game.GameType(&bind.CallOpts{Context: ctx})
+		h.require.NoErrorf(err, "load game type of %v", addr)
+		if actual == gameType {
+			games[addr] = creation
+		}
+	}
+	return games
+}
@@ -0,0 +1,67 @@
+package disputegame
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/challenger"
+)
+
+// existingGameBlocks is the number of L1 blocks to wait for after creating a game before starting the challenger,
+// so the game's creation is well behind the L1 head the challenger first sees.
+const existingGameBlocks = 3
+
+// RequireChallengerPlaysExistingGame creates an alphabet game with an invalid root claim, waits for further L1 blocks
+// and only then starts a challenger, configured by options, that discovers games from the dispute game factory
+// rather than being given the game address. This checks the challenger finds and plays games that existed before it
+// started rather than relying on seeing them created.
+// The challenger must counter the root claim in a block after it started and, once L1 time is advanced past the end
+// of the game, resolve it with the challenger winning.
+// Fails the test if the helper wasn't created with WithL1TimeAdvancer.
+func (h *FactoryHelper) RequireChallengerPlaysExistingGame(ctx context.Context, l1Endpoint string, options ...challenger.Option) {
+	h.require.NoError(h.checkTimeControl(), "resolve existing game")
+	game := h.StartAlphabetGame(ctx, "abcdexyz")
+	h.waitForL1Block(ctx, game.creation.BlockNumber+existingGameBlocks)
+	startHead, err := h.client.BlockNumber(ctx)
+	h.require.NoError(err, "load L1 head")
+
+	opts := []challenger.Option{
+		func(c *config.Config) {
+			c.AgreeWithProposedOutput = true // Agree with the proposed output, so disagree with the root claim
+			c.AlphabetTrace = CorrectAlphabet
+		},
+		challenger.WithGameFactory(h.factoryAddr),
+	}
+	c := game.StartChallenger(ctx, l1Endpoint, "LateStarter", append(opts, options...)...)
+	game.WaitForClaimCount(ctx, 2)
+
+	responded := false
+	game.forEachTxFrom(ctx, c.Address(), func(scanned ScannedTx) {
+		to := scanned.Tx.To()
+		if to == nil || *to != game.addr {
+			return
+		}
+		h.require.Greaterf(scanned.Receipt.BlockNumber.Uint64(), startHead,
+			"challenger tx %v included before the challenger started", scanned.Tx.Hash())
+		responded = true
+	})
+	h.require.True(responded, "challenger did not respond to the existing game")
+
+	h.AdvanceL1Time(ctx, game.GameDuration(ctx))
+	game.WaitForGameStatus(ctx, StatusChallengerWins)
+}
+
+// waitForL1Block waits until the L1 head is at or after block number target.
+func (h *FactoryHelper) waitForL1Block(ctx context.Context, target uint64) {
+	ctx, cancel := h.withTimeout(ctx, time.Minute)
+	defer cancel()
+	err := waitFor(ctx, h.clock, 100*time.Millisecond, func() (bool, error) {
+		head, err := h.client.BlockNumber(ctx)
+		if err != nil {
+			return false, err
+		}
+		return head >= target, nil
+	})
+	h.require.NoErrorf(err, "L1 did not reach block %v", target)
+}
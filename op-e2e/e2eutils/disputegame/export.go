@@ -0,0 +1,154 @@
+package disputegame
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// GameExport is the state of a game as written by ExportJSON.
+type GameExport struct {
+	Address   common.Address `json:"address"`
+	Version   string         `json:"version"`
+	GameType  uint8          `json:"gameType"`
+	RootClaim common.Hash    `json:"rootClaim"`
+	// L2BlockNumber is the L2 block the root claim is an output root for.
+	L2BlockNumber uint64 `json:"l2BlockNumber"`
+	// L1Head is the hash of the L1 block the game was created with.
+	L1Head common.Hash `json:"l1Head"`
+	// ExtraData is the extra data the game was created with.
+	ExtraData hexutil.Bytes `json:"extraData"`
+	MaxDepth  int           `json:"maxDepth"`
+	// GameDuration is the total duration of the game in seconds, shared equally between both sides' clocks.
+	GameDuration uint64       `json:"gameDuration"`
+	CreatedAt    GameCreation `json:"createdAt"`
+	// Status is the human-readable form of StatusCode.
+	Status     string        `json:"status"`
+	StatusCode Status        `json:"statusCode"`
+	Claims     []ClaimExport `json:"claims"`
+}
+
+// ClaimExport is a single claim in a GameExport.
+type ClaimExport struct {
+	Index int `json:"index"`
+	// ParentIndex is the index of the claim this claim responds to. The root claim's parent index is 2^32-1.
+	ParentIndex uint32         `json:"parentIndex"`
+	Claimant    common.Address `json:"claimant"`
+	Value       common.Hash    `json:"value"`
+	// Position is the generalized index of the claim in the game tree.
+	Position     uint64 `json:"position"`
+	Depth        int    `json:"depth"`
+	IndexAtDepth int    `json:"indexAtDepth"`
+	// Path is the claim's position rendered as bisection steps from the root, as returned by ClaimPath.
+	Path      string `json:"path"`
+	Countered bool   `json:"countered"`
+	// ClockDuration is the duration in seconds accumulated on the claimant's clock when the claim was made.
+	ClockDuration uint64 `json:"clockDuration"`
+	// ClockTimestamp is the L1 timestamp the claim was made at.
+	ClockTimestamp uint64 `json:"clockTimestamp"`
+}
+
+// ExportJSON writes the current state of the game, including every claim, to path as indented JSON.
+// The format is described by GameExport.
+func (g *FaultGameHelper) ExportJSON(ctx context.Context, path string) {
+	data, err := json.MarshalIndent(g.Export(ctx), "", "  ")
+	g.require.NoError(err, "failed to encode game state")
+	g.require.NoErrorf(os.WriteFile(path, data, 0o644), "failed to write game state to %v", path)
+}
+
+// Export loads the current state of the game, including every claim.
+func (g *FaultGameHelper) Export(ctx context.Context) GameExport {
+	opts := &bind.CallOpts{Context: ctx}
+	gameType, err := g.game.GameType(opts)
+	g.require.NoError(err, "failed to load game type")
+	rootClaim, err := g.LoadRootClaim(ctx)
+	g.require.NoError(err, "failed to load root claim")
+	l1Head, err := g.game.L1Head(opts)
+	g.require.NoError(err, "failed to load L1 head")
+	extraData, err := g.game.ExtraData(opts)
+	g.require.NoError(err, "failed to load extra data")
+	duration, err := g.game.GAMEDURATION(opts)
+	g.require.NoError(err, "failed to load game duration")
+	status, err := g.LoadStatus(ctx)
+	g.require.NoError(err, "failed to load status")
+
+	claims := g.GetAllClaims(ctx)
+	claimants, err := g.loadClaimants(ctx)
+	g.require.NoError(err, "failed to load claimants")
+	exported := make([]ClaimExport, 0, len(claims))
+	for i, claim := range claims {
+		pos := types.NewPositionFromGIndex(claim.Position.Uint64())
+		var claimant common.Address
+		// Moves made after the claims were loaded may have added more claimants.
+		if i < len(claimants) {
+			claimant = claimants[i]
+		}
+		exported = append(exported, ClaimExport{
+			Index:          i,
+			ParentIndex:    claim.ParentIndex,
+			Claimant:       claimant,
+			Value:          claim.Claim,
+			Position:       pos.ToGIndex(),
+			Depth:          pos.Depth(),
+			IndexAtDepth:   pos.IndexAtDepth(),
+			Path:           positionPath(pos),
+			Countered:      claim.Countered,
+			ClockDuration:  uint64(claim.ClockDuration().Seconds()),
+			ClockTimestamp: claim.ClockTimestamp(),
+		})
+	}
+	return GameExport{
+		Address:       g.addr,
+		Version:       g.Version(ctx),
+		GameType:      gameType,
+		RootClaim:     rootClaim,
+		L2BlockNumber: g.L2BlockNumber(ctx),
+		L1Head:        l1Head,
+		ExtraData:     extraData,
+		MaxDepth:      g.maxDepth,
+		GameDuration:  duration,
+		CreatedAt:     g.creation,
+		Status:        status.String(),
+		StatusCode:    status,
+		Claims:        exported,
+	}
+}
+
+// loadClaimants returns the address that made each claim, in claim index order.
+// The root claimant is the sender of the transaction that created the game and each subsequent claimant is
+// recorded in the Move event for the claim.
+func (g *FaultGameHelper) loadClaimants(ctx context.Context) ([]common.Address, error) {
+	tx, _, err := g.client.TransactionByHash(ctx, g.creation.TxHash)
+	if err != nil {
+		return nil, fmt.Errorf("load creation tx %v: %w", g.creation.TxHash, err)
+	}
+	chainID, err := g.client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load L1 chain ID: %w", err)
+	}
+	creator, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(chainID), tx)
+	if err != nil {
+		return nil, fmt.Errorf("recover sender of creation tx %v: %w", tx.Hash(), err)
+	}
+	claimants := []common.Address{creator}
+
+	iter, err := g.game.FilterMove(&bind.FilterOpts{Context: ctx, Start: g.creation.BlockNumber}, nil, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("filter move events: %w", err)
+	}
+	defer iter.Close()
+	for iter.Next() {
+		claimants = append(claimants, iter.Event.Claimant)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("iterate move events: %w", err)
+	}
+	return claimants, nil
+}
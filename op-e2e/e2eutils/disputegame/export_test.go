@@ -0,0 +1,70 @@
+package disputegame
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExportFormat guards the JSON field names written by ExportJSON so offline tooling keeps working.
+func TestExportFormat(t *testing.T) {
+	export := GameExport{
+		Address:       common.Address{0x01},
+		Version:       "0.0.7",
+		GameType:      alphabetGameType,
+		RootClaim:     common.Hash{0x02},
+		L2BlockNumber: 8,
+		L1Head:        common.Hash{0x03},
+		ExtraData:     makeExtraData(10),
+		MaxDepth:      alphabetGameDepth,
+		GameDuration:  300,
+		CreatedAt:     GameCreation{BlockNumber: 10, TxHash: common.Hash{0x04}, Timestamp: 20},
+		Status:        StatusInProgress.String(),
+		StatusCode:    StatusInProgress,
+		Claims: []ClaimExport{{
+			Index:          0,
+			ParentIndex:    math.MaxUint32,
+			Claimant:       common.Address{0x05},
+			Value:          common.Hash{0x06},
+			Position:       1,
+			Depth:          0,
+			IndexAtDepth:   0,
+			Path:           "root",
+			ClockDuration:  0,
+			ClockTimestamp: 20,
+		}},
+	}
+	data, err := json.Marshal(export)
+	require.NoError(t, err)
+
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(data, &fields))
+	require.ElementsMatch(t, []string{
+		"address", "version", "gameType", "rootClaim", "l2BlockNumber", "l1Head", "extraData", "maxDepth", "gameDuration",
+		"createdAt", "status", "statusCode", "claims",
+	}, keys(fields))
+	require.JSONEq(t, `{"blockNumber":10,"txHash":"0x0400000000000000000000000000000000000000000000000000000000000000","timestamp":20}`, string(fields["createdAt"]))
+
+	var claims []map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(fields["claims"], &claims))
+	require.Len(t, claims, 1)
+	require.ElementsMatch(t, []string{
+		"index", "parentIndex", "claimant", "value", "position", "depth", "indexAtDepth", "path", "countered",
+		"clockDuration", "clockTimestamp",
+	}, keys(claims[0]))
+
+	var decoded GameExport
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, export, decoded)
+}
+
+func keys(m map[string]json.RawMessage) []string {
+	var result []string
+	for k := range m {
+		result = append(result, k)
+	}
+	return result
+}
@@ -0,0 +1,93 @@
+package disputegame
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// extraDataLen is the length of the extra data games are created with, containing both the L2 block number and the
+// L1 block number of the checkpointed L1 head as 32 byte words.
+const extraDataLen = 64
+
+var ErrInvalidExtraData = errors.New("invalid extra data")
+
+// ExtraDataEncoder encodes the extra data games are created with, so tests can create games with extra data the
+// helper wouldn't build itself.
+type ExtraDataEncoder interface {
+	EncodeExtraData(d GameExtraData) ([]byte, error)
+}
+
+// GameExtraData is the extra data identifying the output a game disputes.
+type GameExtraData struct {
+	L2BlockNumber uint64
+	// L1Head is the L1 block number of the checkpointed L1 head.
+	L1Head uint64
+}
+
+// Encode encodes the extra data as the L2 block number followed by the L1 head.
+func (d GameExtraData) Encode() []byte {
+	extraData := make([]byte, extraDataLen)
+	binary.BigEndian.PutUint64(extraData[24:], d.L2BlockNumber)
+	binary.BigEndian.PutUint64(extraData[56:], d.L1Head)
+	return extraData
+}
+
+// gameExtraData returns the extra data for a game disputing disputedL2BlockNumber with the checkpointed L1 block
+// l1Head, encoded by encoder unless it is nil.
+func gameExtraData(l1Head uint64, encoder ExtraDataEncoder) ([]byte, error) {
+	if encoder == nil {
+		return makeExtraData(l1Head), nil
+	}
+	return encoder.EncodeExtraData(GameExtraData{L2BlockNumber: disputedL2BlockNumber, L1Head: l1Head})
+}
+
+// encodeExtraData is gameExtraData, failing the test if encoder returns an error.
+func (h *FactoryHelper) encodeExtraData(l1Head uint64, encoder ExtraDataEncoder) []byte {
+	extraData, err := gameExtraData(l1Head, encoder)
+	h.require.NoError(err)
+	return extraData
+}
+
+// DecodeExtraData reads and decodes the extra data of an existing fault dispute game.
+// The returned l1Head is the L1 block number the game was created with.
+func DecodeExtraData(game *bindings.FaultDisputeGame) (l2BlockNum uint64, l1Head uint64, err error) {
+	return loadExtraData(context.Background(), game)
+}
+
+func loadExtraData(ctx context.Context, game *bindings.FaultDisputeGame) (l2BlockNum uint64, l1Head uint64, err error) {
+	extraData, err := game.ExtraData(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to load extra data: %w", err)
+	}
+	return decodeExtraData(extraData)
+}
+
+func decodeExtraData(extraData []byte) (l2BlockNum uint64, l1Head uint64, err error) {
+	if len(extraData) != extraDataLen {
+		return 0, 0, fmt.Errorf("%w: unexpected length %v", ErrInvalidExtraData, len(extraData))
+	}
+	l2BlockNum, err = decodeUint64Word(extraData[0:32])
+	if err != nil {
+		return 0, 0, fmt.Errorf("l2 block number: %w", err)
+	}
+	l1Head, err = decodeUint64Word(extraData[32:64])
+	if err != nil {
+		return 0, 0, fmt.Errorf("l1 head: %w", err)
+	}
+	return l2BlockNum, l1Head, nil
+}
+
+// decodeUint64Word decodes a big endian 32 byte word, requiring that the value fits in a uint64.
+func decodeUint64Word(word []byte) (uint64, error) {
+	for _, b := range word[:24] {
+		if b != 0 {
+			return 0, fmt.Errorf("%w: value exceeds uint64", ErrInvalidExtraData)
+		}
+	}
+	return binary.BigEndian.Uint64(word[24:32]), nil
+}
@@ -0,0 +1,68 @@
+package disputegame
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeExtraData(t *testing.T) {
+	t.Run("Legacy", func(t *testing.T) {
+		extraData := make([]byte, 64)
+		binary.BigEndian.PutUint64(extraData[24:], 8)
+		binary.BigEndian.PutUint64(extraData[56:], 1234)
+		l2BlockNum, l1Head, err := decodeExtraData(extraData)
+		require.NoError(t, err)
+		require.Equal(t, uint64(8), l2BlockNum)
+		require.Equal(t, uint64(1234), l1Head)
+	})
+
+	t.Run("MalformedLength", func(t *testing.T) {
+		_, _, err := decodeExtraData(make([]byte, 63))
+		require.ErrorIs(t, err, ErrInvalidExtraData)
+	})
+
+	t.Run("WordOnly", func(t *testing.T) {
+		_, _, err := decodeExtraData(make([]byte, 32))
+		require.ErrorIs(t, err, ErrInvalidExtraData)
+	})
+
+	t.Run("ValueTooLarge", func(t *testing.T) {
+		extraData := make([]byte, 64)
+		extraData[0] = 1
+		_, _, err := decodeExtraData(extraData)
+		require.ErrorIs(t, err, ErrInvalidExtraData)
+	})
+}
+
+// reversedEncoder encodes extra data the helper wouldn't build, with the L1 head before the L2 block number.
+type reversedEncoder struct{}
+
+func (reversedEncoder) EncodeExtraData(d GameExtraData) ([]byte, error) {
+	extraData := make([]byte, 64)
+	binary.BigEndian.PutUint64(extraData[24:], d.L1Head)
+	binary.BigEndian.PutUint64(extraData[56:], d.L2BlockNumber)
+	return extraData, nil
+}
+
+func TestExtraDataEncoder(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		extraData, err := gameExtraData(1234, nil)
+		require.NoError(t, err)
+		require.Equal(t, makeExtraData(1234), extraData)
+		l2BlockNum, l1Head, err := decodeExtraData(extraData)
+		require.NoError(t, err)
+		require.Equal(t, disputedL2BlockNumber, l2BlockNum)
+		require.Equal(t, uint64(1234), l1Head)
+	})
+
+	t.Run("Custom", func(t *testing.T) {
+		extraData, err := gameExtraData(1234, reversedEncoder{})
+		require.NoError(t, err)
+		l2BlockNum, l1Head, err := decodeExtraData(extraData)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1234), l2BlockNum)
+		require.Equal(t, disputedL2BlockNumber, l1Head)
+	})
+}
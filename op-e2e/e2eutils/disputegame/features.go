@@ -0,0 +1,147 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/client/utils"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	claimCreditSelector  = crypto.Keccak256([]byte("claimCredit(address)"))[:4]
+	creditSelector       = crypto.Keccak256([]byte("credit(address)"))[:4]
+	resolveClaimSelector = crypto.Keccak256([]byte("resolveClaim(uint256)"))[:4]
+)
+
+// Version returns the semver version string reported by the game contract.
+func (g *FaultGameHelper) Version(ctx context.Context) string {
+	version, err := g.game.Version(&bind.CallOpts{Context: ctx})
+	g.require.NoError(err, "failed to load game version")
+	return version
+}
+
+// SupportsBonds returns true if the game contract requires bonds for moves and pays out credit on resolution.
+func (g *FaultGameHelper) SupportsBonds(ctx context.Context) bool {
+	return g.hasFunction(ctx, claimCreditSelector, common.Address{}.Bytes())
+}
+
+// SupportsSubgameResolution returns true if the game contract requires each subgame to be resolved via
+// resolveClaim before the game as a whole can be resolved.
+func (g *FaultGameHelper) SupportsSubgameResolution(ctx context.Context) bool {
+	return g.hasFunction(ctx, resolveClaimSelector, common.Hash{}.Bytes())
+}
+
+// RequireSupportsBonds skips the current test if the game contract does not support bonds.
+func (g *FaultGameHelper) RequireSupportsBonds(ctx context.Context) {
+	if !g.SupportsBonds(ctx) {
+		g.t.Skipf("Game version %v does not support bonds", g.Version(ctx))
+	}
+}
+
+// Credit returns the amount of credit available for recipient to claim.
+// The current test is skipped if the game contract does not support bonds.
+func (g *FaultGameHelper) Credit(ctx context.Context, recipient common.Address) *big.Int {
+	g.RequireSupportsBonds(ctx)
+	credit, err := g.loadCredit(ctx, recipient)
+	g.require.NoError(err)
+	return credit
+}
+
+func (g *FaultGameHelper) loadCredit(ctx context.Context, recipient common.Address) (*big.Int, error) {
+	data := append(append([]byte{}, creditSelector...), common.LeftPadBytes(recipient.Bytes(), 32)...)
+	result, err := g.client.CallContract(ctx, ethereum.CallMsg{To: &g.addr, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("load credit of %v: %w", recipient, err)
+	}
+	return new(big.Int).SetBytes(result), nil
+}
+
+// claimCreditCalldata returns the calldata to claim the credit available to recipient.
+func claimCreditCalldata(recipient common.Address) []byte {
+	return append(append([]byte{}, claimCreditSelector...), common.LeftPadBytes(recipient.Bytes(), 32)...)
+}
+
+// hasFunction detects if the game contract implements the function with the specified selector.
+// The game contracts have no fallback function so calls to unknown functions revert without any revert data,
+// whereas known functions either succeed or revert with a custom error or panic code.
+// The result is cached as the game's code doesn't change, so the game is only probed once per function.
+func (g *FaultGameHelper) hasFunction(ctx context.Context, selector []byte, args []byte) bool {
+	key := string(selector) + string(args)
+	g.probes.lock.Lock()
+	defer g.probes.lock.Unlock()
+	if ok, cached := g.probes.functions[key]; cached {
+		return ok
+	}
+	ok, err := hasFunction(ctx, g.client, g.addr, selector, args)
+	g.require.NoError(err)
+	g.probes.functions[key] = ok
+	return ok
+}
+
+// hasFunction returns true if the contract at addr implements the function with selector.
+// A call that reverts with data is assumed to have reached the function, while contracts without a fallback revert
+// without data when called with an unknown selector.
+func hasFunction(ctx context.Context, client ethereum.ContractCaller, addr common.Address, selector []byte, args []byte) (bool, error) {
+	data := append(append([]byte{}, selector...), args...)
+	_, err := client.CallContract(ctx, ethereum.CallMsg{To: &addr, Data: data}, nil)
+	if err == nil {
+		return true, nil
+	}
+	revertData, ok := extractRevertData(err)
+	if !ok {
+		return false, fmt.Errorf("probe for function %x: %w", selector, err)
+	}
+	return len(revertData) > 0, nil
+}
+
+// resolveAllClaims resolves every subgame, starting from the most recent claim so that children are always
+// resolved before their parents.
+func (g *FaultGameHelper) resolveAllClaims(ctx context.Context) []*ethtypes.Receipt {
+	count, err := g.game.ClaimDataLen(&bind.CallOpts{Context: ctx})
+	g.require.NoError(err, "retrieve number of claims")
+	var receipts []*ethtypes.Receipt
+	for i := count.Int64() - 1; i >= 0; i-- {
+		rcpt, err := g.resolveClaim(ctx, i)
+		g.require.NoErrorf(err, "resolve claim %v", i)
+		receipts = append(receipts, rcpt)
+	}
+	return receipts
+}
+
+func (g *FaultGameHelper) resolveClaim(ctx context.Context, claimIdx int64) (*ethtypes.Receipt, error) {
+	ctx, cancel := g.withTimeout(ctx, time.Minute)
+	defer cancel()
+	data := append(append([]byte{}, resolveClaimSelector...), common.BigToHash(big.NewInt(claimIdx)).Bytes()...)
+	// Check the call succeeds first so the revert reason can be reported rather than a failed gas estimate.
+	if _, err := g.client.CallContract(ctx, ethereum.CallMsg{From: g.opts.From, To: &g.addr, Data: data}, nil); err != nil {
+		if reason, ok := decodeRevertReason(err); ok {
+			return nil, fmt.Errorf("resolve claim %v reverted: %v: %w", claimIdx, reason, err)
+		}
+		return nil, fmt.Errorf("resolve claim %v: %w", claimIdx, err)
+	}
+	return g.sendRawTxReceipt(ctx, data)
+}
+
+// sendRawTx sends a transaction with the specified calldata to the game contract and waits for a successful receipt.
+func (g *FaultGameHelper) sendRawTx(ctx context.Context, data []byte) error {
+	_, err := g.sendRawTxReceipt(ctx, data)
+	return err
+}
+
+// sendRawTxReceipt is like sendRawTx but also returns the receipt.
+func (g *FaultGameHelper) sendRawTxReceipt(ctx context.Context, data []byte) (*ethtypes.Receipt, error) {
+	contract := bind.NewBoundContract(g.addr, abi.ABI{}, g.client, g.client, g.client)
+	tx, err := contract.RawTransact(g.opts, data)
+	if err != nil {
+		return nil, fmt.Errorf("send tx: %w", err)
+	}
+	return utils.WaitReceiptOK(ctx, g.client, tx.Hash())
+}
@@ -0,0 +1,84 @@
+package disputegame
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+// stubContract responds to calls like a contract without a fallback function, so unknown selectors revert without
+// data.
+type stubContract struct {
+	addr common.Address
+	// functions maps the hex encoded selector of each implemented function to the error it returns, if any.
+	functions map[string]error
+	// err is returned for every call if set, as if the RPC request failed.
+	err   error
+	calls []ethereum.CallMsg
+}
+
+func (s *stubContract) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	s.calls = append(s.calls, call)
+	if s.err != nil {
+		return nil, s.err
+	}
+	if call.To == nil || *call.To != s.addr {
+		return nil, nil
+	}
+	fnErr, ok := s.functions[hexutil.Encode(call.Data[:4])]
+	if !ok {
+		return nil, stubDataError{data: "0x"}
+	}
+	return nil, fnErr
+}
+
+func TestHasFunction(t *testing.T) {
+	addr := common.Address{0xaa}
+	contract := &stubContract{
+		addr: addr,
+		functions: map[string]error{
+			hexutil.Encode(claimCreditSelector):  nil,
+			hexutil.Encode(resolveClaimSelector): stubDataError{data: "0x0ea2e752"},
+		},
+	}
+
+	t.Run("Succeeds", func(t *testing.T) {
+		ok, err := hasFunction(context.Background(), contract, addr, claimCreditSelector, common.Address{}.Bytes())
+		require.NoError(t, err)
+		require.True(t, ok)
+	})
+
+	t.Run("RevertsWithData", func(t *testing.T) {
+		ok, err := hasFunction(context.Background(), contract, addr, resolveClaimSelector, common.Hash{}.Bytes())
+		require.NoError(t, err)
+		require.True(t, ok, "a custom error means the function was reached")
+	})
+
+	t.Run("RevertsWithoutData", func(t *testing.T) {
+		ok, err := hasFunction(context.Background(), contract, addr, getRequiredBondSelector, common.Hash{}.Bytes())
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("IncludesArgs", func(t *testing.T) {
+		contract.calls = nil
+		args := common.LeftPadBytes(common.Address{0xbb}.Bytes(), 32)
+		_, err := hasFunction(context.Background(), contract, addr, creditSelector, args)
+		require.NoError(t, err)
+		require.Len(t, contract.calls, 1)
+		require.Equal(t, append(append([]byte{}, creditSelector...), args...), contract.calls[0].Data)
+		require.Equal(t, addr, *contract.calls[0].To)
+	})
+
+	t.Run("RequestFails", func(t *testing.T) {
+		failing := &stubContract{addr: addr, err: errors.New("connection refused")}
+		_, err := hasFunction(context.Background(), failing, addr, claimCreditSelector, common.Address{}.Bytes())
+		require.ErrorContains(t, err, "connection refused")
+	})
+}
@@ -0,0 +1,92 @@
+package disputegame
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// ErrOutputFinalized is returned when the output a game would dispute has already passed the finalization period.
+var ErrOutputFinalized = errors.New("disputed output is finalized")
+
+type FactoryOption func(h *FactoryHelper)
+
+// WithFinalizationCheck makes the helper refuse to start games disputing an output that has already finalized.
+// The output is only checked once, before each game is created. It isn't re-checked while waiting for the game to
+// be created or played, so an output that finalizes during that time, as it soon will with the devnet's short
+// finalization period, is not detected.
+func WithFinalizationCheck() FactoryOption {
+	return func(h *FactoryHelper) {
+		h.checkFinalization = true
+	}
+}
+
+// CheckDisputedOutputNotFinalized returns ErrOutputFinalized if the output games are created for has passed the
+// L2OutputOracle's finalization period, measured using the latest L1 block timestamp.
+func (c *FactoryCore) CheckDisputedOutputNotFinalized(ctx context.Context) error {
+	opts := &bind.CallOpts{Context: ctx}
+	output, err := c.l2oo.GetL2OutputAfter(opts, new(big.Int).SetUint64(disputedL2BlockNumber))
+	if err != nil {
+		return fmt.Errorf("load disputed output: %w", err)
+	}
+	finalizedAt, err := c.finalizedAt(ctx, output)
+	if err != nil {
+		return err
+	}
+	head, err := c.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("load L1 head: %w", err)
+	}
+	if head.Time > finalizedAt {
+		return fmt.Errorf("%w: output for L2 block %v proposed at %v finalized at %v, L1 time is now %v", ErrOutputFinalized,
+			output.L2BlockNumber, time.Unix(output.Timestamp.Int64(), 0), time.Unix(int64(finalizedAt), 0), time.Unix(int64(head.Time), 0))
+	}
+	return nil
+}
+
+// finalizedAt returns the L1 timestamp after which output has passed the L2OutputOracle's finalization period.
+func (c *FactoryCore) finalizedAt(ctx context.Context, output bindings.TypesOutputProposal) (uint64, error) {
+	period, err := c.l2oo.FINALIZATIONPERIODSECONDS(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, fmt.Errorf("load finalization period: %w", err)
+	}
+	return output.Timestamp.Uint64() + period.Uint64(), nil
+}
+
+// DisputedOutputIndex returns the index in the L2OutputOracle of the output proposal games created by the helper
+// dispute.
+func (h *FactoryHelper) DisputedOutputIndex(ctx context.Context) uint64 {
+	h.waitForProposals(ctx)
+	idx, err := h.l2oo.GetL2OutputIndexAfter(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(disputedL2BlockNumber))
+	h.require.NoError(err, "find disputed output index")
+	return idx.Uint64()
+}
+
+// WaitForOutputFinalized moves L1 time forward until the output proposal at outputIndex has passed the
+// L2OutputOracle's finalization period and waits for an L1 block after it finalized.
+// Fails the test if the helper wasn't created with WithL1TimeAdvancer.
+func (h *FactoryHelper) WaitForOutputFinalized(ctx context.Context, outputIndex uint64) {
+	ctx, cancel := h.withTimeout(ctx, time.Minute)
+	defer cancel()
+	h.require.NoErrorf(h.checkTimeControl(), "wait for output %v to finalize", outputIndex)
+	output, err := h.l2oo.GetL2Output(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(outputIndex))
+	h.require.NoErrorf(err, "load output %v", outputIndex)
+	finalizedAt, err := h.finalizedAt(ctx, output)
+	h.require.NoError(err)
+	h.advanceL1TimeTo(ctx, finalizedAt+1)
+}
+
+// requireOutputNotFinalized fails the test if finalization checks are enabled and the disputed output has finalized.
+// The check is made after waiting for proposals, as the disputed output isn't available until it is proposed.
+func (h *FactoryHelper) requireOutputNotFinalized(ctx context.Context) {
+	if !h.checkFinalization {
+		return
+	}
+	h.waitForProposals(ctx)
+	h.require.NoError(h.CheckDisputedOutputNotFinalized(ctx))
+}
@@ -0,0 +1,52 @@
+package disputegame
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/cannon"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// fixtureGameDepth is the max depth of cannon games created from a fixture. The fixture program only runs for a few
+// steps so a shallow game covers its whole trace, and an odd depth means the claims at max depth are made by the
+// challenger of the root claim, so an honest defender is the one to step.
+const fixtureGameDepth = 3
+
+// StartFixtureCannonGame creates a cannon game for the pre-generated cannon trace in fixtureDir, allowing the on-chain
+// MIPS step to be tested without executing cannon. A cannon implementation using the fixture's prestate as its
+// absolute prestate is deployed and registered with the factory, so the helper must be created with WithFactoryOwner.
+// The game's root claim is the fixture's final state and challengers started via the returned helper read their
+// trace from the fixture.
+func (h *FactoryHelper) StartFixtureCannonGame(ctx context.Context, fixtureDir string) *CannonGameHelper {
+	fixture, err := cannon.LoadFixture(fixtureDir)
+	h.require.NoError(err, "load cannon fixture")
+	h.require.NotNil(h.ownerOpts, "factory owner not configured")
+
+	h.waitForProposals(ctx)
+	ctx, cancel := h.withTimeout(ctx, 2*time.Minute)
+	defer cancel()
+	h.registerFixtureImplementation(ctx, fixture, fixtureGameDepth)
+
+	l1Head := h.checkpointL1Block(ctx)
+	rootClaim := crypto.Keccak256Hash(fixture.Final.EncodeWitness())
+	addr, err := h.CreateGame(ctx, cannonGameType, rootClaim, makeExtraData(l1Head.Uint64()))
+	h.require.NoError(err, "create fixture cannon game")
+	return &CannonGameHelper{
+		FaultGameHelper: h.newGameHelper(ctx, addr, fixtureGameDepth),
+		fixtureDir:      fixtureDir,
+	}
+}
+
+// registerFixtureImplementation deploys a cannon implementation using the fixture's prestate as its absolute prestate
+// and maxDepth as its max game depth, and registers it with the factory as the cannon game type.
+func (h *FactoryHelper) registerFixtureImplementation(ctx context.Context, fixture *cannon.Fixture, maxDepth int64) {
+	params, err := h.loadImplementationParams(ctx, cannonGameType)
+	h.require.NoError(err)
+	params.prestate = crypto.Keccak256Hash(fixture.Prestate.EncodeWitness())
+	params.maxDepth = big.NewInt(maxDepth)
+	impl, err := h.deployImplementation(ctx, cannonGameType, params)
+	h.require.NoError(err, "deploy fixture cannon implementation")
+	h.SetImplementation(ctx, cannonGameType, impl)
+}
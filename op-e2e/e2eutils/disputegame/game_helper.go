@@ -9,21 +9,29 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
-	"github.com/ethereum-optimism/optimism/op-service/client/utils"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/require"
 )
 
 type FaultGameHelper struct {
-	t        *testing.T
-	require  *require.Assertions
-	client   *ethclient.Client
-	opts     *bind.TransactOpts
-	game     *bindings.FaultDisputeGame
+	t       *testing.T
+	require *require.Assertions
+	*GameCore
 	maxDepth int
-	addr     common.Address
+	creation GameCreation
+
+	challengerInvariants bool
+	// timeAdvancer moves L1 time forward, if the factory helper was created with WithL1TimeAdvancer.
+	timeAdvancer TimeAdvancer
+	// timeoutScale multiplies the helper's timeouts, as configured by WithTimeoutScale.
+	timeoutScale timeoutScale
+}
+
+// CreatedAt returns the L1 block number, transaction hash and timestamp the game was created at.
+func (g *FaultGameHelper) CreatedAt() GameCreation {
+	return g.creation
 }
 
 func (g *FaultGameHelper) GameDuration(ctx context.Context) time.Duration {
@@ -32,16 +40,69 @@ func (g *FaultGameHelper) GameDuration(ctx context.Context) time.Duration {
 	return time.Duration(duration) * time.Second
 }
 
+// L2BlockNumber returns the L2 block number the game's root claim is for, as reported by the game contract. Fails the
+// test if the game's extra data records a different block.
+func (g *FaultGameHelper) L2BlockNumber(ctx context.Context) uint64 {
+	l2BlockNum, err := g.game.L2BlockNumber(&bind.CallOpts{Context: ctx})
+	g.require.NoError(err, "failed to load L2 block number")
+	fromExtraData, _, err := loadExtraData(ctx, g.game)
+	g.require.NoError(err, "failed to decode extra data")
+	g.require.Equalf(fromExtraData, l2BlockNum.Uint64(), "game %v extra data has unexpected L2 block number", g.addr)
+	return l2BlockNum.Uint64()
+}
+
+// RequireL2BlockNumber fails the test unless the game disputes expected.
+func (g *FaultGameHelper) RequireL2BlockNumber(ctx context.Context, expected uint64) {
+	g.require.Equalf(expected, g.L2BlockNumber(ctx), "game %v disputes unexpected L2 block number", g.addr)
+}
+
+// L1HeadNum returns the L1 block number the game was created with, as recorded in the game's extra data.
+func (g *FaultGameHelper) L1HeadNum(ctx context.Context) uint64 {
+	_, l1Head, err := loadExtraData(ctx, g.game)
+	g.require.NoError(err, "failed to decode extra data")
+	g.require.NotZero(l1Head, "game extra data does not include the L1 head")
+	return l1Head
+}
+
+// L1Head returns the hash of the L1 head the game was created with. The game stores the hash loaded from the block
+// oracle at creation, which is checked to still match the block oracle's record of the L1 block in the extra data.
+func (g *FaultGameHelper) L1Head(ctx context.Context) common.Hash {
+	opts := &bind.CallOpts{Context: ctx}
+	l1Head, err := g.game.L1Head(opts)
+	g.require.NoError(err, "failed to load L1 head")
+	oracleAddr, err := g.game.BLOCKORACLE(opts)
+	g.require.NoError(err, "failed to load block oracle address")
+	oracle, err := bindings.NewBlockOracleCaller(oracleAddr, g.client)
+	g.require.NoError(err, "failed to bind block oracle")
+	l1HeadNum := g.L1HeadNum(ctx)
+	info, err := oracle.Load(opts, new(big.Int).SetUint64(l1HeadNum))
+	g.require.NoErrorf(err, "failed to load L1 block %v from block oracle", l1HeadNum)
+	g.require.EqualValuesf(info.Hash, l1Head, "game L1 head does not match block oracle for L1 block %v", l1HeadNum)
+	return l1Head
+}
+
+// ClaimCount returns the number of claims currently in the game.
+func (g *FaultGameHelper) ClaimCount(ctx context.Context) int64 {
+	count, err := g.game.ClaimDataLen(&bind.CallOpts{Context: ctx})
+	g.require.NoError(err, "retrieve number of claims")
+	return count.Int64()
+}
+
+// WaitForClaimCount waits until the game has exactly count claims.
+// The test fails immediately if the game is resolved before the claims are made.
 func (g *FaultGameHelper) WaitForClaimCount(ctx context.Context, count int64) {
-	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	ctx, cancel := g.withTimeout(ctx, time.Minute)
 	defer cancel()
-	err := utils.WaitFor(ctx, time.Second, func() (bool, error) {
+	err := g.waitForGameLogs(ctx, time.Second, func() (bool, error) {
 		actual, err := g.game.ClaimDataLen(&bind.CallOpts{Context: ctx})
 		if err != nil {
 			return false, err
 		}
 		g.t.Log("Waiting for claim count", "current", actual, "expected", count, "game", g.addr)
-		return actual.Cmp(big.NewInt(count)) == 0, nil
+		if actual.Cmp(big.NewInt(count)) == 0 {
+			return true, nil
+		}
+		return false, g.checkInProgress(ctx)
 	})
 	g.require.NoError(err)
 }
@@ -54,10 +115,26 @@ type ContractClaim struct {
 	Clock       *big.Int
 }
 
+// ClockDuration returns the accumulated duration recorded in the claim's clock.
+func (c ContractClaim) ClockDuration() time.Duration {
+	return time.Duration(new(big.Int).Rsh(c.Clock, 64).Uint64()) * time.Second
+}
+
+// ClockTimestamp returns the timestamp recorded in the claim's clock, which is the time the claim was made.
+func (c ContractClaim) ClockTimestamp() uint64 {
+	return c.Clock.Uint64()
+}
+
+func (g *FaultGameHelper) getClaim(ctx context.Context, claimIdx int64) ContractClaim {
+	claimData, err := g.game.ClaimData(&bind.CallOpts{Context: ctx}, big.NewInt(claimIdx))
+	g.require.NoErrorf(err, "retrieve claim %v", claimIdx)
+	return claimData
+}
+
 func (g *FaultGameHelper) WaitForClaim(ctx context.Context, predicate func(claim ContractClaim) bool) {
-	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	ctx, cancel := g.withTimeout(ctx, time.Minute)
 	defer cancel()
-	err := utils.WaitFor(ctx, time.Second, func() (bool, error) {
+	err := g.waitFor(ctx, time.Second, func() (bool, error) {
 		count, err := g.game.ClaimDataLen(&bind.CallOpts{Context: ctx})
 		if err != nil {
 			return false, fmt.Errorf("retrieve number of claims: %w", err)
@@ -77,6 +154,11 @@ func (g *FaultGameHelper) WaitForClaim(ctx context.Context, predicate func(claim
 	g.require.NoError(err)
 }
 
+// MaxDepth returns the max depth of the game's claim tree.
+func (g *FaultGameHelper) MaxDepth() int {
+	return g.maxDepth
+}
+
 func (g *FaultGameHelper) WaitForClaimAtMaxDepth(ctx context.Context, countered bool) {
 	g.WaitForClaim(ctx, func(claim ContractClaim) bool {
 		pos := types.NewPositionFromGIndex(claim.Position.Uint64())
@@ -84,28 +166,118 @@ func (g *FaultGameHelper) WaitForClaimAtMaxDepth(ctx context.Context, countered
 	})
 }
 
-func (g *FaultGameHelper) Resolve(ctx context.Context) {
-	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+// Attack posts an attack against the claim at claimIdx.
+// The test fails without sending the move if the same attack has already been made.
+func (g *FaultGameHelper) Attack(ctx context.Context, claimIdx int64, claim common.Hash) {
+	g.requireNewMove(ctx, claimIdx, claim, true)
+	g.require.NoError(g.move(ctx, claimIdx, claim, true), "attack claim %v", claimIdx)
+}
+
+// Defend posts a defense of the claim at claimIdx.
+// The test fails without sending the move if the same defense has already been made.
+func (g *FaultGameHelper) Defend(ctx context.Context, claimIdx int64, claim common.Hash) {
+	g.requireNewMove(ctx, claimIdx, claim, false)
+	g.require.NoError(g.move(ctx, claimIdx, claim, false), "defend claim %v", claimIdx)
+}
+
+// TryAttack attempts to attack the claim at claimIdx, returning an error including the revert reason if the move
+// is rejected. Unlike Attack, duplicate moves are sent so the contract's handling of them can be tested.
+func (g *FaultGameHelper) TryAttack(ctx context.Context, claimIdx int64, claim common.Hash) error {
+	err := g.move(ctx, claimIdx, claim, true)
+	if err == nil {
+		return nil
+	}
+	if reason, ok := decodeRevertReason(err); ok {
+		return fmt.Errorf("attack claim %v reverted: %v: %w", claimIdx, reason, err)
+	}
+	return fmt.Errorf("attack claim %v: %w", claimIdx, err)
+}
+
+// TryStep attempts to step against the claim at claimIdx, returning an error including the revert reason if the step
+// is rejected.
+func (g *FaultGameHelper) TryStep(ctx context.Context, claimIdx int64, isAttack bool, stateData []byte, proof []byte) error {
+	ctx, cancel := g.withTimeout(ctx, time.Minute)
+	defer cancel()
+	err := g.SendStep(ctx, claimIdx, isAttack, stateData, proof)
+	if err == nil {
+		return nil
+	}
+	if reason, ok := decodeRevertReason(err); ok {
+		return fmt.Errorf("step against claim %v reverted: %v: %w", claimIdx, reason, err)
+	}
+	return fmt.Errorf("step against claim %v: %w", claimIdx, err)
+}
+
+// RequireDuplicateMoveRejected attacks the claim at parentIdx with value, then requires that making the identical
+// attack again is rejected with ClaimAlreadyExists and does not add a claim.
+func (g *FaultGameHelper) RequireDuplicateMoveRejected(ctx context.Context, parentIdx int64, value common.Hash) {
+	g.Attack(ctx, parentIdx, value)
+	count := g.ClaimCount(ctx)
+	err := g.TryAttack(ctx, parentIdx, value)
+	g.require.ErrorContains(err, "ClaimAlreadyExists", "duplicate attack on claim %v should be rejected", parentIdx)
+	g.require.Equal(count, g.ClaimCount(ctx), "duplicate attack should not add a claim")
+}
+
+// RequireMovesRejectedAfterResolution requires that the game has been resolved and that attacking the root claim is
+// then rejected with GameNotInProgress and does not add a claim.
+func (g *FaultGameHelper) RequireMovesRejectedAfterResolution(ctx context.Context) {
+	status, err := g.LoadStatus(ctx)
+	g.require.NoError(err, "load game status")
+	g.require.NotEqualf(StatusInProgress, status, "game %v must be resolved before moves are rejected", g.addr)
+	count := g.ClaimCount(ctx)
+	err = g.TryAttack(ctx, 0, common.Hash{0xde, 0xad})
+	g.require.ErrorContainsf(err, "GameNotInProgress", "move in game %v with status %v should be rejected", g.addr, status)
+	g.require.Equal(count, g.ClaimCount(ctx), "rejected move should not add a claim")
+}
+
+func (g *FaultGameHelper) move(ctx context.Context, claimIdx int64, claim common.Hash, isAttack bool) error {
+	ctx, cancel := g.withTimeout(ctx, time.Minute)
+	defer cancel()
+	bond, err := g.moveBond(ctx, claimIdx, isAttack)
+	if err != nil {
+		return err
+	}
+	return g.sendMove(ctx, claimIdx, claim, isAttack, bond)
+}
+
+// RequireDefendedAt waits for a claim to be made against the claim at parentIdx and requires that it is a defense,
+// not an attack.
+func (g *FaultGameHelper) RequireDefendedAt(ctx context.Context, parentIdx int64) {
+	parent := g.getClaim(ctx, parentIdx)
+	parentPos := types.NewPositionFromGIndex(parent.Position.Uint64())
+	g.require.False(parentPos.IsRootPosition(), "root claim cannot be defended")
+	g.require.Falsef(g.waitForResponse(ctx, parentIdx), "claim %v should have been defended but was attacked", parentIdx)
+}
+
+// Resolve resolves the game, first resolving every claim if the game supports subgame resolution, and returns the
+// outcome of the resolution.
+func (g *FaultGameHelper) Resolve(ctx context.Context) *ResolveResult {
+	ctx, cancel := g.withTimeout(ctx, time.Minute)
 	defer cancel()
-	tx, err := g.game.Resolve(g.opts)
+	var receipts []*ethtypes.Receipt
+	if g.SupportsSubgameResolution(ctx) {
+		receipts = g.resolveAllClaims(ctx)
+	}
+	rcpt, err := g.sendResolve(ctx)
 	g.require.NoError(err)
-	_, err = utils.WaitReceiptOK(ctx, g.client, tx.Hash())
+	result, err := g.loadResolveResult(ctx, append(receipts, rcpt))
 	g.require.NoError(err)
+	return result
 }
 
 func (g *FaultGameHelper) WaitForGameStatus(ctx context.Context, expected Status) {
 	g.t.Logf("Waiting for game %v to have status %v", g.addr, expected)
-	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	ctx, cancel := g.withTimeout(ctx, time.Minute)
 	defer cancel()
-	err := utils.WaitFor(ctx, time.Second, func() (bool, error) {
-		ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	err := g.waitForGameLogs(ctx, time.Second, func() (bool, error) {
+		ctx, cancel := g.withTimeout(ctx, 30*time.Second)
 		defer cancel()
-		status, err := g.game.Status(&bind.CallOpts{Context: ctx})
+		status, err := g.LoadStatus(ctx)
 		if err != nil {
-			return false, fmt.Errorf("game status unavailable: %w", err)
+			return false, err
 		}
-		g.t.Logf("Game %v has state %v, waiting for state %v", g.addr, Status(status), expected)
-		return expected == Status(status), nil
+		g.t.Logf("Game %v has state %v, waiting for state %v", g.addr, status, expected)
+		return expected == status, nil
 	})
 	g.require.NoError(err, "wait for game status")
 }
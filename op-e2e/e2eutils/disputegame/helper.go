@@ -2,20 +2,21 @@ package disputegame
 
 import (
 	"context"
-	"encoding/binary"
+	"crypto/ecdsa"
 	"fmt"
 	"math/big"
+	"net/http"
 	"testing"
 	"time"
 
-	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
 	"github.com/ethereum-optimism/optimism/op-chain-ops/deployer"
 	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
-	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
-	"github.com/ethereum-optimism/optimism/op-service/client/utils"
+	"github.com/ethereum-optimism/optimism/op-node/client"
+	"github.com/ethereum-optimism/optimism/op-node/sources"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/require"
 )
 
@@ -25,6 +26,9 @@ const alphabetGameDepth = 4
 const cannonGameDepth = 64
 const lastAlphabetTraceIndex = 1<<alphabetGameDepth - 1
 
+// disputedL2BlockNumber is the L2 block number games created by the helpers are for.
+const disputedL2BlockNumber uint64 = 8
+
 type Status uint8
 
 const (
@@ -49,137 +53,322 @@ func (s Status) String() string {
 var CorrectAlphabet = "abcdefghijklmnop"
 
 type FactoryHelper struct {
-	t           *testing.T
-	require     *require.Assertions
-	client      *ethclient.Client
-	opts        *bind.TransactOpts
-	factory     *bindings.DisputeGameFactory
-	blockOracle *bindings.BlockOracle
-	l2oo        *bindings.L2OutputOracleCaller
+	t       *testing.T
+	require *require.Assertions
+	*FactoryCore
+	checkFinalization    bool
+	timeAdvancer         TimeAdvancer
+	challengerInvariants bool
+
+	rpcCountingEndpoint string
+	rpcCounter          *rpcCounter
+
+	ownerKey  *ecdsa.PrivateKey
+	ownerOpts *bind.TransactOpts
+
+	// alphabetType is the game type alphabet games are created with.
+	alphabetType uint8
+	// skipProposalWait is true if games are created without first waiting for output proposals.
+	skipProposalWait bool
+
+	l2ooChallengerKey  *ecdsa.PrivateKey
+	l2ooChallengerOpts *bind.TransactOpts
+	proxyAdminKey      *ecdsa.PrivateKey
+	proxyAdminOpts     *bind.TransactOpts
+
+	// gameDuration is the duration of games created by the helper, or zero to use the registered implementation.
+	gameDuration time.Duration
+	// timeoutScale multiplies the helper's timeouts and the duration of games it creates.
+	timeoutScale timeoutScale
+	// baseGameDurations are the durations registered for each game type before they were scaled by timeoutScale.
+	baseGameDurations map[uint8]time.Duration
+
+	summary *summaryCollector
+
+	callTracing  bool
+	callTraceDir string
+	callTracer   *CallTracer
 }
 
-func NewFactoryHelper(t *testing.T, ctx context.Context, deployments *genesis.L1Deployments, client *ethclient.Client) *FactoryHelper {
+func NewFactoryHelper(t *testing.T, ctx context.Context, deployments *genesis.L1Deployments, client *ethclient.Client, options ...FactoryOption) *FactoryHelper {
 	require := require.New(t)
+	h := &FactoryHelper{
+		t:            t,
+		require:      require,
+		summary:      summaryFor(t),
+		alphabetType: alphabetGameType,
+	}
+	for _, option := range options {
+		option(h)
+	}
+	if h.rpcCountingEndpoint != "" {
+		h.rpcCounter = newRPCCounter(http.DefaultTransport)
+		countingClient, err := dialCountingClient(ctx, h.rpcCountingEndpoint, h.rpcCounter)
+		require.NoError(err)
+		t.Cleanup(countingClient.Close)
+		client = countingClient
+	}
+
 	chainID, err := client.ChainID(ctx)
 	require.NoError(err)
 	opts, err := bind.NewKeyedTransactorWithChainID(deployer.TestKey, chainID)
 	require.NoError(err)
+	if h.ownerKey != nil {
+		h.ownerOpts, err = bind.NewKeyedTransactorWithChainID(h.ownerKey, chainID)
+		require.NoError(err)
+	}
+	if h.l2ooChallengerKey != nil {
+		h.l2ooChallengerOpts, err = bind.NewKeyedTransactorWithChainID(h.l2ooChallengerKey, chainID)
+		require.NoError(err)
+	}
+	if h.proxyAdminKey != nil {
+		h.proxyAdminOpts, err = bind.NewKeyedTransactorWithChainID(h.proxyAdminKey, chainID)
+		require.NoError(err)
+	}
 
 	require.NotNil(deployments, "No deployments")
-	factory, err := bindings.NewDisputeGameFactory(deployments.DisputeGameFactoryProxy, client)
+	core, err := NewFactoryCore(ctx, client, opts, deployments)
 	require.NoError(err)
-	blockOracle, err := bindings.NewBlockOracle(deployments.BlockOracle, client)
-	require.NoError(err)
-	l2oo, err := bindings.NewL2OutputOracleCaller(deployments.L2OutputOracleProxy, client)
-	require.NoError(err, "Error creating l2oo caller")
+	core.SetAlphabetGameType(h.alphabetType)
+	if h.skipProposalWait {
+		core.SkipProposalWait()
+	}
+	h.FactoryCore = core
+	h.summary.trackOutputs(core)
+	if h.callTracing {
+		h.enableCallTracing(ctx, deployments)
+	}
+	return h
+}
+
+// StartOption configures how a Start helper creates a game.
+type StartOption func(cfg *startCfg)
 
-	//factory, l1Head := deployDisputeGameContracts(require, ctx, clock, client, opts, gameDuration)
-	return &FactoryHelper{
-		t:           t,
-		require:     require,
-		client:      client,
-		opts:        opts,
-		factory:     factory,
-		blockOracle: blockOracle,
-		l2oo:        l2oo,
+type startCfg struct {
+	// encoder encodes the game's extra data. Nil uses the helper's own encoding.
+	encoder ExtraDataEncoder
+}
+
+// WithExtraDataEncoder creates the game with extra data encoded by encoder instead of the helper's own encoding.
+func WithExtraDataEncoder(encoder ExtraDataEncoder) StartOption {
+	return func(cfg *startCfg) {
+		cfg.encoder = encoder
 	}
 }
 
-func (h *FactoryHelper) StartAlphabetGame(ctx context.Context, claimedAlphabet string) *AlphabetGameHelper {
-	h.waitForProposals(ctx)
-	l1Head := h.checkpointL1Block(ctx)
+func newStartCfg(options []StartOption) *startCfg {
+	cfg := &startCfg{}
+	for _, option := range options {
+		option(cfg)
+	}
+	return cfg
+}
 
-	ctx, cancel := context.WithTimeout(ctx, 1*time.Minute)
+func (h *FactoryHelper) StartAlphabetGame(ctx context.Context, claimedAlphabet string, options ...StartOption) *AlphabetGameHelper {
+	cfg := newStartCfg(options)
+	ctx, cancel := h.withTimeout(ctx, 3*time.Minute)
 	defer cancel()
+	h.requireOutputNotFinalized(ctx)
+	h.applyGameDuration(ctx, h.alphabetType)
 
-	trace := alphabet.NewTraceProvider(claimedAlphabet, alphabetGameDepth)
-	rootClaim, err := trace.Get(ctx, lastAlphabetTraceIndex)
-	h.require.NoError(err, "get root claim")
-	extraData := make([]byte, 64)
-	binary.BigEndian.PutUint64(extraData[24:], uint64(8))
-	binary.BigEndian.PutUint64(extraData[56:], l1Head.Uint64())
-	tx, err := h.factory.Create(h.opts, alphabetGameType, rootClaim, extraData)
-	h.require.NoError(err, "create fault dispute game")
-	rcpt, err := utils.WaitReceiptOK(ctx, h.client, tx.Hash())
-	h.require.NoError(err, "wait for create fault dispute game receipt to be OK")
-	h.require.Len(rcpt.Logs, 1, "should have emitted a single DisputeGameCreated event")
-	createdEvent, err := h.factory.ParseDisputeGameCreated(*rcpt.Logs[0])
-	h.require.NoError(err)
-	game, err := bindings.NewFaultDisputeGame(createdEvent.DisputeProxy, h.client)
-	h.require.NoError(err)
+	rootClaim, err := alphabetRootClaim(ctx, claimedAlphabet)
+	h.require.NoError(err, "calculate alphabet root claim")
+	addr, err := h.createAlphabetGame(ctx, rootClaim, cfg.encoder)
+	h.require.NoError(err, "create alphabet game")
 	return &AlphabetGameHelper{
-		FaultGameHelper: FaultGameHelper{
-			t:        h.t,
-			require:  h.require,
-			client:   h.client,
-			opts:     h.opts,
-			game:     game,
-			maxDepth: alphabetGameDepth,
-			addr:     createdEvent.DisputeProxy,
-		},
+		FaultGameHelper: h.newGameHelper(ctx, addr, alphabetGameDepth),
 		claimedAlphabet: claimedAlphabet,
 	}
 }
 
-func (h *FactoryHelper) StartCannonGame(ctx context.Context, rootClaim common.Hash) *CannonGameHelper {
+// StartHonestAlphabetGame creates an alphabet game with a valid root claim, derived from CorrectAlphabet.
+func (h *FactoryHelper) StartHonestAlphabetGame(ctx context.Context, options ...StartOption) *AlphabetGameHelper {
+	return h.StartAlphabetGame(ctx, CorrectAlphabet, options...)
+}
+
+// StartZeroRootAlphabetGame creates an alphabet game with an all-zeros root claim. No alphabet produces a zero root
+// so the game has no claimed alphabet and challengers must be configured with a trace, as by StartHonestChallenger.
+func (h *FactoryHelper) StartZeroRootAlphabetGame(ctx context.Context, options ...StartOption) *AlphabetGameHelper {
+	cfg := newStartCfg(options)
+	ctx, cancel := h.withTimeout(ctx, 3*time.Minute)
+	defer cancel()
+	h.requireOutputNotFinalized(ctx)
+	h.applyGameDuration(ctx, h.alphabetType)
+
+	addr, err := h.createAlphabetGame(ctx, common.Hash{}, cfg.encoder)
+	h.require.NoError(err, "create zero root alphabet game")
+	game := &AlphabetGameHelper{
+		FaultGameHelper: h.newGameHelper(ctx, addr, alphabetGameDepth),
+	}
+	rootClaim, err := game.LoadRootClaim(ctx)
+	h.require.NoError(err, "load root claim")
+	h.require.Equal(common.Hash{}, rootClaim, "game should have a zero root claim")
+	return game
+}
+
+func (h *FactoryHelper) StartCannonGame(ctx context.Context, rootClaim common.Hash, options ...StartOption) *CannonGameHelper {
+	cfg := newStartCfg(options)
 	h.waitForProposals(ctx)
+	h.requireOutputNotFinalized(ctx)
+	h.applyGameDuration(ctx, cannonGameType)
 	l1Head := h.checkpointL1Block(ctx)
 
-	ctx, cancel := context.WithTimeout(ctx, 1*time.Minute)
+	ctx, cancel := h.withTimeout(ctx, 1*time.Minute)
 	defer cancel()
 
-	extraData := make([]byte, 64)
-	binary.BigEndian.PutUint64(extraData[24:], uint64(8))
-	binary.BigEndian.PutUint64(extraData[56:], l1Head.Uint64())
-	tx, err := h.factory.Create(h.opts, cannonGameType, rootClaim, extraData)
-	h.require.NoError(err, "create fault dispute game")
-	rcpt, err := utils.WaitReceiptOK(ctx, h.client, tx.Hash())
-	h.require.NoError(err, "wait for create fault dispute game receipt to be OK")
-	h.require.Len(rcpt.Logs, 1, "should have emitted a single DisputeGameCreated event")
-	createdEvent, err := h.factory.ParseDisputeGameCreated(*rcpt.Logs[0])
-	h.require.NoError(err)
-	game, err := bindings.NewFaultDisputeGame(createdEvent.DisputeProxy, h.client)
+	addr, err := h.CreateGame(ctx, cannonGameType, rootClaim, h.encodeExtraData(l1Head.Uint64(), cfg.encoder))
 	h.require.NoError(err)
 	return &CannonGameHelper{
-		FaultGameHelper: FaultGameHelper{
-			t:        h.t,
-			require:  h.require,
-			client:   h.client,
-			opts:     h.opts,
-			game:     game,
-			maxDepth: cannonGameDepth,
-			addr:     createdEvent.DisputeProxy,
-		},
+		FaultGameHelper: h.newGameHelper(ctx, addr, cannonGameDepth),
 	}
 }
 
-// waitForProposals waits until there are at least two proposals in the output oracle
-// This is the minimum required for creating a game.
-func (h *FactoryHelper) waitForProposals(ctx context.Context) {
+// StartCannonGameForOutput creates a cannon game disputing the output at the helper's disputed L2 block.
+// Game creation waits until the rollup node at rollupEndpoint has a safe head beyond the disputed block so that the
+// L2 chain data required to run cannon is derivable from L1.
+func (h *FactoryHelper) StartCannonGameForOutput(ctx context.Context, rollupEndpoint string, rootClaim common.Hash) *CannonGameHelper {
+	h.WaitForSafeHeadBeyondDisputedBlock(ctx, rollupEndpoint, disputedL2BlockNumber)
+	return h.StartCannonGame(ctx, rootClaim)
+}
+
+// DisputedL2BlockNumber returns the L2 block number disputed by games created by the helper.
+func (h *FactoryHelper) DisputedL2BlockNumber() uint64 {
+	return disputedL2BlockNumber
+}
 
-	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+// WaitForSafeHeadBeyondDisputedBlock waits until the safe head of the rollup node at rollupEndpoint is beyond
+// l2BlockNum, ensuring the L2 data for the disputed block range can be derived from L1.
+func (h *FactoryHelper) WaitForSafeHeadBeyondDisputedBlock(ctx context.Context, rollupEndpoint string, l2BlockNum uint64) {
+	ctx, cancel := h.withTimeout(ctx, 2*time.Minute)
 	defer cancel()
-	err := utils.WaitFor(ctx, time.Second, func() (bool, error) {
-		index, err := h.l2oo.LatestOutputIndex(&bind.CallOpts{Context: ctx})
+	rpcClient, err := rpc.DialContext(ctx, rollupEndpoint)
+	h.require.NoError(err, "dial rollup node")
+	defer rpcClient.Close()
+	rollupClient := sources.NewRollupClient(client.NewBaseRPCClient(rpcClient))
+
+	var safeHead uint64
+	err = waitFor(ctx, h.clock, time.Second, func() (bool, error) {
+		status, err := rollupClient.SyncStatus(ctx)
 		if err != nil {
-			h.t.Logf("Could not get latest output index: %v", err.Error())
-			return false, nil
+			return false, fmt.Errorf("load sync status: %w", err)
 		}
-		h.t.Logf("Latest output index: %v", index)
-		return index.Cmp(big.NewInt(1)) >= 0, nil
+		safeHead = status.SafeL2.Number
+		return safeHead > l2BlockNum, nil
+	})
+	h.require.NoErrorf(err, "safe head %v did not pass disputed block %v", safeHead, l2BlockNum)
+}
+
+func (h *FactoryHelper) newGameHelper(ctx context.Context, addr common.Address, maxDepth int) FaultGameHelper {
+	return h.newGameHelperWithOpts(ctx, addr, maxDepth, h.opts)
+}
+
+// newGameHelperWithOpts creates a helper for the game at addr that sends transactions with opts.
+func (h *FactoryHelper) newGameHelperWithOpts(ctx context.Context, addr common.Address, maxDepth int, opts *bind.TransactOpts) FaultGameHelper {
+	core, err := h.gameWithOpts(addr, opts)
+	h.require.NoError(err)
+	creation, err := h.GameCreation(ctx, addr)
+	h.require.NoError(err)
+	h.summary.trackGame(core, creation)
+	return FaultGameHelper{
+		t:        h.t,
+		require:  h.require,
+		GameCore: core,
+		maxDepth: maxDepth,
+		creation: creation,
+
+		challengerInvariants: h.challengerInvariants,
+		timeAdvancer:         h.timeAdvancer,
+		timeoutScale:         h.timeoutScale,
+	}
+}
+
+// WrapGame creates a helper for an existing game created by the factory, such as one found via GameAddresses.
+func (h *FactoryHelper) WrapGame(ctx context.Context, addr common.Address) *FaultGameHelper {
+	game := h.newGameHelper(ctx, addr, 0)
+	maxDepth, err := game.game.MAXGAMEDEPTH(&bind.CallOpts{Context: ctx})
+	h.require.NoErrorf(err, "load max depth of game %v", addr)
+	game.maxDepth = int(maxDepth.Uint64())
+	return &game
+}
+
+// TryStartGameWithoutCheckpoint attempts to create a game referencing l1Block without first storing that block in
+// the block oracle. Game creation is expected to fail, so the error from creating the game is returned, including the
+// revert reason if the creation was rejected.
+func (h *FactoryHelper) TryStartGameWithoutCheckpoint(ctx context.Context, gameType uint8, rootClaim common.Hash, l1Block uint64) error {
+	h.waitForProposals(ctx)
+
+	ctx, cancel := h.withTimeout(ctx, 1*time.Minute)
+	defer cancel()
+
+	_, err := h.TryCreateGame(ctx, gameType, rootClaim, makeExtraData(l1Block))
+	if err == nil {
+		return nil
+	}
+	if reason, ok := decodeRevertReason(err); ok {
+		return fmt.Errorf("create game reverted: %v: %w", reason, err)
+	}
+	return err
+}
+
+// makeExtraData creates the extra data for a game disputing disputedL2BlockNumber using the specified L1 block as
+// the L1 head.
+func makeExtraData(l1Head uint64) []byte {
+	return GameExtraData{L2BlockNumber: disputedL2BlockNumber, L1Head: l1Head}.Encode()
+}
+
+// waitForProposals waits until the output oracle has the proposals required to create a game, unless the helper was
+// created with WithoutProposalWait.
+func (h *FactoryHelper) waitForProposals(ctx context.Context) {
+	ctx, cancel := h.withTimeout(ctx, 2*time.Minute)
+	defer cancel()
+	h.require.NoError(h.waitForProposalsIfRequired(ctx), "Did not get required output proposals")
+}
+
+// WaitForBlockOracleCheckpoint waits until the hash of the L1 block blockNum is stored in the block oracle.
+func (h *FactoryHelper) WaitForBlockOracleCheckpoint(ctx context.Context, blockNum uint64) {
+	ctx, cancel := h.withTimeout(ctx, 1*time.Minute)
+	defer cancel()
+	err := waitFor(ctx, h.clock, time.Second, func() (bool, error) {
+		return h.IsBlockCheckpointed(ctx, blockNum)
 	})
-	h.require.NoError(err, "Did not get two output roots")
+	h.require.NoErrorf(err, "L1 block %v was not checkpointed in the block oracle", blockNum)
 }
 
 // checkpointL1Block stores the current L1 block in the oracle
 // Returns the L1 block number that was stored as the checkpoint
 func (h *FactoryHelper) checkpointL1Block(ctx context.Context) *big.Int {
-	ctx, cancel := context.WithTimeout(ctx, 1*time.Minute)
+	ctx, cancel := h.withTimeout(ctx, 1*time.Minute)
 	defer cancel()
-	// Store the current block in the oracle
-	tx, err := h.blockOracle.Checkpoint(h.opts)
+	l1Head, err := h.CheckpointL1Block(ctx)
+	h.require.NoError(err)
+	return l1Head
+}
+
+// CreateGameCalldata returns the calldata to create a game via the factory at FactoryAddress without sending it,
+// so tests can submit game creation indirectly, such as via a multicall or forwarder contract.
+func (h *FactoryHelper) CreateGameCalldata(gameType uint8, rootClaim common.Hash, extraData []byte) []byte {
+	data, err := createGameCalldata(gameType, rootClaim, extraData)
 	h.require.NoError(err)
-	r, err := utils.WaitReceiptOK(ctx, h.client, tx.Hash())
-	h.require.NoError(err, "failed to store block in block oracle")
-	return new(big.Int).Sub(r.BlockNumber, big.NewInt(1))
+	return data
+}
+
+// CheckpointedExtraData waits for proposals and checkpoints the current L1 block, returning the extra data for a game
+// disputing the helper's disputed L2 block. Use with CreateGameCalldata to create games indirectly.
+func (h *FactoryHelper) CheckpointedExtraData(ctx context.Context) []byte {
+	h.waitForProposals(ctx)
+	l1Head := h.checkpointL1Block(ctx)
+	return makeExtraData(l1Head.Uint64())
+}
+
+// MeasureCreateGas creates a game of gameType with rootClaim via the factory and returns the gas used by the creation
+// transaction. Each game references a newly checkpointed L1 block so the same root claim can be measured repeatedly.
+func (h *FactoryHelper) MeasureCreateGas(ctx context.Context, gameType uint8, rootClaim common.Hash) uint64 {
+	extraData := h.CheckpointedExtraData(ctx)
+
+	ctx, cancel := h.withTimeout(ctx, 1*time.Minute)
+	defer cancel()
+	addr, rcpt, err := h.createGameWithReceipt(ctx, h.opts, gameType, rootClaim, extraData)
+	h.require.NoErrorf(err, "create game of type %v", gameType)
+	h.t.Logf("Creating game %v of type %v used %v gas", addr, gameType, rcpt.GasUsed)
+	return rcpt.GasUsed
 }
@@ -0,0 +1,74 @@
+package disputegame
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/solver"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+)
+
+// honestRootAttackAlphabet is the trace used to attack an honest root claim. It matches the correct alphabet for the
+// first few indices so the dispute narrows to a step from an agreed prestate rather than an invalid one.
+const honestRootAttackAlphabet = "abcdexyz"
+
+// RequireHonestRootSurvives plays a full attack chain against the game's honest root claim and requires that the
+// attacker loses. The attacker counters each claim supporting the root using an incorrect trace and the defender
+// counters each attack using the correct trace, until the defender makes a claim at max depth. The attacker's step
+// against that claim must be rejected. L1 time is then advanced past the end of the game using advancer and the game
+// must resolve with the defender winning.
+func (g *AlphabetGameHelper) RequireHonestRootSurvives(ctx context.Context, advancer TimeAdvancer) {
+	g.require.Equal(CorrectAlphabet, g.claimedAlphabet, "root claim must be honest")
+	g.require.Zero(g.maxDepth%2, "defender must make the claims at max depth")
+	attacker := solver.NewSolver(g.maxDepth, g.TraceProvider(honestRootAttackAlphabet))
+	defender := solver.NewSolver(g.maxDepth, g.TraceProvider(CorrectAlphabet))
+
+	claimIdx := int64(0)
+	claim := g.solverClaim(ctx, claimIdx)
+	for claim.Depth() < g.maxDepth {
+		actor := defender
+		if claim.Depth()%2 == 0 {
+			actor = attacker
+		}
+		move, err := actor.NextMove(ctx, claim, false)
+		g.require.NoErrorf(err, "calculate move against claim %v", claimIdx)
+		g.require.NotNilf(move, "no move against claim %v", claimIdx)
+		g.require.NoError(g.move(ctx, claimIdx, move.Value, !move.DefendsParent()), "counter claim %v", claimIdx)
+		claimIdx = g.ClaimCount(ctx) - 1
+		claim = g.solverClaim(ctx, claimIdx)
+	}
+
+	step, err := attacker.AttemptStep(ctx, claim, false)
+	g.require.NoErrorf(err, "calculate step against claim %v", claimIdx)
+	err = g.TryStep(ctx, claimIdx, step.IsAttack, step.PreState, step.ProofData)
+	g.require.Errorf(err, "attacker's step against honest claim %v should be rejected", claimIdx)
+	g.t.Logf("Attacker's step against claim %v rejected: %v", claimIdx, err)
+
+	duration := g.GameDuration(ctx)
+	end := g.creation.Timestamp + uint64(duration/time.Second)
+	advancer.AdvanceTime(duration)
+	waitCtx, cancel := g.withTimeout(ctx, time.Minute)
+	defer cancel()
+	err = g.waitFor(waitCtx, time.Second, func() (bool, error) {
+		header, err := g.client.HeaderByNumber(waitCtx, nil)
+		if err != nil {
+			return false, err
+		}
+		return header.Time > end, nil
+	})
+	g.require.NoError(err, "wait for game clocks to expire")
+	g.Resolve(ctx)
+	g.WaitForGameStatus(ctx, StatusDefenderWins)
+}
+
+// solverClaim loads the claim at claimIdx in the form used by the challenger's solver.
+func (g *FaultGameHelper) solverClaim(ctx context.Context, claimIdx int64) types.Claim {
+	claim := g.getClaim(ctx, claimIdx)
+	return types.Claim{
+		ClaimData: types.ClaimData{
+			Value:    claim.Claim,
+			Position: types.NewPositionFromGIndex(claim.Position.Uint64()),
+		},
+		ContractIndex: int(claimIdx),
+	}
+}
@@ -0,0 +1,102 @@
+package disputegame
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// StartGameFromJSON creates a game equivalent to the one written to path by ExportJSON and replays each recorded
+// move in order, so the new game has the same root claim and the same claims at the same indices.
+func (h *FactoryHelper) StartGameFromJSON(ctx context.Context, path string) *FaultGameHelper {
+	data, err := os.ReadFile(path)
+	h.require.NoErrorf(err, "failed to read game state from %v", path)
+	var export GameExport
+	h.require.NoErrorf(json.Unmarshal(data, &export), "failed to decode game state from %v", path)
+	return h.StartGameFromExport(ctx, export)
+}
+
+// StartGameFromExport creates a game with the same type, root claim and disputed L2 block as export and replays
+// each recorded move in order.
+// The recorded L1 head is replaced with a newly checkpointed block as the original checkpoint is unlikely to exist
+// in a fresh environment. Moves are all made by the helper's account, so claimants and clocks are not reproduced.
+func (h *FactoryHelper) StartGameFromExport(ctx context.Context, export GameExport) *FaultGameHelper {
+	ctx, cancel := h.withTimeout(ctx, 3*time.Minute)
+	defer cancel()
+	moves, err := replayMoves(export.Claims)
+	h.require.NoError(err, "invalid exported claims")
+
+	h.waitForProposals(ctx)
+	h.requireOutputNotFinalized(ctx)
+	l1Head := h.checkpointL1Block(ctx)
+	extraData, err := replayExtraData(export.ExtraData, l1Head.Uint64())
+	h.require.NoError(err)
+	addr, err := h.CreateGame(ctx, export.GameType, export.RootClaim, extraData)
+	h.require.NoError(err, "recreate game")
+
+	game := h.newGameHelper(ctx, addr, export.MaxDepth)
+	for _, move := range moves {
+		h.require.NoErrorf(game.move(ctx, move.parentIdx, move.value, move.isAttack), "replay claim %v", move.claimIdx)
+	}
+	return &game
+}
+
+type replayMove struct {
+	claimIdx  int64
+	parentIdx int64
+	value     common.Hash
+	isAttack  bool
+}
+
+// replayMoves converts exported claims to the moves that recreate them, in claim index order.
+// Each claim must respond to an earlier claim and be at either the attack or defense position of its parent.
+func replayMoves(claims []ClaimExport) ([]replayMove, error) {
+	if len(claims) == 0 {
+		return nil, fmt.Errorf("no root claim")
+	}
+	var moves []replayMove
+	for i, claim := range claims {
+		if claim.Index != i {
+			return nil, fmt.Errorf("claim %v recorded with index %v", i, claim.Index)
+		}
+		if i == 0 {
+			continue
+		}
+		parentIdx := int(claim.ParentIndex)
+		if parentIdx >= i {
+			return nil, fmt.Errorf("claim %v responds to later claim %v", i, parentIdx)
+		}
+		parentPos := types.NewPositionFromGIndex(claims[parentIdx].Position)
+		attackPos := parentPos.Attack()
+		isAttack := claim.Position == attackPos.ToGIndex()
+		if !isAttack {
+			// The root claim can only be attacked.
+			defendPos := parentPos.Defend()
+			if parentPos.IsRootPosition() || claim.Position != defendPos.ToGIndex() {
+				return nil, fmt.Errorf("claim %v at position %v is not a move against claim %v at position %v",
+					i, claim.Position, parentIdx, parentPos.ToGIndex())
+			}
+		}
+		moves = append(moves, replayMove{
+			claimIdx:  int64(i),
+			parentIdx: int64(parentIdx),
+			value:     claim.Value,
+			isAttack:  isAttack,
+		})
+	}
+	return moves, nil
+}
+
+// replayExtraData returns extraData with the L1 head replaced by l1Head.
+func replayExtraData(extraData []byte, l1Head uint64) ([]byte, error) {
+	l2BlockNum, _, err := decodeExtraData(extraData)
+	if err != nil {
+		return nil, err
+	}
+	return GameExtraData{L2BlockNumber: l2BlockNum, L1Head: l1Head}.Encode(), nil
+}
@@ -0,0 +1,74 @@
+package disputegame
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayMoves(t *testing.T) {
+	root := ClaimExport{Index: 0, ParentIndex: ^uint32(0), Position: 1, Value: common.Hash{0x01}}
+
+	t.Run("AttacksAndDefends", func(t *testing.T) {
+		moves, err := replayMoves([]ClaimExport{
+			root,
+			{Index: 1, ParentIndex: 0, Position: 2, Value: common.Hash{0x02}},
+			{Index: 2, ParentIndex: 1, Position: 4, Value: common.Hash{0x03}},
+			{Index: 3, ParentIndex: 2, Position: 10, Value: common.Hash{0x04}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []replayMove{
+			{claimIdx: 1, parentIdx: 0, value: common.Hash{0x02}, isAttack: true},
+			{claimIdx: 2, parentIdx: 1, value: common.Hash{0x03}, isAttack: true},
+			{claimIdx: 3, parentIdx: 2, value: common.Hash{0x04}, isAttack: false},
+		}, moves)
+	})
+
+	t.Run("RootOnly", func(t *testing.T) {
+		moves, err := replayMoves([]ClaimExport{root})
+		require.NoError(t, err)
+		require.Empty(t, moves)
+	})
+
+	t.Run("NoClaims", func(t *testing.T) {
+		_, err := replayMoves(nil)
+		require.Error(t, err)
+	})
+
+	t.Run("IndexMismatch", func(t *testing.T) {
+		_, err := replayMoves([]ClaimExport{root, {Index: 2, ParentIndex: 0, Position: 2}})
+		require.ErrorContains(t, err, "recorded with index")
+	})
+
+	t.Run("LaterParent", func(t *testing.T) {
+		_, err := replayMoves([]ClaimExport{root, {Index: 1, ParentIndex: 1, Position: 2}})
+		require.ErrorContains(t, err, "later claim")
+	})
+
+	t.Run("DefendRoot", func(t *testing.T) {
+		_, err := replayMoves([]ClaimExport{root, {Index: 1, ParentIndex: 0, Position: 3}})
+		require.ErrorContains(t, err, "is not a move against")
+	})
+
+	t.Run("UnrelatedPosition", func(t *testing.T) {
+		_, err := replayMoves([]ClaimExport{root, {Index: 1, ParentIndex: 0, Position: 2}, {Index: 2, ParentIndex: 1, Position: 7}})
+		require.ErrorContains(t, err, "is not a move against")
+	})
+}
+
+func TestReplayExtraData(t *testing.T) {
+	t.Run("ReplacesL1Head", func(t *testing.T) {
+		result, err := replayExtraData(makeExtraData(10), 20)
+		require.NoError(t, err)
+		l2BlockNum, l1Head, err := decodeExtraData(result)
+		require.NoError(t, err)
+		require.Equal(t, disputedL2BlockNumber, l2BlockNum)
+		require.Equal(t, uint64(20), l1Head)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := replayExtraData([]byte{1, 2, 3}, 20)
+		require.ErrorIs(t, err, ErrInvalidExtraData)
+	})
+}
@@ -0,0 +1,198 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// VerifyGameIntegrity checks the final state of game against the honest trace from honestProvider and returns an
+// error for each violation found:
+//   - every claim the honest actor disputes is countered by a claim matching the honest trace, or by a step at the
+//     maximum depth. The honest actor disputes the root claim if it is invalid and every claim at the same levels.
+//   - no claim matching the honest trace was attacked by a claim that also matches it.
+//   - every step at the maximum depth was made at the point the disputed trace first diverges from the honest trace.
+//   - the game resolved with the status the honest trace requires.
+//
+// The checks are deliberately implemented independently of the challenger's solver so they can catch bugs in it.
+func VerifyGameIntegrity(ctx context.Context, game *FaultGameHelper, honestProvider types.TraceProvider) []error {
+	claims, err := game.LoadClaims(ctx)
+	if err != nil {
+		return []error{fmt.Errorf("load claims for game %v: %w", game.addr, err)}
+	}
+	status, err := game.LoadStatus(ctx)
+	if err != nil {
+		return []error{fmt.Errorf("load status for game %v: %w", game.addr, err)}
+	}
+	violations, err := checkGameIntegrity(ctx, claims, status, game.maxDepth, honestProvider)
+	if err != nil {
+		return []error{fmt.Errorf("check integrity of game %v: %w", game.addr, err)}
+	}
+	return violations
+}
+
+// RequireGameIntegrity fails the test if VerifyGameIntegrity reports any violations.
+func (g *FaultGameHelper) RequireGameIntegrity(ctx context.Context, honestProvider types.TraceProvider) {
+	g.require.Emptyf(VerifyGameIntegrity(ctx, g, honestProvider), "game %v violates integrity checks", g.addr)
+}
+
+// integrityTree is a claim tree annotated with whether each claim matches the honest trace.
+type integrityTree struct {
+	claims   []ContractClaim
+	correct  []bool
+	children [][]int
+	maxDepth int
+}
+
+func (t *integrityTree) position(i int) types.Position {
+	return types.NewPositionFromGIndex(t.claims[i].Position.Uint64())
+}
+
+// honestDisputes reports whether the honest actor disputes claims at the level of claim i. Levels alternate between
+// supporting and disputing the root claim, and the honest actor supports the root claim only if it is correct.
+func (t *integrityTree) honestDisputes(i int) bool {
+	pos := t.position(i)
+	supportsRoot := pos.Depth()%2 == 0
+	return supportsRoot != t.correct[0]
+}
+
+// traceAncestor returns the index of the closest ancestor of claim i that commits to the trace at traceIdx.
+func (t *integrityTree) traceAncestor(i int, traceIdx uint64) (int, bool) {
+	for parent := t.claims[i].ParentIndex; parent != math.MaxUint32; parent = t.claims[parent].ParentIndex {
+		pos := t.position(int(parent))
+		if pos.TraceIndex(t.maxDepth) == traceIdx {
+			return int(parent), true
+		}
+	}
+	return 0, false
+}
+
+// newIntegrityTree builds the claim tree for claims, comparing each claim to honestProvider.
+func newIntegrityTree(ctx context.Context, claims []ContractClaim, maxDepth int, honestProvider types.TraceProvider) (*integrityTree, error) {
+	if len(claims) == 0 {
+		return nil, fmt.Errorf("game has no claims")
+	}
+	tree := &integrityTree{
+		claims:   claims,
+		correct:  make([]bool, len(claims)),
+		children: make([][]int, len(claims)),
+		maxDepth: maxDepth,
+	}
+	for i, claim := range claims {
+		pos := tree.position(i)
+		expected, err := honestProvider.Get(ctx, pos.TraceIndex(maxDepth))
+		if err != nil {
+			return nil, fmt.Errorf("get honest trace for claim %v: %w", i, err)
+		}
+		tree.correct[i] = common.Hash(claim.Claim) == expected
+		if i > 0 {
+			tree.children[claim.ParentIndex] = append(tree.children[claim.ParentIndex], i)
+		}
+	}
+	return tree, nil
+}
+
+func checkGameIntegrity(ctx context.Context, claims []ContractClaim, status Status, maxDepth int, honestProvider types.TraceProvider) ([]error, error) {
+	tree, err := newIntegrityTree(ctx, claims, maxDepth, honestProvider)
+	if err != nil {
+		return nil, err
+	}
+	var violations []error
+	violations = append(violations, uncounteredClaims(tree)...)
+	violations = append(violations, attackedHonestClaims(tree)...)
+	violations = append(violations, misplacedSteps(tree)...)
+	expected := StatusDefenderWins
+	if !tree.correct[0] {
+		expected = StatusChallengerWins
+	}
+	if status != expected {
+		violations = append(violations, fmt.Errorf("game status is %v but the honest trace requires %v", status, expected))
+	}
+	return violations, nil
+}
+
+// uncounteredClaims returns an error for each claim the honest actor disputes that has no honest counter.
+func uncounteredClaims(tree *integrityTree) []error {
+	var violations []error
+	for i, claim := range tree.claims {
+		if !tree.honestDisputes(i) {
+			continue
+		}
+		pos := tree.position(i)
+		if pos.Depth() == tree.maxDepth {
+			if !claim.Countered {
+				violations = append(violations, fmt.Errorf("claim %v at max depth was not stepped on", i))
+			}
+			continue
+		}
+		countered := false
+		for _, child := range tree.children[i] {
+			countered = countered || tree.correct[child]
+		}
+		if !countered {
+			violations = append(violations, fmt.Errorf("claim %v at depth %v with value %v has no honest counter",
+				i, pos.Depth(), common.Hash(claim.Claim)))
+		}
+	}
+	return violations
+}
+
+// attackedHonestClaims returns an error for each claim matching the honest trace that was attacked with a claim that
+// also matches it. The honest actor defends correct claims it disputes rather than attacking them.
+func attackedHonestClaims(tree *integrityTree) []error {
+	var violations []error
+	for i := range tree.claims {
+		if !tree.correct[i] {
+			continue
+		}
+		pos := tree.position(i)
+		attack := pos.Attack()
+		for _, child := range tree.children[i] {
+			if tree.correct[child] && tree.claims[child].Position.Uint64() == attack.ToGIndex() {
+				violations = append(violations, fmt.Errorf("honest claim %v was attacked by honest claim %v", i, child))
+			}
+		}
+	}
+	return violations
+}
+
+// misplacedSteps returns an error for each step at the maximum depth that was not made where the disputed trace
+// first diverges from the honest trace.
+// An incorrect leaf at trace index i is stepped on by attacking, using the state at i-1 as the pre-state, so that
+// state must be correct. A correct leaf is stepped on by defending, using it as the pre-state, so the claimed state at
+// i+1 must be incorrect.
+func misplacedSteps(tree *integrityTree) []error {
+	var violations []error
+	for i, claim := range tree.claims {
+		pos := tree.position(i)
+		if pos.Depth() != tree.maxDepth || !claim.Countered {
+			continue
+		}
+		traceIdx := pos.TraceIndex(tree.maxDepth)
+		if !tree.correct[i] {
+			if traceIdx == 0 {
+				// The pre-state is the absolute prestate.
+				continue
+			}
+			pre, ok := tree.traceAncestor(i, traceIdx-1)
+			if !ok {
+				violations = append(violations, fmt.Errorf("step on claim %v at trace index %v has no pre-state claim", i, traceIdx))
+			} else if !tree.correct[pre] {
+				violations = append(violations, fmt.Errorf("step on claim %v at trace index %v but the trace already diverged at claim %v",
+					i, traceIdx, pre))
+			}
+			continue
+		}
+		post, ok := tree.traceAncestor(i, traceIdx+1)
+		if !ok {
+			violations = append(violations, fmt.Errorf("step on claim %v at trace index %v has no post-state claim", i, traceIdx))
+		} else if tree.correct[post] {
+			violations = append(violations, fmt.Errorf("step on correct claim %v at trace index %v but claim %v at the next index is also correct",
+				i, traceIdx, post))
+		}
+	}
+	return violations
+}
@@ -0,0 +1,151 @@
+package disputegame
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/stretchr/testify/require"
+)
+
+type integrityClaim struct {
+	parent    uint32
+	gindex    uint64
+	correct   bool
+	countered bool
+}
+
+func integrityClaims(t *testing.T, provider types.TraceProvider, maxDepth int, specs ...integrityClaim) []ContractClaim {
+	claims := make([]ContractClaim, 0, len(specs))
+	for _, spec := range specs {
+		pos := types.NewPositionFromGIndex(spec.gindex)
+		value, err := provider.Get(context.Background(), pos.TraceIndex(maxDepth))
+		require.NoError(t, err)
+		if !spec.correct {
+			value[0] ^= 0xff
+		}
+		claims = append(claims, ContractClaim{
+			ParentIndex: spec.parent,
+			Countered:   spec.countered,
+			Claim:       value,
+			Position:    new(big.Int).SetUint64(spec.gindex),
+			Clock:       big.NewInt(0),
+		})
+	}
+	return claims
+}
+
+func TestCheckGameIntegrity(t *testing.T) {
+	ctx := context.Background()
+	const maxDepth = 2
+	provider := alphabet.NewTraceProvider("abcd", maxDepth)
+	root := uint32(math.MaxUint32)
+
+	// An invalid root is attacked, the dishonest actor defends against the attack and the honest actor steps on the
+	// leaf at trace index 2, where the trace first diverges.
+	validTree := []integrityClaim{
+		{parent: root, gindex: 1, correct: false},
+		{parent: 0, gindex: 2, correct: true},                   // Attacks the root, trace index 1
+		{parent: 1, gindex: 6, correct: false, countered: true}, // Defends claim 1 at max depth, trace index 2
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		claims := integrityClaims(t, provider, maxDepth, validTree...)
+		violations, err := checkGameIntegrity(ctx, claims, StatusChallengerWins, maxDepth, provider)
+		require.NoError(t, err)
+		require.Empty(t, violations)
+	})
+
+	t.Run("WrongStatus", func(t *testing.T) {
+		claims := integrityClaims(t, provider, maxDepth, validTree...)
+		violations, err := checkGameIntegrity(ctx, claims, StatusDefenderWins, maxDepth, provider)
+		require.NoError(t, err)
+		require.Len(t, violations, 1)
+		require.ErrorContains(t, violations[0], "honest trace requires Challenger Wins")
+	})
+
+	t.Run("Unresolved", func(t *testing.T) {
+		claims := integrityClaims(t, provider, maxDepth, validTree...)
+		violations, err := checkGameIntegrity(ctx, claims, StatusInProgress, maxDepth, provider)
+		require.NoError(t, err)
+		require.Len(t, violations, 1)
+	})
+
+	t.Run("RootNotCountered", func(t *testing.T) {
+		claims := integrityClaims(t, provider, maxDepth, validTree[0])
+		violations, err := checkGameIntegrity(ctx, claims, StatusChallengerWins, maxDepth, provider)
+		require.NoError(t, err)
+		require.Len(t, violations, 1)
+		require.ErrorContains(t, violations[0], "claim 0 at depth 0")
+	})
+
+	t.Run("RootCounteredDishonestly", func(t *testing.T) {
+		claims := integrityClaims(t, provider, maxDepth, validTree[0], integrityClaim{parent: 0, gindex: 2, correct: false})
+		violations, err := checkGameIntegrity(ctx, claims, StatusChallengerWins, maxDepth, provider)
+		require.NoError(t, err)
+		require.Len(t, violations, 1)
+		require.ErrorContains(t, violations[0], "claim 0 at depth 0")
+	})
+
+	t.Run("LeafNotStepped", func(t *testing.T) {
+		tree := append([]integrityClaim{}, validTree...)
+		tree[2].countered = false
+		claims := integrityClaims(t, provider, maxDepth, tree...)
+		violations, err := checkGameIntegrity(ctx, claims, StatusChallengerWins, maxDepth, provider)
+		require.NoError(t, err)
+		require.Len(t, violations, 1)
+		require.ErrorContains(t, violations[0], "claim 2 at max depth was not stepped on")
+	})
+
+	t.Run("HonestClaimAttacked", func(t *testing.T) {
+		claims := integrityClaims(t, provider, maxDepth,
+			integrityClaim{parent: root, gindex: 1, correct: true},
+			integrityClaim{parent: 0, gindex: 2, correct: true},
+		)
+		violations, err := checkGameIntegrity(ctx, claims, StatusDefenderWins, maxDepth, provider)
+		require.NoError(t, err)
+		require.Len(t, violations, 2)
+		require.ErrorContains(t, violations[0], "claim 1 at depth 1", "correct claims at disputed levels must be defended")
+		require.ErrorContains(t, violations[1], "honest claim 0 was attacked by honest claim 1")
+	})
+
+	t.Run("StepAfterDivergence", func(t *testing.T) {
+		// The claim at trace index 1 is incorrect, so the trace diverged before the stepped leaf at index 2.
+		claims := integrityClaims(t, provider, maxDepth,
+			integrityClaim{parent: root, gindex: 1, correct: true},
+			integrityClaim{parent: 0, gindex: 2, correct: false},
+			integrityClaim{parent: 1, gindex: 6, correct: false, countered: true},
+		)
+		violations, err := checkGameIntegrity(ctx, claims, StatusDefenderWins, maxDepth, provider)
+		require.NoError(t, err)
+		require.Contains(t, errorStrings(violations), "step on claim 2 at trace index 2 but the trace already diverged at claim 1")
+	})
+
+	t.Run("DefendStepWithoutDivergence", func(t *testing.T) {
+		// The correct leaf at trace index 0 was stepped on but the claim at index 1 is also correct.
+		claims := integrityClaims(t, provider, maxDepth,
+			integrityClaim{parent: root, gindex: 1, correct: false},
+			integrityClaim{parent: 0, gindex: 2, correct: true},
+			integrityClaim{parent: 1, gindex: 4, correct: true, countered: true},
+		)
+		violations, err := checkGameIntegrity(ctx, claims, StatusChallengerWins, maxDepth, provider)
+		require.NoError(t, err)
+		require.Contains(t, errorStrings(violations), "step on correct claim 2 at trace index 0 but claim 1 at the next index is also correct")
+	})
+
+	t.Run("NoClaims", func(t *testing.T) {
+		_, err := checkGameIntegrity(ctx, nil, StatusChallengerWins, maxDepth, provider)
+		require.ErrorContains(t, err, "no claims")
+	})
+}
+
+func errorStrings(errs []error) []string {
+	strs := make([]string, 0, len(errs))
+	for _, err := range errs {
+		strs = append(strs, err.Error())
+	}
+	return strs
+}
@@ -0,0 +1,95 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
+	"github.com/ethereum-optimism/optimism/op-node/client"
+	"github.com/ethereum-optimism/optimism/op-node/sources"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+type intentCfg struct {
+	failOnMismatch bool
+}
+
+type IntentOption func(c *intentCfg)
+
+// FailOnIntentMismatch fails the test when the honesty of the root claim doesn't match the stated intent, rather
+// than only logging a warning.
+func FailOnIntentMismatch() IntentOption {
+	return func(c *intentCfg) {
+		c.failOnMismatch = true
+	}
+}
+
+// StartAlphabetGameWithIntent creates an alphabet game with a root claim from claimedAlphabet, checking that the
+// root claim is valid if honest is true or invalid if honest is false.
+func (h *FactoryHelper) StartAlphabetGameWithIntent(ctx context.Context, claimedAlphabet string, honest bool, options ...IntentOption) *AlphabetGameHelper {
+	claimed, err := alphabetRootClaim(ctx, claimedAlphabet)
+	h.require.NoError(err)
+	correct, err := alphabetRootClaim(ctx, CorrectAlphabet)
+	h.require.NoError(err)
+	h.checkIntent(honest, correct, claimed, options...)
+	return h.StartAlphabetGame(ctx, claimedAlphabet)
+}
+
+// StartCannonGameForOutputWithIntent creates a cannon game for the output at the helper's disputed L2 block,
+// checking that rootClaim matches the output root reported by the rollup node at rollupEndpoint if honest is true,
+// or differs from it if honest is false.
+func (h *FactoryHelper) StartCannonGameForOutputWithIntent(ctx context.Context, rollupEndpoint string, rootClaim common.Hash, honest bool, options ...IntentOption) *CannonGameHelper {
+	correct := h.correctOutputRoot(ctx, rollupEndpoint)
+	h.checkIntent(honest, correct, rootClaim, options...)
+	return h.StartCannonGameForOutput(ctx, rollupEndpoint, rootClaim)
+}
+
+func (h *FactoryHelper) checkIntent(honest bool, correct common.Hash, claimed common.Hash, options ...IntentOption) {
+	cfg := &intentCfg{}
+	for _, option := range options {
+		option(cfg)
+	}
+	if err := checkRootIntent(honest, correct, claimed); err != nil {
+		if cfg.failOnMismatch {
+			h.require.NoError(err)
+		}
+		h.summary.softFail(err)
+	}
+}
+
+// correctOutputRoot returns the output root of the disputed L2 block reported by the rollup node at rollupEndpoint.
+func (h *FactoryHelper) correctOutputRoot(ctx context.Context, rollupEndpoint string) common.Hash {
+	ctx, cancel := h.withTimeout(ctx, time.Minute)
+	defer cancel()
+	rpcClient, err := rpc.DialContext(ctx, rollupEndpoint)
+	h.require.NoError(err, "dial rollup node")
+	defer rpcClient.Close()
+	root, err := e2eutils.OutputRootViaRollupNode(ctx, sources.NewRollupClient(client.NewBaseRPCClient(rpcClient)), disputedL2BlockNumber)
+	h.require.NoError(err)
+	return root
+}
+
+// alphabetRootClaim returns the root claim of an alphabet game using the specified alphabet as its trace.
+func alphabetRootClaim(ctx context.Context, claimedAlphabet string) (common.Hash, error) {
+	trace := alphabet.NewTraceProvider(claimedAlphabet, alphabetGameDepth)
+	root, err := trace.Get(ctx, lastAlphabetTraceIndex)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("get root claim: %w", err)
+	}
+	return root, nil
+}
+
+// checkRootIntent returns an error describing the mismatch if the claimed root's honesty doesn't match honest.
+func checkRootIntent(honest bool, correct common.Hash, claimed common.Hash) error {
+	isHonest := correct == claimed
+	if honest && !isHonest {
+		return fmt.Errorf("intended an honest root claim but %v does not match the correct root %v", claimed, correct)
+	}
+	if !honest && isHonest {
+		return fmt.Errorf("intended a dishonest root claim but %v matches the correct root", claimed)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package disputegame
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRootIntent(t *testing.T) {
+	correct := common.Hash{0xaa}
+	incorrect := common.Hash{0xbb}
+
+	require.NoError(t, checkRootIntent(true, correct, correct))
+	require.NoError(t, checkRootIntent(false, correct, incorrect))
+	require.ErrorContains(t, checkRootIntent(true, correct, incorrect), "intended an honest root claim")
+	require.ErrorContains(t, checkRootIntent(false, correct, correct), "intended a dishonest root claim")
+}
+
+func TestAlphabetRootClaimIntent(t *testing.T) {
+	ctx := context.Background()
+	correct, err := alphabetRootClaim(ctx, CorrectAlphabet)
+	require.NoError(t, err)
+
+	// Alphabets which only differ before the last trace index still have an honest root claim
+	sameRoot, err := alphabetRootClaim(ctx, "zbcdefghijklmnop")
+	require.NoError(t, err)
+	require.NoError(t, checkRootIntent(true, correct, sameRoot))
+
+	dishonest, err := alphabetRootClaim(ctx, "abcdexyz")
+	require.NoError(t, err)
+	require.NoError(t, checkRootIntent(false, correct, dishonest))
+	require.Error(t, checkRootIntent(true, correct, dishonest), "should detect dishonest root claimed as honest")
+	require.Error(t, checkRootIntent(false, correct, correct), "should detect honest root claimed as dishonest")
+}
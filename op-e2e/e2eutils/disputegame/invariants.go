@@ -0,0 +1,125 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/challenger"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// RevertInvariant checks the reason a transaction from a watched account reverted, or would have reverted if it had
+// been sent, returning an error if the revert violates the invariant. revertReason is empty for successful
+// transactions or when the reason couldn't be determined.
+type RevertInvariant func(revertReason string) error
+
+// NoRevertsWith returns an invariant that fails for any transaction that reverted with one of reasons.
+func NoRevertsWith(reasons ...string) RevertInvariant {
+	return func(revertReason string) error {
+		for _, reason := range reasons {
+			if revertReason == reason {
+				return fmt.Errorf("reverted with %v", reason)
+			}
+		}
+		return nil
+	}
+}
+
+// ChallengerInvariants are the invariants enforced for challengers by WithChallengerInvariants. A challenger must
+// never make a move beyond the maximum game depth and must stop acting on a game once it has resolved.
+var ChallengerInvariants = []RevertInvariant{
+	NoRevertsWith("GameDepthExceeded", "GameNotInProgress"),
+}
+
+// WithChallengerInvariants makes every challenger started by the game helpers check ChallengerInvariants against
+// all of its transactions when the test completes. The challenger estimates gas before sending so transactions that
+// would revert are usually never sent, and are instead checked using the errors the challenger logged.
+func WithChallengerInvariants() FactoryOption {
+	return func(h *FactoryHelper) {
+		h.challengerInvariants = true
+	}
+}
+
+// watchChallenger registers the invariant checks for a newly started challenger if they are enabled.
+func (g *FaultGameHelper) watchChallenger(c *challenger.Helper) {
+	if !g.challengerInvariants {
+		return
+	}
+	// Cleanups run in reverse order so this runs before the challenger and system are stopped.
+	g.t.Cleanup(func() {
+		ctx, cancel := g.withTimeout(context.Background(), time.Minute)
+		defer cancel()
+		g.RequireTxInvariants(ctx, c.Address(), ChallengerInvariants...)
+		violations := checkErrorInvariants(c.LoggedErrors(), ChallengerInvariants)
+		g.require.Emptyf(violations, "challenger %v attempted transactions that violated invariants", c.Address())
+	})
+}
+
+// RequireTxInvariants fails the test if any transaction sent by sender since the game was created, to any address,
+// violates one of invariants. Only transactions included in a block are checked.
+func (g *FaultGameHelper) RequireTxInvariants(ctx context.Context, sender common.Address, invariants ...RevertInvariant) {
+	var txs []ScannedTx
+	g.forEachTxFrom(ctx, sender, func(scanned ScannedTx) {
+		txs = append(txs, scanned)
+	})
+	violations := checkTxInvariants(txs, func(scanned ScannedTx) string {
+		return g.revertReason(ctx, sender, scanned)
+	}, invariants)
+	g.require.Emptyf(violations, "transactions from %v violated invariants", sender)
+}
+
+// checkTxInvariants applies each invariant to every transaction and returns all violations.
+// revertReason is only called for transactions that reverted.
+func checkTxInvariants(txs []ScannedTx, revertReason func(scanned ScannedTx) string, invariants []RevertInvariant) []error {
+	var violations []error
+	for _, scanned := range txs {
+		reason := ""
+		if scanned.Receipt.Status != ethtypes.ReceiptStatusSuccessful {
+			reason = revertReason(scanned)
+		}
+		for _, invariant := range invariants {
+			if err := invariant(reason); err != nil {
+				violations = append(violations, fmt.Errorf("tx %v %w", scanned.Tx.Hash(), err))
+			}
+		}
+	}
+	return violations
+}
+
+// checkErrorInvariants applies each invariant to the revert reason of every error that includes revert data and
+// returns all violations. Errors without revert data, such as network failures, are ignored.
+func checkErrorInvariants(errs []error, invariants []RevertInvariant) []error {
+	var violations []error
+	for _, cause := range errs {
+		reason, ok := decodeRevertReason(cause)
+		if !ok {
+			continue
+		}
+		for _, invariant := range invariants {
+			if err := invariant(reason); err != nil {
+				violations = append(violations, fmt.Errorf("attempted tx %w: %v", err, cause))
+			}
+		}
+	}
+	return violations
+}
+
+// revertReason determines why an included transaction reverted by replaying it against the state at the end of the
+// block it was included in. Receipts don't include revert data so this is the closest reproduction available.
+func (g *FaultGameHelper) revertReason(ctx context.Context, sender common.Address, scanned ScannedTx) string {
+	_, err := g.client.CallContract(ctx, ethereum.CallMsg{
+		From:  sender,
+		To:    scanned.Tx.To(),
+		Gas:   scanned.Tx.Gas(),
+		Value: scanned.Tx.Value(),
+		Data:  scanned.Tx.Data(),
+	}, scanned.Receipt.BlockNumber)
+	if err == nil {
+		return ""
+	}
+	reason, _ := decodeRevertReason(err)
+	return reason
+}
@@ -0,0 +1,88 @@
+package disputegame
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoRevertsWith(t *testing.T) {
+	invariant := NoRevertsWith("GameDepthExceeded", "GameNotInProgress")
+	require.NoError(t, invariant(""))
+	require.NoError(t, invariant("ClockTimeExceeded"))
+	require.ErrorContains(t, invariant("GameDepthExceeded"), "GameDepthExceeded")
+	require.ErrorContains(t, invariant("GameNotInProgress"), "GameNotInProgress")
+}
+
+func TestCheckTxInvariants(t *testing.T) {
+	tx := func(nonce uint64, status uint64) ScannedTx {
+		return ScannedTx{
+			Tx:      ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: nonce, To: &common.Address{0xaa}}),
+			Receipt: &ethtypes.Receipt{Status: status},
+		}
+	}
+	success := tx(0, ethtypes.ReceiptStatusSuccessful)
+	depthExceeded := tx(1, ethtypes.ReceiptStatusFailed)
+	notInProgress := tx(2, ethtypes.ReceiptStatusFailed)
+	otherRevert := tx(3, ethtypes.ReceiptStatusFailed)
+	reasons := map[common.Hash]string{
+		depthExceeded.Tx.Hash(): "GameDepthExceeded",
+		notInProgress.Tx.Hash(): "GameNotInProgress",
+		otherRevert.Tx.Hash():   "ClockTimeExceeded",
+	}
+	var looked []common.Hash
+	revertReason := func(scanned ScannedTx) string {
+		looked = append(looked, scanned.Tx.Hash())
+		return reasons[scanned.Tx.Hash()]
+	}
+
+	t.Run("NoViolations", func(t *testing.T) {
+		looked = nil
+		violations := checkTxInvariants([]ScannedTx{success, otherRevert}, revertReason, ChallengerInvariants)
+		require.Empty(t, violations)
+		require.Equal(t, []common.Hash{otherRevert.Tx.Hash()}, looked, "should only load revert reasons of failed txs")
+	})
+
+	t.Run("ReportsEachViolation", func(t *testing.T) {
+		violations := checkTxInvariants([]ScannedTx{success, depthExceeded, otherRevert, notInProgress}, revertReason, ChallengerInvariants)
+		require.Len(t, violations, 2)
+		require.ErrorContains(t, violations[0], depthExceeded.Tx.Hash().Hex())
+		require.ErrorContains(t, violations[1], notInProgress.Tx.Hash().Hex())
+	})
+
+	t.Run("AppliesAllInvariants", func(t *testing.T) {
+		violations := checkTxInvariants([]ScannedTx{depthExceeded}, revertReason, []RevertInvariant{
+			NoRevertsWith("GameDepthExceeded"),
+			NoRevertsWith("GameDepthExceeded", "ClockTimeExceeded"),
+			NoRevertsWith("ClockTimeExceeded"),
+		})
+		require.Len(t, violations, 2)
+	})
+}
+
+func TestCheckErrorInvariants(t *testing.T) {
+	selector := func(sig string) string {
+		return hexutil.Encode(crypto.Keccak256([]byte(sig))[:4])
+	}
+	depthExceeded := fmt.Errorf("failed to create the tx: failed to estimate gas: %w", stubDataError{data: selector("GameDepthExceeded()")})
+	notInProgress := fmt.Errorf("failed to create the tx: %w", stubDataError{data: selector("GameNotInProgress()")})
+	otherRevert := stubDataError{data: selector("ClockTimeExceeded()")}
+	networkFailure := errors.New("connection refused")
+
+	t.Run("NoViolations", func(t *testing.T) {
+		require.Empty(t, checkErrorInvariants([]error{otherRevert, networkFailure}, ChallengerInvariants))
+	})
+
+	t.Run("ReportsEachViolation", func(t *testing.T) {
+		violations := checkErrorInvariants([]error{depthExceeded, networkFailure, notInProgress, otherRevert}, ChallengerInvariants)
+		require.Len(t, violations, 2)
+		require.ErrorContains(t, violations[0], "GameDepthExceeded")
+		require.ErrorContains(t, violations[1], "GameNotInProgress")
+	})
+}
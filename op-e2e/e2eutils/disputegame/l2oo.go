@@ -0,0 +1,154 @@
+package disputegame
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/challenger"
+	"github.com/ethereum-optimism/optimism/op-service/client/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// deletionSurvivalPeriod is how long the challenger must keep running after outputs are deleted before the game is
+// allowed to end.
+const deletionSurvivalPeriod = 10 * time.Second
+
+// WithL2OutputOracleChallenger makes the helper send L2OutputOracle challenger transactions, such as
+// DeleteL2Outputs, from key. key must be the oracle's challenger, which for the devnet is easiest to arrange by
+// overriding the challenger in the L1 genesis.
+func WithL2OutputOracleChallenger(key *ecdsa.PrivateKey) FactoryOption {
+	return func(h *FactoryHelper) {
+		h.l2ooChallengerKey = key
+	}
+}
+
+// WithProxyAdminOwner makes the helper send ProxyAdmin transactions, such as UpgradeL2OutputOracle, from key.
+// key must be the owner of the ProxyAdmin, which for the devnet requires overriding the owner in the L1 genesis.
+func WithProxyAdminOwner(key *ecdsa.PrivateKey) FactoryOption {
+	return func(h *FactoryHelper) {
+		h.proxyAdminKey = key
+	}
+}
+
+// UpgradeL2OutputOracle upgrades the L2OutputOracle proxy to a new implementation with the same configuration as
+// the current one, except for finalizationPeriod. Existing outputs and roles are kept as they are in the proxy's
+// storage. The devnet finalizes outputs within seconds so this is required before outputs can be deleted.
+// Requires the helper to be created with WithProxyAdminOwner.
+func (h *FactoryHelper) UpgradeL2OutputOracle(ctx context.Context, finalizationPeriod time.Duration) common.Address {
+	ctx, cancel := h.withTimeout(ctx, time.Minute)
+	defer cancel()
+	h.require.NotNil(h.proxyAdminOpts, "proxy admin owner not configured")
+	impl, err := h.FactoryCore.UpgradeL2OutputOracle(ctx, h.proxyAdminOpts, uint64(finalizationPeriod/time.Second))
+	h.require.NoError(err)
+	period, err := h.l2oo.FINALIZATIONPERIODSECONDS(&bind.CallOpts{Context: ctx})
+	h.require.NoError(err, "load finalization period")
+	h.require.Equal(uint64(finalizationPeriod/time.Second), period.Uint64(), "finalization period not upgraded")
+	return impl
+}
+
+// DeleteL2Outputs deletes the output proposal at fromIndex and every later output from the L2OutputOracle.
+// Requires the helper to be created with WithL2OutputOracleChallenger.
+func (h *FactoryHelper) DeleteL2Outputs(ctx context.Context, fromIndex uint64) {
+	ctx, cancel := h.withTimeout(ctx, time.Minute)
+	defer cancel()
+	h.require.NotNil(h.l2ooChallengerOpts, "L2 output oracle challenger not configured")
+	h.require.NoError(h.FactoryCore.DeleteL2Outputs(ctx, h.l2ooChallengerOpts, fromIndex))
+	next, err := h.l2oo.NextOutputIndex(&bind.CallOpts{Context: ctx})
+	h.require.NoError(err, "load next output index")
+	h.require.Equal(fromIndex, next.Uint64(), "outputs not deleted")
+}
+
+// RequireGameSurvivesOutputDeletion deletes the output disputed by game, and all later outputs, while the game is in
+// progress, then checks how the game and challenger, which must already be playing the game, react.
+// The game copies its proposals from the L2OutputOracle when it is created, so it is unaffected by the deletion and
+// resolves on its original claims. The challenger may fail to load the deleted output but must keep running and
+// play the game until it resolves with the expected status. Outputs must not be proposed again before the challenger's checks, so the proposer
+// should be stopped first.
+// Requires the helper to be created with WithL2OutputOracleChallenger and WithL1TimeAdvancer.
+func (h *FactoryHelper) RequireGameSurvivesOutputDeletion(ctx context.Context, game *FaultGameHelper, c *challenger.Helper, expected Status) {
+	h.require.NoError(h.checkTimeControl(), "resolve game after output deletion")
+	opts := &bind.CallOpts{Context: ctx}
+	before, err := game.game.Proposals(opts)
+	h.require.NoError(err, "load game proposals")
+	h.DeleteL2Outputs(ctx, before.Disputed.Index.Uint64())
+
+	_, err = h.l2oo.GetL2Output(opts, before.Disputed.Index)
+	h.require.Error(err, "deleted output should not be readable")
+	after, err := game.game.Proposals(opts)
+	h.require.NoError(err, "load game proposals after deletion")
+	h.require.Equal(before, after, "game proposals changed when outputs were deleted")
+
+	h.require.Never(func() bool {
+		return c.CheckRunning() != nil
+	}, deletionSurvivalPeriod, time.Second, "challenger stopped after outputs were deleted")
+	h.AdvanceL1Time(ctx, game.GameDuration(ctx))
+	game.WaitForGameStatus(ctx, expected)
+	h.require.NoError(c.CheckRunning(), "challenger stopped before the game ended")
+}
+
+// UpgradeL2OutputOracle deploys a new L2OutputOracle implementation with the same submission interval and L2 block
+// time as the current one and the given finalizationPeriod, in seconds, then upgrades the proxy to it, sending the
+// upgrade with adminOpts which must be for the ProxyAdmin owner.
+func (c *FactoryCore) UpgradeL2OutputOracle(ctx context.Context, adminOpts *bind.TransactOpts, finalizationPeriod uint64) (common.Address, error) {
+	callOpts := &bind.CallOpts{Context: ctx}
+	interval, err := c.l2oo.SUBMISSIONINTERVAL(callOpts)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("load submission interval: %w", err)
+	}
+	blockTime, err := c.l2oo.L2BLOCKTIME(callOpts)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("load L2 block time: %w", err)
+	}
+	opts := *adminOpts
+	opts.Context = ctx
+	impl, tx, _, err := bindings.DeployL2OutputOracle(&opts, c.client, interval, blockTime, new(big.Int).SetUint64(finalizationPeriod))
+	if err != nil {
+		return common.Address{}, fmt.Errorf("deploy L2 output oracle: %w", err)
+	}
+	if _, err := utils.WaitReceiptOK(ctx, c.client, tx.Hash()); err != nil {
+		return common.Address{}, fmt.Errorf("wait for L2 output oracle deployment receipt to be OK: %w", err)
+	}
+	proxyAdmin, err := bindings.NewProxyAdminTransactor(c.proxyAdminAddr, c.client)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("create proxy admin transactor: %w", err)
+	}
+	tx, err = proxyAdmin.Upgrade(&opts, c.l2ooAddr, impl)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("upgrade L2 output oracle to %v: %w", impl, err)
+	}
+	if _, err := utils.WaitReceiptOK(ctx, c.client, tx.Hash()); err != nil {
+		return common.Address{}, fmt.Errorf("wait for upgrade receipt to be OK: %w", err)
+	}
+	return impl, nil
+}
+
+// DeleteL2Outputs deletes the outputs from fromIndex onwards from the L2OutputOracle, sending the transaction with
+// challengerOpts which must be for the oracle's challenger.
+func (c *FactoryCore) DeleteL2Outputs(ctx context.Context, challengerOpts *bind.TransactOpts, fromIndex uint64) error {
+	role, err := c.l2oo.CHALLENGER(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("load L2 output oracle challenger: %w", err)
+	}
+	if role != challengerOpts.From {
+		return fmt.Errorf("%v is not the L2 output oracle challenger %v", challengerOpts.From, role)
+	}
+	l2oo, err := bindings.NewL2OutputOracleTransactor(c.l2ooAddr, c.client)
+	if err != nil {
+		return fmt.Errorf("create l2oo transactor: %w", err)
+	}
+	opts := *challengerOpts
+	opts.Context = ctx
+	tx, err := l2oo.DeleteL2Outputs(&opts, new(big.Int).SetUint64(fromIndex))
+	if err != nil {
+		return fmt.Errorf("delete L2 outputs from index %v: %w", fromIndex, err)
+	}
+	if _, err := utils.WaitReceiptOK(ctx, c.client, tx.Hash()); err != nil {
+		return fmt.Errorf("wait for delete outputs receipt to be OK: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package disputegame
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/challenger"
+)
+
+// loadDishonestAlphabet is the alphabet claimed by the dishonest games created by RunAlphabetGameLoad.
+const loadDishonestAlphabet = "abcdexyz"
+
+// GameLoad is a set of alphabet games created over time and played by a single honest challenger.
+type GameLoad struct {
+	h          *FactoryHelper
+	games      []*AlphabetGameHelper
+	challenger *challenger.Helper
+	collector  *MoveCollector
+}
+
+// RunAlphabetGameLoad creates a new alphabet game every interval until duration has passed, alternating between valid
+// and invalid root claims, while a single honest challenger, configured by options, plays every game. The challenger
+// is started once, after the first game is created, and discovers the later games from the dispute game factory while
+// it runs. The challenger is left running once the load ends so the games can play out.
+func (h *FactoryHelper) RunAlphabetGameLoad(ctx context.Context, l1Endpoint string, interval time.Duration, duration time.Duration, options ...challenger.Option) *GameLoad {
+	load := &GameLoad{
+		h:         h,
+		collector: h.CollectMoves(),
+	}
+	end := h.clock.Now().Add(duration)
+	for i := 0; h.clock.Now().Before(end); i++ {
+		claimedAlphabet := CorrectAlphabet
+		if i%2 == 1 {
+			claimedAlphabet = loadDishonestAlphabet
+		}
+		game := h.StartAlphabetGame(ctx, claimedAlphabet)
+		h.t.Logf("Created game %v at %v claiming %v", i+1, game.addr, claimedAlphabet)
+		load.games = append(load.games, game)
+		load.collector.Add(&game.FaultGameHelper, game.HonestStrategy())
+		if load.challenger == nil {
+			opts := append([]challenger.Option{challenger.WithGameFactory(h.factoryAddr)}, options...)
+			load.challenger = game.StartHonestChallenger(ctx, l1Endpoint, "Challenger", opts...)
+		}
+
+		select {
+		case <-ctx.Done():
+			h.require.NoError(ctx.Err(), "game load interrupted")
+		case <-h.clock.After(interval):
+		}
+	}
+	return load
+}
+
+// Games returns the games created by the load, in creation order.
+func (l *GameLoad) Games() []*AlphabetGameHelper {
+	return l.games
+}
+
+// Throughput reports how the challenger kept up with the games created by the load.
+func (l *GameLoad) Throughput(ctx context.Context) ThroughputReport {
+	return l.collector.Throughput(ctx, l.challenger.Address())
+}
+
+// RequireNoMissedClocks logs the throughput report for the challenger and fails the test if the clock of any claim
+// expired without the challenger making the honest move against it.
+// Check before moving L1 time forward to resolve the games, as doing so expires the clocks of games still being
+// played.
+func (l *GameLoad) RequireNoMissedClocks(ctx context.Context) {
+	l.collector.RequireNoMissedClocks(ctx, l.challenger.Address())
+}
+
+// RequireResolvedCorrectly advances L1 time past the end of every game and requires that the challenger resolves the
+// games with valid root claims as defender wins and those with invalid root claims as challenger wins.
+// Fails the test if the helper wasn't created with WithL1TimeAdvancer.
+func (l *GameLoad) RequireResolvedCorrectly(ctx context.Context) {
+	l.h.require.NoError(l.h.checkTimeControl(), "resolve game load")
+	last := l.games[len(l.games)-1]
+	l.h.AdvanceL1Time(ctx, last.GameDuration(ctx))
+	for _, game := range l.games {
+		expected := StatusChallengerWins
+		if game.claimedAlphabet == CorrectAlphabet {
+			expected = StatusDefenderWins
+		}
+		game.WaitForGameStatus(ctx, expected)
+	}
+}
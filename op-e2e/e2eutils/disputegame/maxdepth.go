@@ -0,0 +1,138 @@
+package disputegame
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/cannon"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PlayMaxDepthGame creates a cannon game with the full cannonGameDepth for the pre-generated cannon trace in
+// fixtureDir and plays it down to a leaf, then steps against the leaf and resolves the game.
+// The root claim is invalid and is disputed using the fixture's trace. The claims supporting the root claim follow a
+// trace which agrees with the fixture up to the middle of its execution and differs after it, so the dispute narrows
+// to a step of the fixture's program. Every move is calculated from the positions of the claims, which are checked
+// against the positions recorded by the contract, rather than being fixed in advance. Once the step has countered
+// the leaf, the clocks must be consistent and the game must resolve with the challenger winning after L1 time is
+// advanced past the end of the game.
+// The fixture's program must not read from the preimage oracle in the disputed step. As for StartFixtureCannonGame,
+// the helper must be created with WithFactoryOwner, and also with WithL1TimeAdvancer.
+func (h *FactoryHelper) PlayMaxDepthGame(ctx context.Context, fixtureDir string) *CannonGameHelper {
+	fixture, err := cannon.LoadFixture(fixtureDir)
+	h.require.NoError(err, "load cannon fixture")
+	h.require.NotNil(h.ownerOpts, "factory owner not configured")
+	h.require.NoError(h.checkTimeControl(), "resolve max depth game")
+	h.require.NotZero(fixture.Final.Step, "fixture has no steps")
+	honest, err := cannon.NewFixtureTraceProvider(&config.Config{CannonFixtureDir: fixtureDir, CannonDatadir: h.t.TempDir()})
+	h.require.NoError(err, "create fixture trace provider")
+	dishonest := &divergentTrace{TraceProvider: honest, divergeAt: fixture.Final.Step / 2}
+
+	h.waitForProposals(ctx)
+	createCtx, cancel := h.withTimeout(ctx, 2*time.Minute)
+	defer cancel()
+	h.registerFixtureImplementation(createCtx, fixture, cannonGameDepth)
+	rootClaim, err := dishonest.Get(createCtx, maxDepthTraceIndex(big.NewInt(1), cannonGameDepth))
+	h.require.NoError(err, "get invalid root claim")
+	l1Head := h.checkpointL1Block(createCtx)
+	addr, err := h.CreateGame(createCtx, cannonGameType, rootClaim, makeExtraData(l1Head.Uint64()))
+	h.require.NoError(err, "create max depth cannon game")
+	game := &CannonGameHelper{
+		FaultGameHelper: h.newGameHelper(createCtx, addr, cannonGameDepth),
+		fixtureDir:      fixtureDir,
+	}
+
+	// Claims at even depths support the root claim so are made by the dishonest actor and countered by the honest one.
+	claimIdx := int64(0)
+	pos := big.NewInt(1)
+	for maxDepthPositionDepth(pos) < cannonGameDepth {
+		var actor types.TraceProvider = honest
+		if maxDepthPositionDepth(pos)%2 == 1 {
+			actor = dishonest
+		}
+		claim := game.getClaim(ctx, claimIdx)
+		agree, err := agreesWithTrace(ctx, actor, pos, claim.Claim)
+		h.require.NoErrorf(err, "check claim %v", claimIdx)
+		next := maxDepthMove(pos, !agree)
+		value, err := actor.Get(ctx, maxDepthTraceIndex(next, cannonGameDepth))
+		h.require.NoErrorf(err, "get trace for position %v", next)
+		h.require.NoErrorf(game.move(ctx, claimIdx, value, !agree), "counter claim %v", claimIdx)
+
+		claimIdx = game.ClaimCount(ctx) - 1
+		recorded := game.getClaim(ctx, claimIdx).Position
+		h.require.Zerof(next.Cmp(recorded), "claim %v recorded at position %v, expected %v", claimIdx, recorded, next)
+		pos = next
+	}
+
+	// Perform the step against the leaf as the honest actor.
+	leaf := game.getClaim(ctx, claimIdx)
+	leafCorrect, err := agreesWithTrace(ctx, honest, pos, leaf.Claim)
+	h.require.NoError(err, "check leaf claim")
+	preStateIdx := maxDepthTraceIndex(pos, cannonGameDepth)
+	if leafCorrect {
+		// Defend the leaf by proving the following step doesn't result in the claimed post state.
+		preStateIdx++
+	}
+	oracleData, err := honest.GetOracleData(ctx, preStateIdx)
+	h.require.NoError(err)
+	h.require.Emptyf(oracleData.OracleKey, "step %v reads from the preimage oracle", preStateIdx)
+	stateData, proof, err := honest.GetPreimage(ctx, preStateIdx)
+	h.require.NoErrorf(err, "get proof for step %v", preStateIdx)
+	h.require.NoError(game.TryStep(ctx, claimIdx, !leafCorrect, stateData, proof))
+	h.require.True(game.getClaim(ctx, claimIdx).Countered, "step should counter the leaf claim")
+
+	game.RequireClockAccounting(ctx)
+	h.AdvanceL1Time(ctx, game.GameDuration(ctx))
+	game.Resolve(ctx)
+	game.WaitForGameStatus(ctx, StatusChallengerWins)
+	return game
+}
+
+// divergentTrace matches the trace of the embedded provider before divergeAt and differs from it at every later index.
+type divergentTrace struct {
+	types.TraceProvider
+	divergeAt uint64
+}
+
+func (d *divergentTrace) Get(ctx context.Context, i uint64) (common.Hash, error) {
+	value, err := d.TraceProvider.Get(ctx, i)
+	if err != nil || i < d.divergeAt {
+		return value, err
+	}
+	value[len(value)-1] ^= 0xff
+	return value, nil
+}
+
+func agreesWithTrace(ctx context.Context, trace types.TraceProvider, pos *big.Int, value common.Hash) (bool, error) {
+	expected, err := trace.Get(ctx, maxDepthTraceIndex(pos, cannonGameDepth))
+	return expected == value, err
+}
+
+// The positions below are generalized indices, calculated the same way as LibPosition. types.Position can't be used
+// as a generalized index at depth 64 doesn't fit in a uint64.
+
+// maxDepthPositionDepth returns the depth of the generalized index pos.
+func maxDepthPositionDepth(pos *big.Int) int {
+	return pos.BitLen() - 1
+}
+
+// maxDepthMove returns the position of an attack or defense against the claim at pos.
+func maxDepthMove(pos *big.Int, isAttack bool) *big.Int {
+	next := new(big.Int).Set(pos)
+	if !isAttack {
+		next.SetBit(next, 0, 1)
+	}
+	return next.Lsh(next, 1)
+}
+
+// maxDepthTraceIndex returns the trace index committed to by a claim at pos in a game with max depth maxDepth.
+func maxDepthTraceIndex(pos *big.Int, maxDepth int) uint64 {
+	remaining := uint(maxDepth - maxDepthPositionDepth(pos))
+	idx := new(big.Int).Lsh(pos, remaining)
+	idx.Add(idx, new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), remaining), big.NewInt(1)))
+	idx.Sub(idx, new(big.Int).Lsh(big.NewInt(1), uint(maxDepth)))
+	return idx.Uint64()
+}
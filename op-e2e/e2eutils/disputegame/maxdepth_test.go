@@ -0,0 +1,64 @@
+package disputegame
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxDepthPositionsMatchPosition(t *testing.T) {
+	maxDepth := 6
+	for gIndex := uint64(1); gIndex < 1<<maxDepth; gIndex++ {
+		pos := types.NewPositionFromGIndex(gIndex)
+		bigPos := new(big.Int).SetUint64(gIndex)
+		require.Equal(t, pos.Depth(), maxDepthPositionDepth(bigPos))
+		require.Equal(t, pos.TraceIndex(maxDepth), maxDepthTraceIndex(bigPos, maxDepth))
+		attack := pos.Attack()
+		require.Equal(t, attack.ToGIndex(), maxDepthMove(bigPos, true).Uint64())
+		if !pos.IsRootPosition() {
+			defend := pos.Defend()
+			require.Equal(t, defend.ToGIndex(), maxDepthMove(bigPos, false).Uint64())
+		}
+	}
+}
+
+func TestMaxDepthPositionsAtFullDepth(t *testing.T) {
+	root := big.NewInt(1)
+	require.Equal(t, uint64(math.MaxUint64), maxDepthTraceIndex(root, cannonGameDepth))
+
+	leftmost := new(big.Int).Lsh(root, cannonGameDepth)
+	require.Equal(t, cannonGameDepth, maxDepthPositionDepth(leftmost))
+	require.Zero(t, maxDepthTraceIndex(leftmost, cannonGameDepth))
+
+	rightmost := new(big.Int).Sub(new(big.Int).Lsh(root, cannonGameDepth+1), big.NewInt(1))
+	require.Equal(t, cannonGameDepth, maxDepthPositionDepth(rightmost))
+	require.Equal(t, uint64(math.MaxUint64), maxDepthTraceIndex(rightmost, cannonGameDepth))
+
+	pos := root
+	for i := 0; i < cannonGameDepth; i++ {
+		pos = maxDepthMove(pos, true)
+	}
+	require.Zero(t, leftmost.Cmp(pos))
+}
+
+func TestDivergentTrace(t *testing.T) {
+	ctx := context.Background()
+	honest := alphabet.NewTraceProvider(CorrectAlphabet, alphabetGameDepth)
+	trace := &divergentTrace{TraceProvider: honest, divergeAt: 3}
+	for i := uint64(0); i < 1<<alphabetGameDepth; i++ {
+		expected, err := honest.Get(ctx, i)
+		require.NoError(t, err)
+		actual, err := trace.Get(ctx, i)
+		require.NoError(t, err)
+		if i < 3 {
+			require.Equal(t, expected, actual)
+		} else {
+			require.NotEqual(t, expected, actual)
+		}
+	}
+}
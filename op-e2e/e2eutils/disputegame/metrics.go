@@ -0,0 +1,84 @@
+package disputegame
+
+import (
+	"strconv"
+	"time"
+
+	opmetrics "github.com/ethereum-optimism/optimism/op-service/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const MetricsNamespace = "op_e2e_disputegame"
+
+// Metricer records the activity of FactoryCore and GameCore so long-running tools, such as soak tests, can monitor
+// the dispute system over time.
+type Metricer interface {
+	RecordGameCreated(gameType uint8)
+	RecordGameResolved(status Status)
+	RecordTxFailure(method string)
+	RecordMoveLatency(latency time.Duration)
+}
+
+type noopMetrics struct{}
+
+// NoopMetrics discards all metrics. It is used unless metrics are set with SetMetrics.
+var NoopMetrics Metricer = noopMetrics{}
+
+func (noopMetrics) RecordGameCreated(uint8)         {}
+func (noopMetrics) RecordGameResolved(Status)       {}
+func (noopMetrics) RecordTxFailure(string)          {}
+func (noopMetrics) RecordMoveLatency(time.Duration) {}
+
+// Metrics is a Metricer that publishes Prometheus metrics.
+type Metrics struct {
+	gamesCreated  *prometheus.CounterVec
+	gamesResolved *prometheus.CounterVec
+	txFailures    *prometheus.CounterVec
+	moveLatency   prometheus.Histogram
+}
+
+var _ Metricer = (*Metrics)(nil)
+
+// NewMetrics creates Metrics registered with registry.
+func NewMetrics(registry *prometheus.Registry) *Metrics {
+	factory := opmetrics.With(registry)
+	return &Metrics{
+		gamesCreated: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "games_created_total",
+			Help:      "Number of games created",
+		}, []string{"game_type"}),
+		gamesResolved: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "games_resolved_total",
+			Help:      "Number of games observed to be resolved, by status",
+		}, []string{"status"}),
+		txFailures: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "tx_failures_total",
+			Help:      "Number of transactions sent by the helpers that failed, by method",
+		}, []string{"method"}),
+		moveLatency: factory.NewHistogram(prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "move_latency_seconds",
+			Help:      "Time from a claim being included until the watched player's move countering it is included",
+			Buckets:   []float64{.5, 1, 2, 4, 8, 16, 32, 64, 128},
+		}),
+	}
+}
+
+func (m *Metrics) RecordGameCreated(gameType uint8) {
+	m.gamesCreated.WithLabelValues(strconv.Itoa(int(gameType))).Inc()
+}
+
+func (m *Metrics) RecordGameResolved(status Status) {
+	m.gamesResolved.WithLabelValues(status.String()).Inc()
+}
+
+func (m *Metrics) RecordTxFailure(method string) {
+	m.txFailures.WithLabelValues(method).Inc()
+}
+
+func (m *Metrics) RecordMoveLatency(latency time.Duration) {
+	m.moveLatency.Observe(latency.Seconds())
+}
@@ -0,0 +1,29 @@
+package disputegame
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MeasureMoveGasByDepth attacks the root claim and then each new claim in turn, down to the game's max depth, and
+// returns the gas used by the move that created the claim at each depth.
+// Moves should cost much the same at every depth, so growth with depth indicates a move that gets more expensive as
+// the game progresses, which could be used to price honest players out of a game.
+func (g *FaultGameHelper) MeasureMoveGasByDepth(ctx context.Context) map[int]uint64 {
+	gasByDepth := make(map[int]uint64, g.maxDepth)
+	claimIdx := int64(0)
+	for depth := 1; depth <= g.maxDepth; depth++ {
+		moveCtx, cancel := g.withTimeout(ctx, time.Minute)
+		bond, err := g.moveBond(moveCtx, claimIdx, true)
+		g.require.NoErrorf(err, "load bond for move at depth %v", depth)
+		rcpt, err := g.sendMoveWithReceipt(moveCtx, claimIdx, common.Hash{0xaa, byte(depth)}, true, bond)
+		cancel()
+		g.require.NoErrorf(err, "attack claim %v at depth %v", claimIdx, depth-1)
+		gasByDepth[depth] = rcpt.GasUsed
+		claimIdx = g.ClaimCount(ctx) - 1
+	}
+	g.t.Logf("Move gas by depth in game %v: %v", g.addr, gasByDepth)
+	return gasByDepth
+}
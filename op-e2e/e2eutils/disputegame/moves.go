@@ -0,0 +1,250 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// GameMove is a move made in a game, decoded from its Move event.
+type GameMove struct {
+	Game        common.Address
+	ParentIndex uint64
+	Claim       common.Hash
+	Claimant    common.Address
+	BlockNumber uint64
+	LogIndex    uint
+	Timestamp   uint64 // Timestamp of the L1 block the move was included in
+}
+
+// before reports whether m was included on chain before other.
+func (m GameMove) before(other GameMove) bool {
+	if m.BlockNumber != other.BlockNumber {
+		return m.BlockNumber < other.BlockNumber
+	}
+	return m.LogIndex < other.LogIndex
+}
+
+// Moves returns every move made in the game so far, in the order they were made.
+func (g *FaultGameHelper) Moves(ctx context.Context) []GameMove {
+	moves, err := g.loadMoves(ctx)
+	g.require.NoError(err)
+	return moves
+}
+
+func (g *FaultGameHelper) loadMoves(ctx context.Context) ([]GameMove, error) {
+	return g.loadMovesSince(ctx, g.creation.BlockNumber)
+}
+
+// loadMovesSince returns every move made in the game, in the order they were made, searching for Move events from
+// the L1 block start. Pass the block the game was created in to avoid searching blocks before it existed.
+func (g *GameCore) loadMovesSince(ctx context.Context, start uint64) ([]GameMove, error) {
+	iter, err := g.game.FilterMove(&bind.FilterOpts{Context: ctx, Start: start}, nil, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("filter move events for game %v: %w", g.addr, err)
+	}
+	defer iter.Close()
+	var moves []GameMove
+	timestamps := make(map[common.Hash]uint64)
+	for iter.Next() {
+		event := iter.Event
+		timestamp, ok := timestamps[event.Raw.BlockHash]
+		if !ok {
+			header, err := g.client.HeaderByHash(ctx, event.Raw.BlockHash)
+			if err != nil {
+				return nil, fmt.Errorf("load block %v for move in game %v: %w", event.Raw.BlockHash, g.addr, err)
+			}
+			timestamp = header.Time
+			timestamps[event.Raw.BlockHash] = timestamp
+		}
+		moves = append(moves, GameMove{
+			Game:        g.addr,
+			ParentIndex: event.ParentIndex.Uint64(),
+			Claim:       event.Claim,
+			Claimant:    event.Claimant,
+			BlockNumber: event.Raw.BlockNumber,
+			LogIndex:    event.Raw.Index,
+			Timestamp:   timestamp,
+		})
+	}
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("iterate move events for game %v: %w", g.addr, err)
+	}
+	return moves, nil
+}
+
+// WatchMoveLatency records the latency of each move claimant makes in the game in the game's metrics until the game
+// is resolved. creation is the game's creation, as returned by FactoryCore.GameCreation.
+func (g *GameCore) WatchMoveLatency(ctx context.Context, creation GameCreation, claimant common.Address) error {
+	recorded := 0
+	err := g.waitForGameLogs(ctx, time.Second, func() (bool, error) {
+		// Load the status first so no move can be made after the moves are loaded once the game is resolved.
+		status, err := g.LoadStatus(ctx)
+		if err != nil {
+			return false, err
+		}
+		moves, err := g.loadMovesSince(ctx, creation.BlockNumber)
+		if err != nil {
+			return false, err
+		}
+		for ; recorded < len(moves); recorded++ {
+			if moves[recorded].Claimant != claimant {
+				continue
+			}
+			latency, err := moveLatency(creation.Timestamp, moves, recorded)
+			if err != nil {
+				return false, err
+			}
+			g.metrics.RecordMoveLatency(latency)
+		}
+		return status != StatusInProgress, nil
+	})
+	if err != nil {
+		return fmt.Errorf("watch moves in game %v: %w", g.addr, err)
+	}
+	return nil
+}
+
+// moveLatency returns the time from the claim countered by moves[i] being included until moves[i] was included,
+// measured by L1 block timestamps. The root claim was included when the game was created at created, and claim n for
+// n > 0 was made by moves[n-1].
+func moveLatency(created uint64, moves []GameMove, i int) (time.Duration, error) {
+	parentTime := created
+	if parent := moves[i].ParentIndex; parent > 0 {
+		if parent > uint64(i) {
+			return 0, fmt.Errorf("move %v counters claim %v which was not yet made", i, parent)
+		}
+		parentTime = moves[parent-1].Timestamp
+	}
+	return time.Duration(moves[i].Timestamp-parentTime) * time.Second, nil
+}
+
+// Expiry returns the unix timestamp by which all of the game's clocks will have expired.
+func (g *FaultGameHelper) Expiry(ctx context.Context) uint64 {
+	createdAt, err := g.game.CreatedAt(&bind.CallOpts{Context: ctx})
+	g.require.NoError(err, "failed to load game creation time")
+	duration, err := g.game.GAMEDURATION(&bind.CallOpts{Context: ctx})
+	g.require.NoError(err, "failed to load game duration")
+	return createdAt + duration
+}
+
+// MoveCollector collects the moves made across multiple games, keyed by game address.
+type MoveCollector struct {
+	t       *testing.T
+	require *require.Assertions
+	factory *FactoryCore
+	games   []*FaultGameHelper
+	// honest is the strategy deciding the moves an honest player must make in each game, if known.
+	honest map[common.Address]Strategy
+	// timeoutScale multiplies the collector's timeouts, as configured by WithTimeoutScale.
+	timeoutScale timeoutScale
+}
+
+// CollectMoves creates a collector for the moves made in games.
+func (h *FactoryHelper) CollectMoves(games ...*FaultGameHelper) *MoveCollector {
+	return &MoveCollector{
+		t:       h.t,
+		require: h.require,
+		factory: h.FactoryCore,
+		games:   games,
+		honest:  make(map[common.Address]Strategy),
+
+		timeoutScale: h.timeoutScale,
+	}
+}
+
+// Add adds game to the games moves are collected from. If honest is not nil, it decides the moves an honest player
+// must make in game so that the throughput report can include the claims whose clock expired without the honest move.
+func (c *MoveCollector) Add(game *FaultGameHelper, honest Strategy) {
+	c.games = append(c.games, game)
+	if honest != nil {
+		c.honest[game.addr] = honest
+	}
+}
+
+// Throughput reports how claimant, the honest challenger playing every collected game, kept up with them.
+func (c *MoveCollector) Throughput(ctx context.Context, claimant common.Address) ThroughputReport {
+	games := make([]ThroughputGame, 0, len(c.games))
+	for _, game := range c.games {
+		games = append(games, ThroughputGame{Addr: game.addr, MaxDepth: game.maxDepth, Honest: c.honest[game.addr]})
+	}
+	report, err := c.factory.LoadThroughputReport(ctx, games, claimant)
+	c.require.NoError(err, "load throughput report")
+	return report
+}
+
+// RequireNoMissedClocks logs the throughput report for claimant and fails the test if the clock of any claim expired
+// without the honest move against it being made. Only games added with an honest strategy are checked.
+func (c *MoveCollector) RequireNoMissedClocks(ctx context.Context, claimant common.Address) {
+	report := c.Throughput(ctx, claimant)
+	c.t.Logf("Challenger %v played %v games with at most %v in progress at once", claimant, report.Games, report.MaxConcurrent)
+	for _, game := range c.games {
+		if response, ok := report.FirstResponse[game.addr]; ok {
+			c.t.Logf("Game %v first responded to after %v", game.addr, response)
+		} else {
+			c.t.Logf("Game %v not responded to", game.addr)
+		}
+	}
+	for _, missed := range report.MissedClocks {
+		c.t.Errorf("Game %v: clock to counter claim %v expired at %v without the honest move", missed.Game, missed.ClaimIndex, missed.Deadline)
+	}
+	c.require.Empty(report.MissedClocks, "honest moves missed")
+}
+
+// Moves returns the moves made so far in each game, keyed by game address.
+func (c *MoveCollector) Moves(ctx context.Context) map[common.Address][]GameMove {
+	moves := make(map[common.Address][]GameMove)
+	for _, game := range c.games {
+		moves[game.addr] = game.Moves(ctx)
+	}
+	return moves
+}
+
+// RequireFirstResponseToEarliestExpiry waits until claimant has made a move in every game, then requires that the
+// games were first responded to in order of expiry, starting with the game with the least remaining time.
+func (c *MoveCollector) RequireFirstResponseToEarliestExpiry(ctx context.Context, claimant common.Address) {
+	expiries := make(map[common.Address]uint64)
+	for _, game := range c.games {
+		expiries[game.addr] = game.Expiry(ctx)
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.timeoutScale.apply(2*time.Minute))
+	defer cancel()
+	var first []GameMove
+	err := waitFor(ctx, c.games[0].clock, time.Second, func() (bool, error) {
+		first = firstMovesBy(c.Moves(ctx), claimant)
+		c.t.Logf("Waiting for %v to respond to all games, responded to %v of %v", claimant, len(first), len(c.games))
+		return len(first) == len(c.games), nil
+	})
+	c.require.NoErrorf(err, "%v did not respond to every game", claimant)
+	for i, move := range first {
+		c.t.Logf("Response %v from %v: game %v expiring at %v, move at %v", i, claimant, move.Game, expiries[move.Game], move.Timestamp)
+	}
+	for i := 1; i < len(first); i++ {
+		c.require.LessOrEqualf(expiries[first[i-1].Game], expiries[first[i].Game],
+			"game %v expiring at %v should have been responded to before game %v expiring at %v",
+			first[i].Game, expiries[first[i].Game], first[i-1].Game, expiries[first[i-1].Game])
+	}
+}
+
+// firstMovesBy returns the first move claimant made in each game, ordered by when the moves were made.
+func firstMovesBy(moves map[common.Address][]GameMove, claimant common.Address) []GameMove {
+	var first []GameMove
+	for _, gameMoves := range moves {
+		for _, move := range gameMoves {
+			if move.Claimant == claimant {
+				first = append(first, move)
+				break
+			}
+		}
+	}
+	sort.Slice(first, func(i, j int) bool {
+		return first[i].before(first[j])
+	})
+	return first
+}
@@ -0,0 +1,60 @@
+package disputegame
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFirstMovesBy(t *testing.T) {
+	claimant := common.Address{0xaa}
+	other := common.Address{0xbb}
+	game1 := common.Address{0x01}
+	game2 := common.Address{0x02}
+	game3 := common.Address{0x03}
+	moves := map[common.Address][]GameMove{
+		game1: {
+			{Game: game1, Claimant: other, BlockNumber: 1},
+			{Game: game1, Claimant: claimant, BlockNumber: 7},
+			{Game: game1, Claimant: claimant, BlockNumber: 8},
+		},
+		game2: {
+			{Game: game2, Claimant: claimant, BlockNumber: 5, LogIndex: 2},
+			{Game: game2, Claimant: claimant, BlockNumber: 6},
+		},
+		game3: {
+			{Game: game3, Claimant: claimant, BlockNumber: 5, LogIndex: 1},
+		},
+	}
+	require.Equal(t, []GameMove{moves[game3][0], moves[game2][0], moves[game1][1]}, firstMovesBy(moves, claimant))
+	require.Equal(t, []GameMove{moves[game1][0]}, firstMovesBy(moves, other))
+	require.Empty(t, firstMovesBy(moves, common.Address{0xcc}))
+}
+
+func TestMoveLatency(t *testing.T) {
+	moves := []GameMove{
+		{ParentIndex: 0, Timestamp: 110},
+		{ParentIndex: 1, Timestamp: 130},
+		{ParentIndex: 0, Timestamp: 134},
+		{ParentIndex: 5, Timestamp: 140},
+	}
+
+	t.Run("CountersRoot", func(t *testing.T) {
+		latency, err := moveLatency(100, moves, 2)
+		require.NoError(t, err)
+		require.Equal(t, 34*time.Second, latency)
+	})
+
+	t.Run("CountersMove", func(t *testing.T) {
+		latency, err := moveLatency(100, moves, 1)
+		require.NoError(t, err)
+		require.Equal(t, 20*time.Second, latency)
+	})
+
+	t.Run("UnknownParent", func(t *testing.T) {
+		_, err := moveLatency(100, moves, 3)
+		require.ErrorContains(t, err, "not yet made")
+	})
+}
@@ -0,0 +1,145 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Multicall3Addr is the address Multicall3 is deployed to on most chains.
+var Multicall3Addr = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+const multicall3ABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// GetAllClaims returns every claim in the game. When Multicall3 is deployed, all claims are read with a single
+// eth_call, otherwise each claim is requested individually.
+func (g *FaultGameHelper) GetAllClaims(ctx context.Context) []ContractClaim {
+	multicall, err := g.multicallAvailable(ctx)
+	g.require.NoError(err, "check for multicall3")
+	var claims []ContractClaim
+	if multicall {
+		claims, err = g.LoadClaimsMulticall(ctx, Multicall3Addr)
+	} else {
+		claims, err = g.LoadClaims(ctx)
+	}
+	g.require.NoError(err, "load claims")
+	return claims
+}
+
+// multicallAvailable returns true if Multicall3 is deployed. The result is cached so the code is only requested once.
+func (g *GameCore) multicallAvailable(ctx context.Context) (bool, error) {
+	g.probes.lock.Lock()
+	defer g.probes.lock.Unlock()
+	if g.probes.multicall != nil {
+		return *g.probes.multicall, nil
+	}
+	code, err := g.client.CodeAt(ctx, Multicall3Addr, nil)
+	if err != nil {
+		return false, err
+	}
+	available := len(code) > 0
+	g.probes.multicall = &available
+	return available, nil
+}
+
+// LoadClaimsMulticall reads every claim in the game with a single call to the Multicall3 contract at multicallAddr.
+func (g *GameCore) LoadClaimsMulticall(ctx context.Context, multicallAddr common.Address) ([]ContractClaim, error) {
+	count, err := g.game.ClaimDataLen(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("retrieve number of claims: %w", err)
+	}
+	data, err := encodeClaimDataCalls(g.addr, count.Uint64())
+	if err != nil {
+		return nil, err
+	}
+	result, err := g.client.CallContract(ctx, ethereum.CallMsg{To: &multicallAddr, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("call multicall3: %w", err)
+	}
+	return decodeClaimDataResults(result)
+}
+
+func encodeClaimDataCalls(game common.Address, count uint64) ([]byte, error) {
+	fdgAbi, err := bindings.FaultDisputeGameMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	calls := make([]multicall3Call, 0, count)
+	for i := uint64(0); i < count; i++ {
+		callData, err := fdgAbi.Pack("claimData", new(big.Int).SetUint64(i))
+		if err != nil {
+			return nil, fmt.Errorf("encode claimData call: %w", err)
+		}
+		calls = append(calls, multicall3Call{Target: game, CallData: callData})
+	}
+	return packAggregate3(calls)
+}
+
+func decodeClaimDataResults(data []byte) ([]ContractClaim, error) {
+	fdgAbi, err := bindings.FaultDisputeGameMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	results, err := unpackAggregate3(data)
+	if err != nil {
+		return nil, err
+	}
+	claims := make([]ContractClaim, 0, len(results))
+	for i, result := range results {
+		if !result.Success {
+			return nil, fmt.Errorf("retrieve claim %v: call failed", i)
+		}
+		values, err := fdgAbi.Unpack("claimData", result.ReturnData)
+		if err != nil {
+			return nil, fmt.Errorf("decode claim %v: %w", i, err)
+		}
+		claims = append(claims, ContractClaim{
+			ParentIndex: *abi.ConvertType(values[0], new(uint32)).(*uint32),
+			Countered:   *abi.ConvertType(values[1], new(bool)).(*bool),
+			Claim:       *abi.ConvertType(values[2], new([32]byte)).(*[32]byte),
+			Position:    *abi.ConvertType(values[3], new(*big.Int)).(**big.Int),
+			Clock:       *abi.ConvertType(values[4], new(*big.Int)).(**big.Int),
+		})
+	}
+	return claims, nil
+}
+
+// packAggregate3 encodes a call to Multicall3's aggregate3 method making each of calls.
+func packAggregate3(calls []multicall3Call) ([]byte, error) {
+	multicallAbi, err := abi.JSON(strings.NewReader(multicall3ABI))
+	if err != nil {
+		return nil, err
+	}
+	return multicallAbi.Pack("aggregate3", calls)
+}
+
+// unpackAggregate3 decodes the results returned by Multicall3's aggregate3 method.
+func unpackAggregate3(data []byte) ([]multicall3Result, error) {
+	multicallAbi, err := abi.JSON(strings.NewReader(multicall3ABI))
+	if err != nil {
+		return nil, err
+	}
+	out, err := multicallAbi.Unpack("aggregate3", data)
+	if err != nil {
+		return nil, fmt.Errorf("decode multicall3 result: %w", err)
+	}
+	return *abi.ConvertType(out[0], new([]multicall3Result)).(*[]multicall3Result), nil
+}
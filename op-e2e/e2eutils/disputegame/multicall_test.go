@@ -0,0 +1,113 @@
+package disputegame
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMulticallClaimData(t *testing.T) {
+	fdgAbi, err := bindings.FaultDisputeGameMetaData.GetAbi()
+	require.NoError(t, err)
+	multicallAbi, err := abi.JSON(strings.NewReader(multicall3ABI))
+	require.NoError(t, err)
+
+	t.Run("EncodeCalls", func(t *testing.T) {
+		game := common.Address{0xaa}
+		data, err := encodeClaimDataCalls(game, 3)
+		require.NoError(t, err)
+		args, err := multicallAbi.Methods["aggregate3"].Inputs.Unpack(data[4:])
+		require.NoError(t, err)
+		calls := *abi.ConvertType(args[0], new([]multicall3Call)).(*[]multicall3Call)
+		require.Len(t, calls, 3)
+		for i, call := range calls {
+			expected, err := fdgAbi.Pack("claimData", big.NewInt(int64(i)))
+			require.NoError(t, err)
+			require.Equal(t, game, call.Target)
+			require.False(t, call.AllowFailure)
+			require.Equal(t, expected, call.CallData)
+		}
+	})
+
+	t.Run("DecodeResults", func(t *testing.T) {
+		expected := []ContractClaim{
+			{ParentIndex: ^uint32(0), Countered: true, Claim: common.Hash{0x01}, Position: big.NewInt(1), Clock: big.NewInt(100)},
+			{ParentIndex: 0, Countered: false, Claim: common.Hash{0x02}, Position: big.NewInt(2), Clock: big.NewInt(200)},
+		}
+		var results []multicall3Result
+		for _, claim := range expected {
+			returnData, err := fdgAbi.Methods["claimData"].Outputs.Pack(claim.ParentIndex, claim.Countered, claim.Claim, claim.Position, claim.Clock)
+			require.NoError(t, err)
+			results = append(results, multicall3Result{Success: true, ReturnData: returnData})
+		}
+		data, err := multicallAbi.Methods["aggregate3"].Outputs.Pack(results)
+		require.NoError(t, err)
+
+		actual, err := decodeClaimDataResults(data)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("DecodeFailedCall", func(t *testing.T) {
+		data, err := multicallAbi.Methods["aggregate3"].Outputs.Pack([]multicall3Result{{Success: false}})
+		require.NoError(t, err)
+		_, err = decodeClaimDataResults(data)
+		require.Error(t, err)
+	})
+}
+
+func TestMulticallOutputs(t *testing.T) {
+	l2ooAbi, err := bindings.L2OutputOracleMetaData.GetAbi()
+	require.NoError(t, err)
+	multicallAbi, err := abi.JSON(strings.NewReader(multicall3ABI))
+	require.NoError(t, err)
+
+	t.Run("EncodeCalls", func(t *testing.T) {
+		l2oo := common.Address{0xaa}
+		data, err := encodeGetL2OutputCalls(l2oo, 5, 8)
+		require.NoError(t, err)
+		args, err := multicallAbi.Methods["aggregate3"].Inputs.Unpack(data[4:])
+		require.NoError(t, err)
+		calls := *abi.ConvertType(args[0], new([]multicall3Call)).(*[]multicall3Call)
+		require.Len(t, calls, 3)
+		for i, call := range calls {
+			expected, err := l2ooAbi.Pack("getL2Output", big.NewInt(int64(5+i)))
+			require.NoError(t, err)
+			require.Equal(t, l2oo, call.Target)
+			require.Equal(t, expected, call.CallData)
+		}
+	})
+
+	t.Run("DecodeResults", func(t *testing.T) {
+		expected := []bindings.TypesOutputProposal{
+			{OutputRoot: common.Hash{0x01}, Timestamp: big.NewInt(100), L2BlockNumber: big.NewInt(10)},
+			{OutputRoot: common.Hash{0x02}, Timestamp: big.NewInt(200), L2BlockNumber: big.NewInt(20)},
+		}
+		var results []multicall3Result
+		for _, output := range expected {
+			returnData, err := l2ooAbi.Methods["getL2Output"].Outputs.Pack(output)
+			require.NoError(t, err)
+			results = append(results, multicall3Result{Success: true, ReturnData: returnData})
+		}
+		data, err := multicallAbi.Methods["aggregate3"].Outputs.Pack(results)
+		require.NoError(t, err)
+
+		actual, err := decodeGetL2OutputResults(data, 5)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("DecodeFailedCall", func(t *testing.T) {
+		returnData, err := l2ooAbi.Methods["getL2Output"].Outputs.Pack(bindings.TypesOutputProposal{Timestamp: big.NewInt(100), L2BlockNumber: big.NewInt(10)})
+		require.NoError(t, err)
+		data, err := multicallAbi.Methods["aggregate3"].Outputs.Pack([]multicall3Result{{Success: true, ReturnData: returnData}, {Success: false}})
+		require.NoError(t, err)
+		_, err = decodeGetL2OutputResults(data, 5)
+		require.ErrorContains(t, err, "retrieve output 6")
+	})
+}
@@ -0,0 +1,158 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// outputBatchSize is the maximum number of outputs read in a single multicall, so that snapshots of oracles with
+// many outputs don't exceed RPC limits.
+const outputBatchSize = 100
+
+// OutputProposal is an output proposed to the L2OutputOracle.
+type OutputProposal struct {
+	Index         uint64      `json:"index"`
+	OutputRoot    common.Hash `json:"outputRoot"`
+	L2BlockNumber uint64      `json:"l2BlockNumber"`
+	// Timestamp is the L1 timestamp the output was proposed at.
+	Timestamp uint64 `json:"timestamp"`
+	// Proposer is the oracle's proposer. Only the proposer is permitted to propose outputs.
+	Proposer common.Address `json:"proposer"`
+}
+
+// L2OOSnapshot returns every output proposal currently in the L2OutputOracle, ordered by index.
+func (h *FactoryHelper) L2OOSnapshot(ctx context.Context) []OutputProposal {
+	proposals, err := h.LoadOutputProposals(ctx)
+	h.require.NoError(err)
+	return proposals
+}
+
+// RequireOutputAt returns the output proposal for exactly l2BlockNum, failing the test if there isn't one.
+func (h *FactoryHelper) RequireOutputAt(ctx context.Context, l2BlockNum uint64) OutputProposal {
+	proposals := h.L2OOSnapshot(ctx)
+	for _, proposal := range proposals {
+		if proposal.L2BlockNumber == l2BlockNum {
+			return proposal
+		}
+	}
+	h.require.Failf("no output proposal", "no output proposed for L2 block %v in %v proposals", l2BlockNum, len(proposals))
+	return OutputProposal{}
+}
+
+// LoadOutputProposals reads every output proposal in the L2OutputOracle. When Multicall3 is deployed, outputs are
+// read in batches of outputBatchSize, otherwise each output is requested individually.
+func (c *FactoryCore) LoadOutputProposals(ctx context.Context) ([]OutputProposal, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	count, err := c.l2oo.NextOutputIndex(opts)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve number of outputs: %w", err)
+	}
+	proposer, err := c.l2oo.PROPOSER(opts)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve proposer: %w", err)
+	}
+	code, err := c.client.CodeAt(ctx, Multicall3Addr, nil)
+	if err != nil {
+		return nil, fmt.Errorf("check for multicall3: %w", err)
+	}
+	proposals := make([]OutputProposal, 0, count.Uint64())
+	for start := uint64(0); start < count.Uint64(); start += outputBatchSize {
+		end := start + outputBatchSize
+		if end > count.Uint64() {
+			end = count.Uint64()
+		}
+		var batch []bindings.TypesOutputProposal
+		if len(code) > 0 {
+			batch, err = c.loadOutputsMulticall(ctx, start, end)
+		} else {
+			batch, err = c.loadOutputs(ctx, start, end)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i, output := range batch {
+			proposals = append(proposals, OutputProposal{
+				Index:         start + uint64(i),
+				OutputRoot:    output.OutputRoot,
+				L2BlockNumber: output.L2BlockNumber.Uint64(),
+				Timestamp:     output.Timestamp.Uint64(),
+				Proposer:      proposer,
+			})
+		}
+	}
+	return proposals, nil
+}
+
+// loadOutputs reads the outputs with indices from start up to but excluding end individually.
+func (c *FactoryCore) loadOutputs(ctx context.Context, start uint64, end uint64) ([]bindings.TypesOutputProposal, error) {
+	outputs := make([]bindings.TypesOutputProposal, 0, end-start)
+	for i := start; i < end; i++ {
+		output, err := c.l2oo.GetL2Output(&bind.CallOpts{Context: ctx}, new(big.Int).SetUint64(i))
+		if err != nil {
+			return nil, fmt.Errorf("retrieve output %v: %w", i, err)
+		}
+		outputs = append(outputs, output)
+	}
+	return outputs, nil
+}
+
+// loadOutputsMulticall reads the outputs with indices from start up to but excluding end with a single call to
+// Multicall3.
+func (c *FactoryCore) loadOutputsMulticall(ctx context.Context, start uint64, end uint64) ([]bindings.TypesOutputProposal, error) {
+	data, err := encodeGetL2OutputCalls(c.l2ooAddr, start, end)
+	if err != nil {
+		return nil, err
+	}
+	result, err := c.client.CallContract(ctx, ethereum.CallMsg{To: &Multicall3Addr, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("call multicall3: %w", err)
+	}
+	return decodeGetL2OutputResults(result, start)
+}
+
+func encodeGetL2OutputCalls(l2oo common.Address, start uint64, end uint64) ([]byte, error) {
+	l2ooAbi, err := bindings.L2OutputOracleMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	calls := make([]multicall3Call, 0, end-start)
+	for i := start; i < end; i++ {
+		callData, err := l2ooAbi.Pack("getL2Output", new(big.Int).SetUint64(i))
+		if err != nil {
+			return nil, fmt.Errorf("encode getL2Output call: %w", err)
+		}
+		calls = append(calls, multicall3Call{Target: l2oo, CallData: callData})
+	}
+	return packAggregate3(calls)
+}
+
+// decodeGetL2OutputResults decodes the results of a multicall reading outputs from index start onwards.
+func decodeGetL2OutputResults(data []byte, start uint64) ([]bindings.TypesOutputProposal, error) {
+	l2ooAbi, err := bindings.L2OutputOracleMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	results, err := unpackAggregate3(data)
+	if err != nil {
+		return nil, err
+	}
+	outputs := make([]bindings.TypesOutputProposal, 0, len(results))
+	for i, result := range results {
+		if !result.Success {
+			return nil, fmt.Errorf("retrieve output %v: call failed", start+uint64(i))
+		}
+		values, err := l2ooAbi.Unpack("getL2Output", result.ReturnData)
+		if err != nil {
+			return nil, fmt.Errorf("decode output %v: %w", start+uint64(i), err)
+		}
+		outputs = append(outputs, *abi.ConvertType(values[0], new(bindings.TypesOutputProposal)).(*bindings.TypesOutputProposal))
+	}
+	return outputs, nil
+}
@@ -0,0 +1,86 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/client/utils"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// notOwnerReason is the revert reason for admin functions called by an account other than the factory owner.
+const notOwnerReason = "Ownable: caller is not the owner"
+
+// Owner returns the owner of the factory, which is the only account allowed to call its admin functions.
+func (h *FactoryHelper) Owner(ctx context.Context) common.Address {
+	owner, err := h.FactoryCore.Owner(ctx)
+	h.require.NoError(err)
+	return owner
+}
+
+// RequireOwner fails the test if the factory is not owned by expected.
+func (h *FactoryHelper) RequireOwner(ctx context.Context, expected common.Address) {
+	h.require.Equal(expected, h.Owner(ctx), "unexpected factory owner")
+}
+
+// TransferOwnership transfers ownership of the factory to newOwner. The helper can no longer send admin transactions
+// afterwards, unless newOwner is the account it already uses.
+// Requires the helper to be created with WithFactoryOwner.
+func (h *FactoryHelper) TransferOwnership(ctx context.Context, newOwner common.Address) {
+	h.require.NotNil(h.ownerOpts, "factory owner not configured")
+	h.require.NoError(h.FactoryCore.TransferOwnership(ctx, h.ownerOpts, newOwner))
+	h.RequireOwner(ctx, newOwner)
+	if newOwner != h.ownerOpts.From {
+		h.ownerOpts = nil
+	}
+}
+
+// RequireAdminCallsRejected requires that each of the factory's admin functions reverts because the caller is not
+// the owner when called from account.
+func (h *FactoryHelper) RequireAdminCallsRejected(ctx context.Context, account common.Address) {
+	ctx, cancel := h.withTimeout(ctx, time.Minute)
+	defer cancel()
+	factoryAbi, err := bindings.DisputeGameFactoryMetaData.GetAbi()
+	h.require.NoError(err)
+	calls := map[string][]interface{}{
+		"setImplementation": {alphabetGameType, account},
+		"transferOwnership": {account},
+		"renounceOwnership": {},
+	}
+	for method, args := range calls {
+		data, err := factoryAbi.Pack(method, args...)
+		h.require.NoErrorf(err, "pack %v call", method)
+		_, err = h.client.CallContract(ctx, ethereum.CallMsg{From: account, To: &h.factoryAddr, Data: data}, nil)
+		h.require.Errorf(err, "%v from non-owner %v should revert", method, account)
+		reason, _ := decodeRevertReason(err)
+		h.require.Equalf(notOwnerReason, reason, "%v from non-owner %v reverted for the wrong reason", method, account)
+	}
+}
+
+// Owner returns the owner of the factory.
+func (c *FactoryCore) Owner(ctx context.Context) (common.Address, error) {
+	owner, err := c.factory.Owner(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return common.Address{}, fmt.Errorf("load factory owner: %w", err)
+	}
+	return owner, nil
+}
+
+// TransferOwnership transfers ownership of the factory to newOwner, sending the transaction with ownerOpts which
+// must be for the current factory owner.
+func (c *FactoryCore) TransferOwnership(ctx context.Context, ownerOpts *bind.TransactOpts, newOwner common.Address) error {
+	opts := *ownerOpts
+	opts.Context = ctx
+	tx, err := c.factory.TransferOwnership(&opts, newOwner)
+	if err != nil {
+		return fmt.Errorf("transfer factory ownership to %v: %w", newOwner, err)
+	}
+	if _, err := utils.WaitReceiptOK(ctx, c.client, tx.Hash()); err != nil {
+		return fmt.Errorf("wait for transfer ownership receipt to be OK: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package disputegame
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/challenger"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// StartParallelAlphabetGames creates two alphabet games for the same L2 block, and so disputing the same output
+// proposal, one with the invalid root claim from claimedAlphabet and one with the valid root claim.
+func (h *FactoryHelper) StartParallelAlphabetGames(ctx context.Context, claimedAlphabet string) (*AlphabetGameHelper, *AlphabetGameHelper) {
+	h.require.NotEqual(CorrectAlphabet, claimedAlphabet, "first game must have an invalid root claim")
+	invalid := h.StartAlphabetGame(ctx, claimedAlphabet)
+	valid := h.StartHonestAlphabetGame(ctx)
+	h.RequireSameDisputedOutput(ctx, &invalid.FaultGameHelper, &valid.FaultGameHelper)
+	return invalid, valid
+}
+
+// RequireSameDisputedOutput fails the test unless game and other are for the same L2 block and recorded the same
+// output proposals when they were created.
+func (h *FactoryHelper) RequireSameDisputedOutput(ctx context.Context, game *FaultGameHelper, other *FaultGameHelper) {
+	opts := &bind.CallOpts{Context: ctx}
+	h.require.Equal(game.L2BlockNumber(ctx), other.L2BlockNumber(ctx), "games should be for the same L2 block")
+	proposals, err := game.game.Proposals(opts)
+	h.require.NoErrorf(err, "load proposals of game %v", game.addr)
+	otherProposals, err := other.game.Proposals(opts)
+	h.require.NoErrorf(err, "load proposals of game %v", other.addr)
+	h.require.Equal(proposals, otherProposals, "games should dispute the same output")
+}
+
+// RequireParallelDisputesResolveIndependently creates two games disputing the same output, one with an invalid root
+// claim and one with the valid root claim, and starts a single honest challenger, configured by options, playing
+// both. The challenger must counter the invalid root claim and leave the valid one alone. Once L1 time is advanced
+// past the end of the games, the invalid game must resolve with the challenger winning and the valid game with the
+// defender winning, so the outcome of one dispute doesn't affect the other.
+// Fails the test if the helper wasn't created with WithL1TimeAdvancer.
+func (h *FactoryHelper) RequireParallelDisputesResolveIndependently(ctx context.Context, l1Endpoint string, options ...challenger.Option) {
+	h.require.NoError(h.checkTimeControl(), "resolve parallel disputes")
+	invalid, valid := h.StartParallelAlphabetGames(ctx, "abcdexyz")
+	opts := append([]challenger.Option{challenger.WithAdditionalGames(valid.addr)}, options...)
+	invalid.StartHonestChallenger(ctx, l1Endpoint, "Challenger", opts...)
+
+	invalid.RequireAttackedAt(ctx, 0)
+	valid.RequireNotAttackedAt(ctx, 0, 10*time.Second)
+
+	h.AdvanceL1Time(ctx, invalid.GameDuration(ctx))
+	invalid.WaitForGameStatus(ctx, StatusChallengerWins)
+	valid.WaitForGameStatus(ctx, StatusDefenderWins)
+}
@@ -0,0 +1,80 @@
+package disputegame
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// GameParams are the parameters a game is created with. Games created by other actors, such as a challenger that
+// creates games itself, can be compared against the parameters this helper would use for the same output.
+type GameParams struct {
+	GameType  uint8
+	RootClaim common.Hash
+	ExtraData []byte
+}
+
+// Params returns the parameters the game was created with.
+func (g *FaultGameHelper) Params(ctx context.Context) GameParams {
+	opts := &bind.CallOpts{Context: ctx}
+	gameType, err := g.game.GameType(opts)
+	g.require.NoError(err, "load game type")
+	rootClaim, err := g.game.RootClaim(opts)
+	g.require.NoError(err, "load root claim")
+	extraData, err := g.game.ExtraData(opts)
+	g.require.NoError(err, "load extra data")
+	return GameParams{
+		GameType:  gameType,
+		RootClaim: rootClaim,
+		ExtraData: extraData,
+	}
+}
+
+// RequireParams fails the test if the game was not created with gameType, rootClaim and extraData, listing every
+// parameter that differs.
+func (g *FaultGameHelper) RequireParams(ctx context.Context, gameType uint8, rootClaim common.Hash, extraData []byte) {
+	expected := GameParams{
+		GameType:  gameType,
+		RootClaim: rootClaim,
+		ExtraData: extraData,
+	}
+	if diffs := diffGameParams(expected, g.Params(ctx)); len(diffs) > 0 {
+		g.require.Failf("game parameters differ", "game %v:\n%v", g.addr, strings.Join(diffs, "\n"))
+	}
+}
+
+// diffGameParams describes each field of actual that differs from expected. Extra data is also compared by its
+// decoded L2 block number and L1 head where possible, as those are what a reader needs to spot the mismatch.
+func diffGameParams(expected GameParams, actual GameParams) []string {
+	var diffs []string
+	diff := func(field string, expected interface{}, actual interface{}) {
+		diffs = append(diffs, fmt.Sprintf("  %v: expected %v but was %v", field, expected, actual))
+	}
+	if expected.GameType != actual.GameType {
+		diff("game type", expected.GameType, actual.GameType)
+	}
+	if expected.RootClaim != actual.RootClaim {
+		diff("root claim", expected.RootClaim, actual.RootClaim)
+	}
+	if bytes.Equal(expected.ExtraData, actual.ExtraData) {
+		return diffs
+	}
+	diff("extra data", hexutil.Bytes(expected.ExtraData), hexutil.Bytes(actual.ExtraData))
+	expectedL2Block, expectedL1Head, expectedErr := decodeExtraData(expected.ExtraData)
+	actualL2Block, actualL1Head, actualErr := decodeExtraData(actual.ExtraData)
+	if expectedErr != nil || actualErr != nil {
+		return diffs
+	}
+	if expectedL2Block != actualL2Block {
+		diff("L2 block number", expectedL2Block, actualL2Block)
+	}
+	if expectedL1Head != actualL1Head {
+		diff("L1 head", expectedL1Head, actualL1Head)
+	}
+	return diffs
+}
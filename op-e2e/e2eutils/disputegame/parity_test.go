@@ -0,0 +1,37 @@
+package disputegame
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffGameParams(t *testing.T) {
+	expected := GameParams{GameType: cannonGameType, RootClaim: common.Hash{0xaa}, ExtraData: makeExtraData(10)}
+
+	t.Run("Same", func(t *testing.T) {
+		actual := expected
+		actual.ExtraData = makeExtraData(10)
+		require.Empty(t, diffGameParams(expected, actual))
+	})
+
+	t.Run("EachField", func(t *testing.T) {
+		actual := GameParams{GameType: alphabetGameType, RootClaim: common.Hash{0xbb}, ExtraData: makeExtraData(11)}
+		diffs := diffGameParams(expected, actual)
+		require.Len(t, diffs, 4)
+		require.Equal(t, "  game type: expected 1 but was 0", diffs[0])
+		require.Contains(t, diffs[1], "root claim: expected 0xaa00")
+		require.Contains(t, diffs[2], "extra data: expected 0x")
+		require.Equal(t, "  L1 head: expected 10 but was 11", diffs[3])
+	})
+
+	t.Run("UndecodableExtraData", func(t *testing.T) {
+		actual := expected
+		actual.ExtraData = []byte{0x01}
+		diffs := diffGameParams(expected, actual)
+		require.Len(t, diffs, 1)
+		require.Contains(t, diffs[0], "extra data: expected 0x")
+		require.Contains(t, diffs[0], "but was 0x01")
+	})
+}
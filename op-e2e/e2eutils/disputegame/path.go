@@ -0,0 +1,33 @@
+package disputegame
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+)
+
+// ClaimPath renders the position of the claim at claimIdx as the sequence of left (L) and right (R) bisection
+// steps taken from the root claim to reach it, for example "R-L-L". The root claim is rendered as "root".
+func (g *FaultGameHelper) ClaimPath(ctx context.Context, claimIdx int64) string {
+	claim := g.getClaim(ctx, claimIdx)
+	return positionPath(types.NewPositionFromGIndex(claim.Position.Uint64()))
+}
+
+func positionPath(pos types.Position) string {
+	depth := pos.Depth()
+	if depth == 0 {
+		return "root"
+	}
+	index := pos.IndexAtDepth()
+	steps := make([]string, depth)
+	for i := 0; i < depth; i++ {
+		// The most significant bit of the index at depth is the first step from the root.
+		if index&(1<<(depth-1-i)) != 0 {
+			steps[i] = "R"
+		} else {
+			steps[i] = "L"
+		}
+	}
+	return strings.Join(steps, "-")
+}
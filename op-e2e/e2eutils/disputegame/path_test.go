@@ -0,0 +1,30 @@
+package disputegame
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPositionPath(t *testing.T) {
+	root := types.NewPositionFromGIndex(1)
+	tests := []struct {
+		name     string
+		pos      types.Position
+		expected string
+	}{
+		{name: "Root", pos: root, expected: "root"},
+		{name: "AttackRoot", pos: root.Attack(), expected: "L"},
+		{name: "AttackAttack", pos: func() types.Position { p := root.Attack(); return p.Attack() }(), expected: "L-L"},
+		{name: "AttackDefend", pos: func() types.Position { p := root.Attack(); return p.Defend() }(), expected: "R-L"},
+		{name: "DeepRight", pos: types.NewPosition(3, 4), expected: "R-L-L"},
+		{name: "DeepMixed", pos: types.NewPosition(4, 5), expected: "L-R-L-R"},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, positionPath(test.pos))
+		})
+	}
+}
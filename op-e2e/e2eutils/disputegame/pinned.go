@@ -0,0 +1,98 @@
+package disputegame
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/cannon"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/challenger"
+	"github.com/ethereum-optimism/optimism/op-node/testlog"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// PinnedTrace is the honest cannon trace for a game at selected positions, computed before the chain data the trace
+// is derived from may change so the challenger's later claims can be compared against it.
+type PinnedTrace struct {
+	// inputs are the local inputs the trace was computed with, or nil if the trace was read from a fixture.
+	inputs *cannon.Inputs
+	// values are the trace values at each pinned position, keyed by generalized index.
+	values map[uint64]common.Hash
+}
+
+// PinTrace computes the honest trace for the game at positions from the current L1 and L2 chain data.
+// The trace is computed the same way as by a challenger started with StartChallenger, so executes cannon unless the
+// game was created from a fixture.
+func (g *CannonGameHelper) PinTrace(ctx context.Context, l1Endpoint string, l2Endpoint string, positions ...types.Position) *PinnedTrace {
+	ctx, cancel := g.withTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	cfg := &config.Config{L1EthRpc: l1Endpoint}
+	for _, option := range g.defaultChallengerOptions(l2Endpoint) {
+		option(cfg)
+	}
+	pinned := &PinnedTrace{values: make(map[uint64]common.Hash)}
+	var provider *cannon.CannonTraceProvider
+	var err error
+	if cfg.CannonFixtureDir != "" {
+		provider, err = cannon.NewFixtureTraceProvider(cfg)
+		g.require.NoError(err, "create fixture trace provider")
+	} else {
+		logger := testlog.Logger(g.t, log.LvlInfo).New("role", "pinned-trace")
+		provider, err = cannon.NewTraceProvider(ctx, logger, cfg, g.client)
+		g.require.NoError(err, "create cannon trace provider")
+		inputs, err := cannon.ReadInputs(cfg.CannonDatadir)
+		g.require.NoError(err, "read pinned cannon inputs")
+		pinned.inputs = &inputs
+	}
+	for _, pos := range positions {
+		value, err := provider.Get(ctx, pos.TraceIndex(g.maxDepth))
+		g.require.NoErrorf(err, "compute trace at position %v", pos.ToGIndex())
+		pinned.values[pos.ToGIndex()] = value
+	}
+	return pinned
+}
+
+// RequireClaimsMatchPinnedTrace requires that the challenger c used the same local inputs as the pinned trace and
+// that every claim in the game at a pinned position, other than the root claim, has the pinned value.
+// At least one claim must be at a pinned position.
+func (g *CannonGameHelper) RequireClaimsMatchPinnedTrace(ctx context.Context, c *challenger.Helper, pinned *PinnedTrace) {
+	if pinned.inputs != nil {
+		inputs, err := cannon.ReadInputs(c.CannonDatadir())
+		if errors.Is(err, os.ErrNotExist) {
+			g.require.FailNow("challenger did not record its cannon inputs")
+		}
+		g.require.NoError(err, "read challenger cannon inputs")
+		g.require.Equal(*pinned.inputs, inputs, "challenger should use the pinned cannon inputs")
+	}
+	checked, mismatches := pinnedTraceMismatches(g.GetAllClaims(ctx), pinned.values)
+	g.require.NotZerof(checked, "game %v has no claims at pinned positions", g.addr)
+	g.require.Emptyf(mismatches, "claims in game %v do not match the pinned trace", g.addr)
+}
+
+// pinnedTraceMismatches compares every claim other than the root claim that is at a position in values against
+// the pinned value. Returns the number of claims compared and an error for each claim that did not match.
+func pinnedTraceMismatches(claims []ContractClaim, values map[uint64]common.Hash) (int, []error) {
+	checked := 0
+	var mismatches []error
+	for i, claim := range claims {
+		if i == 0 {
+			continue
+		}
+		gindex := claim.Position.Uint64()
+		expected, ok := values[gindex]
+		if !ok {
+			continue
+		}
+		checked++
+		if common.Hash(claim.Claim) != expected {
+			mismatches = append(mismatches, fmt.Errorf("claim %v at position %v has value %v but pinned trace has %v",
+				i, gindex, common.Hash(claim.Claim), expected))
+		}
+	}
+	return checked, mismatches
+}
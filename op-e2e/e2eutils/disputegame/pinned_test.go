@@ -0,0 +1,56 @@
+package disputegame
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPinnedTraceMismatches(t *testing.T) {
+	claimAt := func(pos types.Position, value common.Hash) ContractClaim {
+		return ContractClaim{Claim: value, Position: new(big.Int).SetUint64(pos.ToGIndex())}
+	}
+	root := types.NewPosition(0, 0)
+	first := types.NewPosition(1, 0)
+	deeper := types.NewPosition(3, 0)
+	unpinned := types.NewPosition(2, 0)
+	values := map[uint64]common.Hash{
+		root.ToGIndex():   {0x01},
+		first.ToGIndex():  {0x02},
+		deeper.ToGIndex(): {0x03},
+	}
+
+	t.Run("Match", func(t *testing.T) {
+		claims := []ContractClaim{
+			claimAt(root, common.Hash{0xaa}),
+			claimAt(first, common.Hash{0x02}),
+			claimAt(unpinned, common.Hash{0xbb}),
+			claimAt(deeper, common.Hash{0x03}),
+		}
+		checked, mismatches := pinnedTraceMismatches(claims, values)
+		require.Equal(t, 2, checked, "should skip the root claim and unpinned positions")
+		require.Empty(t, mismatches)
+	})
+
+	t.Run("Mismatch", func(t *testing.T) {
+		claims := []ContractClaim{
+			claimAt(root, common.Hash{0xaa}),
+			claimAt(first, common.Hash{0x02}),
+			claimAt(unpinned, common.Hash{0xbb}),
+			claimAt(deeper, common.Hash{0x04}),
+		}
+		checked, mismatches := pinnedTraceMismatches(claims, values)
+		require.Equal(t, 2, checked)
+		require.Len(t, mismatches, 1)
+		require.ErrorContains(t, mismatches[0], "claim 3")
+	})
+
+	t.Run("NoPinnedClaims", func(t *testing.T) {
+		checked, mismatches := pinnedTraceMismatches([]ContractClaim{claimAt(root, common.Hash{0xaa})}, values)
+		require.Zero(t, checked)
+		require.Empty(t, mismatches)
+	})
+}
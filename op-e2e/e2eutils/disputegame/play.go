@@ -0,0 +1,177 @@
+package disputegame
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/solver"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+type playCfg struct {
+	advancer TimeAdvancer
+}
+
+type PlayOption func(c *playCfg)
+
+// PlayWithTimeAdvancer makes PlayGame time travel L1 to the end of the game rather than waiting for chain time to
+// pass.
+func PlayWithTimeAdvancer(advancer TimeAdvancer) PlayOption {
+	return func(c *playCfg) {
+		c.advancer = advancer
+	}
+}
+
+// PlayGame creates a game of the type whose absolute prestate matches provider, plays it out between two actors until
+// neither has a move left, steps against the claims at the maximum depth the honest actor disputes, then lets the
+// game's clocks expire and resolves it, returning the outcome.
+// The root claim is the final state of provider if honest is true, or a different, invalid claim otherwise. The
+// honest actor plays provider as the correct trace from the helper's account, supporting the root claim if it is
+// honest and disputing it otherwise. The opponent plays from the account of opponentKey and takes the other side,
+// so only the honest actor's steps can settle the game. Other actors started by the test may play too.
+// Steps don't load data into the preimage oracle, so providers whose steps require it are not supported.
+// The final state of the game must pass VerifyGameIntegrity against provider.
+func (h *FactoryHelper) PlayGame(ctx context.Context, provider types.TraceProvider, honest bool, opponentKey *ecdsa.PrivateKey, options ...PlayOption) Status {
+	cfg := &playCfg{}
+	for _, option := range options {
+		option(cfg)
+	}
+	gameType, maxDepth := h.gameTypeFor(ctx, provider)
+	rootClaim, err := provider.Get(ctx, (1<<maxDepth)-1)
+	h.require.NoError(err, "get root claim from trace provider")
+	if !honest {
+		rootClaim[len(rootClaim)-1] ^= 0xff
+	}
+
+	extraData := h.CheckpointedExtraData(ctx)
+	createCtx, cancel := h.withTimeout(ctx, time.Minute)
+	defer cancel()
+	addr, err := h.CreateGame(createCtx, gameType, rootClaim, extraData)
+	h.require.NoErrorf(err, "create game of type %v", gameType)
+	game := h.newGameHelper(createCtx, addr, maxDepth)
+	chainID, err := h.client.ChainID(createCtx)
+	h.require.NoError(err)
+	opponentOpts, err := bind.NewKeyedTransactorWithChainID(opponentKey, chainID)
+	h.require.NoError(err)
+	opponentGame := h.newGameHelperWithOpts(createCtx, addr, maxDepth, opponentOpts)
+
+	duration := game.GameDuration(ctx)
+	end := game.CreatedAt().Timestamp + uint64(duration/time.Second)
+	playCtx, cancel := context.WithTimeout(ctx, duration+h.timeoutScale.apply(2*time.Minute))
+	defer cancel()
+	actors := []*StrategyDriver{
+		newStrategyDriver(&gameStrategyBackend{g: &game}, NewTraceStrategy(maxDepth, provider, honest, NoDelay), h.t.Logf),
+		newStrategyDriver(&gameStrategyBackend{g: &opponentGame}, NewTraceStrategy(maxDepth, provider, !honest, NoDelay), h.t.Logf),
+	}
+	stepped := false
+	advanced := false
+	err = game.waitFor(playCtx, time.Second, func() (bool, error) {
+		if !stepped {
+			moved, err := pollActors(playCtx, actors)
+			if err != nil || moved {
+				// Keep playing until neither side has a move left to make.
+				return false, err
+			}
+			if err := game.stepAgainstLeaves(playCtx, provider, honest); err != nil {
+				return false, err
+			}
+			stepped = true
+		}
+		header, err := game.client.HeaderByNumber(playCtx, nil)
+		if err != nil {
+			return false, fmt.Errorf("load L1 head: %w", err)
+		}
+		if cfg.advancer != nil && !advanced && header.Time <= end {
+			cfg.advancer.AdvanceTime(time.Duration(end-header.Time+1) * time.Second)
+			advanced = true
+		}
+		return header.Time > end, nil
+	})
+	h.require.NoErrorf(err, "play game %v", addr)
+	game.Resolve(ctx)
+	status, err := game.LoadStatus(ctx)
+	h.require.NoError(err)
+	game.RequireValidTree(ctx)
+	game.RequireGameIntegrity(ctx, provider)
+	return status
+}
+
+// pollActors polls each actor in turn and returns true if any of them made a move.
+func pollActors(ctx context.Context, actors []*StrategyDriver) (bool, error) {
+	moved := false
+	for _, actor := range actors {
+		made := len(actor.Made())
+		if _, err := actor.poll(ctx); err != nil {
+			return false, err
+		}
+		moved = moved || len(actor.Made()) > made
+	}
+	return moved, nil
+}
+
+// stepAgainstLeaves steps against every uncountered claim at the maximum depth that an actor playing trace disputes.
+// The actor supports the root claim if supportsRoot is true and disputes it otherwise.
+func (g *FaultGameHelper) stepAgainstLeaves(ctx context.Context, trace types.TraceProvider, supportsRoot bool) error {
+	claims, err := g.LoadClaims(ctx)
+	if err != nil {
+		return err
+	}
+	s := solver.NewSolver(g.maxDepth, trace)
+	for idx, claim := range claims {
+		pos := types.NewPositionFromGIndex(claim.Position.Uint64())
+		// Claims at even depths support the root claim.
+		if pos.Depth() != g.maxDepth || claim.Countered || (pos.Depth()%2 == 0) == supportsRoot {
+			continue
+		}
+		step, err := s.AttemptStep(ctx, types.Claim{
+			ClaimData: types.ClaimData{
+				Value:    claim.Claim,
+				Position: pos,
+			},
+			ContractIndex: idx,
+		}, false)
+		if err != nil {
+			return fmt.Errorf("calculate step against claim %v: %w", idx, err)
+		}
+		if err := g.SendStep(ctx, int64(idx), step.IsAttack, step.PreState, step.ProofData); err != nil {
+			return fmt.Errorf("step against claim %v: %w", idx, err)
+		}
+	}
+	return nil
+}
+
+// gameTypeFor returns the game type and max depth of the registered implementation with the same absolute prestate
+// as provider.
+func (h *FactoryHelper) gameTypeFor(ctx context.Context, provider types.TraceProvider) (uint8, int) {
+	preState, err := provider.AbsolutePreState(ctx)
+	h.require.NoError(err, "get absolute prestate from trace provider")
+	preStateHash := crypto.Keccak256Hash(preState)
+	registry := h.ImplementationRegistry(ctx)
+	gameTypes := make([]uint8, 0, len(registry))
+	for gameType := range registry {
+		gameTypes = append(gameTypes, gameType)
+	}
+	sort.Slice(gameTypes, func(i, j int) bool { return gameTypes[i] < gameTypes[j] })
+	opts := &bind.CallOpts{Context: ctx}
+	for _, gameType := range gameTypes {
+		impl, err := bindings.NewFaultDisputeGameCaller(registry[gameType], h.client)
+		h.require.NoError(err)
+		implPreState, err := impl.ABSOLUTEPRESTATE(opts)
+		h.require.NoErrorf(err, "load absolute prestate of game type %v", gameType)
+		if common.Hash(implPreState) != preStateHash {
+			continue
+		}
+		maxDepth, err := impl.MAXGAMEDEPTH(opts)
+		h.require.NoErrorf(err, "load max depth of game type %v", gameType)
+		return gameType, int(maxDepth.Uint64())
+	}
+	h.require.Failf("no matching game type", "no implementation has absolute prestate %v", preStateHash)
+	return 0, 0
+}
@@ -0,0 +1,42 @@
+package disputegame
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/cannon"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// RequirePrestateStep attacks down from the root claim to the leftmost leaf, at trace index 0, and steps against it.
+// There is no claim committing to the state before trace index 0, so the step must use the game's absolute prestate
+// as its pre-state. Requires the step to be accepted and to counter the leaf.
+// The game must have been created from a cannon fixture, as by StartFixtureCannonGame, which provides the proof.
+func (g *CannonGameHelper) RequirePrestateStep(ctx context.Context) {
+	g.require.NotEmpty(g.fixtureDir, "game must be created from a cannon fixture")
+	claimIdx := int64(0)
+	for i := 1; i <= g.maxDepth; i++ {
+		g.Attack(ctx, claimIdx, common.Hash{0xaa, byte(i)})
+		claimIdx = g.ClaimCount(ctx) - 1
+	}
+	leaf := g.getClaim(ctx, claimIdx)
+	pos := types.NewPositionFromGIndex(leaf.Position.Uint64())
+	g.require.Zero(pos.TraceIndex(g.maxDepth), "leftmost leaf should be at trace index 0")
+
+	provider, err := cannon.NewFixtureTraceProvider(&config.Config{CannonFixtureDir: g.fixtureDir, CannonDatadir: g.t.TempDir()})
+	g.require.NoError(err, "create fixture trace provider")
+	prestate, err := provider.AbsolutePreState(ctx)
+	g.require.NoError(err, "load absolute prestate")
+	gamePrestate, err := g.game.ABSOLUTEPRESTATE(&bind.CallOpts{Context: ctx})
+	g.require.NoError(err, "load absolute prestate of game")
+	g.require.Equal(common.Hash(gamePrestate), crypto.Keccak256Hash(prestate), "fixture prestate should be the game's absolute prestate")
+	_, proof, err := provider.GetPreimage(ctx, 0)
+	g.require.NoError(err, "load proof for first step")
+
+	g.require.NoError(g.TryStep(ctx, claimIdx, true, prestate, proof), "step from absolute prestate should be accepted")
+	g.require.True(g.getClaim(ctx, claimIdx).Countered, "step should counter the leaf")
+	g.AssertStepConsistency(ctx, provider)
+}
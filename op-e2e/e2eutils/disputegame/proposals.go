@@ -0,0 +1,85 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// outputOracle is the part of the L2OutputOracle needed to wait for proposals.
+type outputOracle interface {
+	LatestOutputIndex(opts *bind.CallOpts) (*big.Int, error)
+	StartingBlockNumber(opts *bind.CallOpts) (*big.Int, error)
+	SUBMISSIONINTERVAL(opts *bind.CallOpts) (*big.Int, error)
+}
+
+// WithoutProposalWait stops the helper waiting for output proposals before creating games, for tests that propose
+// the outputs games require themselves.
+func WithoutProposalWait() FactoryOption {
+	return func(h *FactoryHelper) {
+		h.skipProposalWait = true
+	}
+}
+
+// SkipProposalWait stops games created by this factory waiting for output proposals first. WaitForProposals still
+// waits when called directly.
+func (c *FactoryCore) SkipProposalWait() {
+	c.skipProposalWait = true
+}
+
+// waitForProposalsIfRequired calls WaitForProposals unless the wait is skipped.
+func (c *FactoryCore) waitForProposalsIfRequired(ctx context.Context) error {
+	if c.skipProposalWait {
+		return nil
+	}
+	return c.WaitForProposals(ctx)
+}
+
+// outputIndexAfter returns the index of the first output at or after l2BlockNum, given that outputs are proposed
+// every interval blocks after startingBlock.
+func outputIndexAfter(l2BlockNum uint64, startingBlock uint64, interval uint64) uint64 {
+	if l2BlockNum <= startingBlock+interval {
+		return 0
+	}
+	return (l2BlockNum-startingBlock+interval-1)/interval - 1
+}
+
+// requiredOutputIndex returns the latest output index required to create a game disputing the output at target.
+// The game also starts from the output before the disputed one, so at least two outputs are required.
+func requiredOutputIndex(target uint64) uint64 {
+	if target < 1 {
+		return 1
+	}
+	return target
+}
+
+// waitForOutputs waits until oracle has the outputs required to create a game disputing l2BlockNum. The oracle is
+// checked immediately, so no time is spent polling if the outputs have already been proposed.
+func waitForOutputs(ctx context.Context, clk clock.Clock, oracle outputOracle, l2BlockNum uint64) error {
+	opts := &bind.CallOpts{Context: ctx}
+	startingBlock, err := oracle.StartingBlockNumber(opts)
+	if err != nil {
+		return fmt.Errorf("load starting block number: %w", err)
+	}
+	interval, err := oracle.SUBMISSIONINTERVAL(opts)
+	if err != nil {
+		return fmt.Errorf("load submission interval: %w", err)
+	}
+	required := requiredOutputIndex(outputIndexAfter(l2BlockNum, startingBlock.Uint64(), interval.Uint64()))
+	proposed := func() (bool, error) {
+		index, err := oracle.LatestOutputIndex(opts)
+		if err != nil {
+			// The oracle reverts until the first output is proposed so keep waiting.
+			return false, nil
+		}
+		return index.Uint64() >= required, nil
+	}
+	if done, err := proposed(); err != nil || done {
+		return err
+	}
+	return waitFor(ctx, clk, time.Second, proposed)
+}
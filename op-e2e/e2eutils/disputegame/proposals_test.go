@@ -0,0 +1,118 @@
+package disputegame
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/stretchr/testify/require"
+)
+
+type stubOutputOracle struct {
+	startingBlock uint64
+	interval      uint64
+	// latest is the latest output index, or -1 if no outputs have been proposed.
+	latest atomic.Int64
+	calls  atomic.Int64
+}
+
+func newStubOutputOracle(startingBlock uint64, interval uint64, latest int64) *stubOutputOracle {
+	o := &stubOutputOracle{startingBlock: startingBlock, interval: interval}
+	o.latest.Store(latest)
+	return o
+}
+
+func (o *stubOutputOracle) LatestOutputIndex(_ *bind.CallOpts) (*big.Int, error) {
+	o.calls.Add(1)
+	latest := o.latest.Load()
+	if latest < 0 {
+		return nil, errors.New("execution reverted")
+	}
+	return big.NewInt(latest), nil
+}
+
+func (o *stubOutputOracle) StartingBlockNumber(_ *bind.CallOpts) (*big.Int, error) {
+	return new(big.Int).SetUint64(o.startingBlock), nil
+}
+
+func (o *stubOutputOracle) SUBMISSIONINTERVAL(_ *bind.CallOpts) (*big.Int, error) {
+	return new(big.Int).SetUint64(o.interval), nil
+}
+
+func TestOutputIndexAfter(t *testing.T) {
+	tests := []struct {
+		name          string
+		l2BlockNum    uint64
+		startingBlock uint64
+		expected      uint64
+	}{
+		{name: "FirstOutput", l2BlockNum: 2, expected: 0},
+		{name: "BeforeFirstOutput", l2BlockNum: 1, expected: 0},
+		{name: "AtOutput", l2BlockNum: 8, expected: 3},
+		{name: "BetweenOutputs", l2BlockNum: 7, expected: 3},
+		{name: "StartingBlock", l2BlockNum: 12, startingBlock: 2, expected: 4},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, outputIndexAfter(test.l2BlockNum, test.startingBlock, 2))
+		})
+	}
+}
+
+func TestWaitForOutputs(t *testing.T) {
+	t.Run("AlreadyProposed", func(t *testing.T) {
+		// The deterministic clock is never advanced so any wait for a tick would time out.
+		clk := clock.NewDeterministicClock(time.Unix(0, 0))
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		oracle := newStubOutputOracle(0, 2, 3)
+		require.NoError(t, waitForOutputs(ctx, clk, oracle, 8))
+		require.EqualValues(t, 1, oracle.calls.Load())
+	})
+
+	t.Run("DerivedFromDisputedOutput", func(t *testing.T) {
+		// Block 12 is output 5, so six proposals are required.
+		clk := clock.NewDeterministicClock(time.Unix(0, 0))
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		oracle := newStubOutputOracle(0, 2, -1)
+		result := make(chan error, 1)
+		go func() {
+			result <- waitForOutputs(ctx, clk, oracle, 12)
+		}()
+		require.True(t, clk.WaitForNewPendingTaskWithTimeout(5*time.Second), "should poll when outputs are missing")
+
+		for _, latest := range []int64{1, 4} {
+			oracle.latest.Store(latest)
+			calls := oracle.calls.Load()
+			clk.AdvanceTime(time.Second)
+			require.Eventually(t, func() bool { return oracle.calls.Load() > calls }, 5*time.Second, 10*time.Millisecond)
+			require.Empty(t, result, "should wait for output 5 but latest is %v", latest)
+		}
+
+		oracle.latest.Store(5)
+		clk.AdvanceTime(time.Second)
+		require.NoError(t, <-result)
+	})
+
+	t.Run("RequiresStartingOutput", func(t *testing.T) {
+		require.EqualValues(t, 1, requiredOutputIndex(0), "the output before the disputed one is required")
+		require.EqualValues(t, 5, requiredOutputIndex(5))
+	})
+}
+
+func TestSkipProposalWait(t *testing.T) {
+	// Without a skip, the core would fail to load from its nil output oracle.
+	core := &FactoryCore{}
+	core.SkipProposalWait()
+	require.NoError(t, core.waitForProposalsIfRequired(context.Background()))
+
+	h := &FactoryHelper{t: t, require: require.New(t), FactoryCore: core}
+	h.waitForProposals(context.Background())
+}
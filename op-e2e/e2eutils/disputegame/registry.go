@@ -0,0 +1,95 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// unregisteredGameType is a game type that has no implementation registered with the devnet factory.
+const unregisteredGameType uint8 = 99
+
+// noImplementationReason is the revert reason when creating a game of a type with no registered implementation.
+const noImplementationReason = "NoImplementation"
+
+// ImplementationChange is the change to the implementation registered for a game type between two registry snapshots.
+// Old or New is the zero address if the game type was not registered in that snapshot.
+type ImplementationChange struct {
+	Old common.Address
+	New common.Address
+}
+
+// ImplementationRegistry returns the implementation registered with the factory for each game type that has one.
+func (h *FactoryHelper) ImplementationRegistry(ctx context.Context) map[uint8]common.Address {
+	registry, err := h.LoadImplementations(ctx)
+	h.require.NoError(err)
+	return registry
+}
+
+// LoadImplementations returns the implementation registered with the factory for each game type that has one.
+// Every possible game type is checked as the devnet genesis is built from a state dump, so there are no
+// ImplementationSet events to discover registered game types from.
+func (c *FactoryCore) LoadImplementations(ctx context.Context) (map[uint8]common.Address, error) {
+	registry := make(map[uint8]common.Address)
+	for gameType := 0; gameType <= math.MaxUint8; gameType++ {
+		impl, err := c.factory.GameImpls(&bind.CallOpts{Context: ctx}, uint8(gameType))
+		if err != nil {
+			return nil, fmt.Errorf("load implementation for game type %v: %w", gameType, err)
+		}
+		if impl != (common.Address{}) {
+			registry[uint8(gameType)] = impl
+		}
+	}
+	return registry, nil
+}
+
+// TryStartGameWithType attempts to create a game of gameType with rootClaim, returning an error including the revert
+// reason if the factory rejects it. Unlike the other Start methods the game type isn't limited to the known types,
+// so the factory's implementation lookup can be tested.
+func (h *FactoryHelper) TryStartGameWithType(ctx context.Context, gameType uint8, rootClaim common.Hash) error {
+	ctx, cancel := h.withTimeout(ctx, time.Minute)
+	defer cancel()
+	h.waitForProposals(ctx)
+	l1Head := h.checkpointL1Block(ctx)
+	_, err := h.CreateGame(ctx, gameType, rootClaim, makeExtraData(l1Head.Uint64()))
+	if err == nil {
+		return nil
+	}
+	if reason, ok := decodeRevertReason(err); ok {
+		return fmt.Errorf("create game of type %v reverted: %v: %w", gameType, reason, err)
+	}
+	return fmt.Errorf("create game of type %v: %w", gameType, err)
+}
+
+// RequireUnregisteredGameTypeRejected requires that creating a game of a type with no registered implementation
+// reverts with NoImplementation.
+func (h *FactoryHelper) RequireUnregisteredGameTypeRejected(ctx context.Context) {
+	impl, err := h.factory.GameImpls(&bind.CallOpts{Context: ctx}, unregisteredGameType)
+	h.require.NoError(err, "load implementation for game type %v", unregisteredGameType)
+	h.require.Equal(common.Address{}, impl, "game type %v unexpectedly registered", unregisteredGameType)
+
+	err = h.TryStartGameWithType(ctx, unregisteredGameType, common.Hash{0xaa})
+	h.require.Error(err, "creating a game of unregistered type %v should revert", unregisteredGameType)
+	reason, _ := decodeRevertReason(err)
+	h.require.Equal(noImplementationReason, reason, "game creation reverted for the wrong reason")
+}
+
+// DiffImplementationRegistry returns the game types whose implementation differs between before and after.
+func DiffImplementationRegistry(before, after map[uint8]common.Address) map[uint8]ImplementationChange {
+	changes := make(map[uint8]ImplementationChange)
+	for gameType, impl := range before {
+		if after[gameType] != impl {
+			changes[gameType] = ImplementationChange{Old: impl, New: after[gameType]}
+		}
+	}
+	for gameType, impl := range after {
+		if _, ok := before[gameType]; !ok {
+			changes[gameType] = ImplementationChange{New: impl}
+		}
+	}
+	return changes
+}
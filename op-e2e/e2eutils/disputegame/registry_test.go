@@ -0,0 +1,28 @@
+package disputegame
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffImplementationRegistry(t *testing.T) {
+	before := map[uint8]common.Address{
+		0: {0x01},
+		1: {0x02},
+		2: {0x03},
+	}
+	after := map[uint8]common.Address{
+		0: {0x01},
+		1: {0x04},
+		3: {0x05},
+	}
+	require.Equal(t, map[uint8]ImplementationChange{
+		1: {Old: common.Address{0x02}, New: common.Address{0x04}},
+		2: {Old: common.Address{0x03}},
+		3: {New: common.Address{0x05}},
+	}, DiffImplementationRegistry(before, after))
+	require.Empty(t, DiffImplementationRegistry(before, before))
+	require.Empty(t, DiffImplementationRegistry(nil, nil))
+}
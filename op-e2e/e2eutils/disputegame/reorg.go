@@ -0,0 +1,79 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
+	"github.com/ethereum/go-ethereum/common"
+	geth_eth "github.com/ethereum/go-ethereum/eth"
+)
+
+// claimIdentity identifies a claim independently of its index, which may change if the transactions that made
+// claims are re-included in a different order after a reorg.
+type claimIdentity struct {
+	gindex       uint64
+	value        common.Hash
+	parentGIndex uint64
+	parentValue  common.Hash
+}
+
+// identityOf returns the identity of the claim at idx in claims.
+func identityOf(claims []ContractClaim, idx int) claimIdentity {
+	claim := claims[idx]
+	id := claimIdentity{gindex: claim.Position.Uint64(), value: claim.Claim}
+	// The root claim's parent index is out of range.
+	if int(claim.ParentIndex) < len(claims) {
+		parent := claims[claim.ParentIndex]
+		id.parentGIndex = parent.Position.Uint64()
+		id.parentValue = parent.Claim
+	}
+	return id
+}
+
+// missingClaims returns an error for each claim in before that is not present in after. Claims are matched by
+// position, value and the position and value of their parent rather than by index.
+func missingClaims(before []ContractClaim, after []ContractClaim) []error {
+	remaining := make(map[claimIdentity]int, len(after))
+	for i := range after {
+		remaining[identityOf(after, i)]++
+	}
+	var missing []error
+	for i, claim := range before {
+		id := identityOf(before, i)
+		if remaining[id] == 0 {
+			missing = append(missing, fmt.Errorf("claim %v at position %v with value %v is missing",
+				i, claim.Position, common.Hash(claim.Claim)))
+			continue
+		}
+		remaining[id]--
+	}
+	return missing
+}
+
+// RequireClaimsSurviveReorg snapshots the game's claims, then reorgs out the latest depth blocks of the L1 chain run
+// by backend. The transactions from the removed blocks are re-included, so once the game has at least as many claims
+// as before the reorg, every claim from the snapshot must still exist. Claims may have moved to different indices if
+// their transactions were re-included in a different order, and new claims may have been added since.
+func (g *FaultGameHelper) RequireClaimsSurviveReorg(ctx context.Context, backend *geth_eth.Ethereum, depth uint64) {
+	ctx, cancel := g.withTimeout(ctx, 2*time.Minute)
+	defer cancel()
+	before := g.GetAllClaims(ctx)
+	removed, err := e2eutils.ReorgL1(ctx, backend, depth)
+	g.require.NoErrorf(err, "reorg %v L1 blocks", depth)
+	g.t.Logf("Reorged out %v L1 blocks with %v claims in game %v", len(removed), len(before), g.addr)
+
+	var after []ContractClaim
+	err = g.waitFor(ctx, time.Second, func() (bool, error) {
+		claims, err := g.LoadClaims(ctx)
+		if err != nil {
+			// Loading claims fails while the game creation is waiting to be re-included.
+			return false, nil
+		}
+		after = claims
+		return len(after) >= len(before), nil
+	})
+	g.require.NoErrorf(err, "game should have at least %v claims after reorg", len(before))
+	g.require.Emptyf(missingClaims(before, after), "claims lost in reorg of game %v", g.addr)
+}
@@ -0,0 +1,57 @@
+package disputegame
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMissingClaims(t *testing.T) {
+	claim := func(parentIdx uint32, gindex int64, value common.Hash) ContractClaim {
+		return ContractClaim{ParentIndex: parentIdx, Claim: value, Position: big.NewInt(gindex), Clock: big.NewInt(0)}
+	}
+	root := claim(math.MaxUint32, 1, common.Hash{0xaa})
+	before := []ContractClaim{
+		root,
+		claim(0, 2, common.Hash{0xbb}),
+		claim(0, 2, common.Hash{0xcc}),
+		claim(1, 4, common.Hash{0xdd}),
+	}
+
+	t.Run("Unchanged", func(t *testing.T) {
+		require.Empty(t, missingClaims(before, before))
+	})
+
+	t.Run("Reordered", func(t *testing.T) {
+		after := []ContractClaim{
+			root,
+			claim(0, 2, common.Hash{0xcc}),
+			claim(0, 2, common.Hash{0xbb}),
+			claim(2, 4, common.Hash{0xdd}),
+		}
+		require.Empty(t, missingClaims(before, after))
+	})
+
+	t.Run("NewClaims", func(t *testing.T) {
+		after := append(append([]ContractClaim{}, before...), claim(3, 8, common.Hash{0xee}))
+		require.Empty(t, missingClaims(before, after))
+	})
+
+	t.Run("Missing", func(t *testing.T) {
+		after := []ContractClaim{root, claim(0, 2, common.Hash{0xbb}), claim(0, 2, common.Hash{0xcc})}
+		missing := missingClaims(before, after)
+		require.Len(t, missing, 1)
+		require.ErrorContains(t, missing[0], "claim 3")
+	})
+
+	t.Run("DifferentParent", func(t *testing.T) {
+		// The same value at the same position but now countering the other claim at position 2.
+		after := append(append([]ContractClaim{}, before[:3]...), claim(2, 4, common.Hash{0xdd}))
+		missing := missingClaims(before, after)
+		require.Len(t, missing, 1)
+		require.ErrorContains(t, missing[0], "claim 3")
+	})
+}
@@ -0,0 +1,88 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// ResolveResult is the outcome of resolving a game.
+type ResolveResult struct {
+	Status Status
+	// Credits is the credit available to each address after resolution, excluding addresses with no credit.
+	Credits map[common.Address]*big.Int
+	// TotalBonds is the sum of the bonds posted with every claim in the game.
+	TotalBonds *big.Int
+	// TotalCredit is the sum of Credits, which is the bond pool distributed by resolution.
+	TotalCredit *big.Int
+	// GasUsed is the total gas used by the transactions that resolved the game, including resolving each claim.
+	GasUsed uint64
+}
+
+// loadResolveResult loads the outcome of resolving the game with the transactions in receipts.
+// Games that don't support bonds have no credits and zero totals.
+func (g *FaultGameHelper) loadResolveResult(ctx context.Context, receipts []*ethtypes.Receipt) (*ResolveResult, error) {
+	status, err := g.LoadStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := &ResolveResult{
+		Status:      status,
+		Credits:     make(map[common.Address]*big.Int),
+		TotalBonds:  new(big.Int),
+		TotalCredit: new(big.Int),
+	}
+	for _, rcpt := range receipts {
+		result.GasUsed += rcpt.GasUsed
+	}
+	if !g.SupportsBonds(ctx) {
+		return result, nil
+	}
+
+	count, err := g.loadClaimCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Bonds are paid to claimants and to whoever countered a claim, which may be by a step rather than a claim.
+	var recipients []common.Address
+	seen := make(map[common.Address]bool)
+	addRecipient := func(addr common.Address) {
+		if addr != (common.Address{}) && !seen[addr] {
+			seen[addr] = true
+			recipients = append(recipients, addr)
+		}
+	}
+	for i := int64(0); i < count; i++ {
+		claim, err := g.loadBondedClaim(ctx, i)
+		if err != nil {
+			return nil, err
+		}
+		result.TotalBonds.Add(result.TotalBonds, claim.bond)
+		addRecipient(claim.claimant)
+		addRecipient(claim.counteredBy)
+	}
+	for _, recipient := range recipients {
+		credit, err := g.loadCredit(ctx, recipient)
+		if err != nil {
+			return nil, err
+		}
+		if credit.Sign() == 0 {
+			continue
+		}
+		result.Credits[recipient] = credit
+		result.TotalCredit.Add(result.TotalCredit, credit)
+	}
+	return result, nil
+}
+
+func (g *FaultGameHelper) loadClaimCount(ctx context.Context) (int64, error) {
+	count, err := g.game.ClaimDataLen(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return 0, fmt.Errorf("load claim count: %w", err)
+	}
+	return count.Int64(), nil
+}
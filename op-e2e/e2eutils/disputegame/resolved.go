@@ -0,0 +1,74 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// WaitForNewClaims waits until at least n claims have been added to the game since it was called and returns the
+// new claim count. Like WaitForClaimCount, the test fails immediately if the game resolves while waiting.
+func (g *FaultGameHelper) WaitForNewClaims(ctx context.Context, n int64) int64 {
+	start := g.ClaimCount(ctx)
+	ctx, cancel := g.withTimeout(ctx, time.Minute)
+	defer cancel()
+	var actual *big.Int
+	err := g.waitForGameLogs(ctx, time.Second, func() (bool, error) {
+		var err error
+		actual, err = g.game.ClaimDataLen(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return false, err
+		}
+		g.t.Log("Waiting for new claims", "current", actual, "expected", start+n, "game", g.addr)
+		if actual.Int64() >= start+n {
+			return true, nil
+		}
+		return false, g.checkInProgress(ctx)
+	})
+	g.require.NoError(err)
+	return actual.Int64()
+}
+
+// checkInProgress returns an error describing the game's resolution if it is no longer in progress.
+// Waits for new claims use it to fail fast rather than time out when the game's clocks expire and it is resolved.
+func (g *FaultGameHelper) checkInProgress(ctx context.Context) error {
+	status, err := g.LoadStatus(ctx)
+	if err != nil {
+		return err
+	}
+	if status == StatusInProgress {
+		return nil
+	}
+	claims, err := g.LoadClaims(ctx)
+	if err != nil {
+		return err
+	}
+	duration, err := g.game.GAMEDURATION(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("load game duration: %w", err)
+	}
+	return resolvedWhileWaiting(status, claims, time.Duration(duration)*time.Second)
+}
+
+// resolvedWhileWaiting describes a game that resolved with status while a test was waiting for further claims,
+// including how much time each player had left on their clock when the last claim was made.
+func resolvedWhileWaiting(status Status, claims []ContractClaim, gameDuration time.Duration) error {
+	if len(claims) == 0 {
+		return fmt.Errorf("game resolved with status %v while waiting for claims but has no claims", status)
+	}
+	lastIdx := len(claims) - 1
+	last := claims[lastIdx]
+	// Each player has half the game duration. The opponent's clock was last updated by the claim being countered.
+	allowance := gameDuration / 2
+	var opponentUsed time.Duration
+	if lastIdx != 0 {
+		opponentUsed = claims[last.ParentIndex].ClockDuration()
+	}
+	return fmt.Errorf("game resolved with status %v while waiting for claims: last claim %v made at %v, "+
+		"claimant had %v remaining (used %v), opponent had %v remaining (used %v) of %v each",
+		status, lastIdx, last.ClockTimestamp(),
+		allowance-last.ClockDuration(), last.ClockDuration(), allowance-opponentUsed, opponentUsed, allowance)
+}
@@ -0,0 +1,51 @@
+package disputegame
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolvedWhileWaiting(t *testing.T) {
+	// A tiny game duration gives each player just 2s.
+	const gameDuration = 4 * time.Second
+	claimWithClock := func(parentIdx uint32, duration uint64, timestamp uint64) ContractClaim {
+		clock := new(big.Int).Lsh(new(big.Int).SetUint64(duration), 64)
+		return ContractClaim{
+			ParentIndex: parentIdx,
+			Position:    big.NewInt(1),
+			Clock:       clock.Or(clock, new(big.Int).SetUint64(timestamp)),
+		}
+	}
+
+	t.Run("NoClaims", func(t *testing.T) {
+		err := resolvedWhileWaiting(StatusDefenderWins, nil, gameDuration)
+		require.ErrorContains(t, err, "Defender Wins")
+	})
+
+	t.Run("RootOnly", func(t *testing.T) {
+		claims := []ContractClaim{claimWithClock(math.MaxUint32, 0, 1000)}
+		err := resolvedWhileWaiting(StatusDefenderWins, claims, gameDuration)
+		require.EqualError(t, err, "game resolved with status Defender Wins while waiting for claims: last claim 0 made at 1000, "+
+			"claimant had 2s remaining (used 0s), opponent had 2s remaining (used 0s) of 2s each")
+	})
+
+	t.Run("CounterClaims", func(t *testing.T) {
+		claims := []ContractClaim{
+			claimWithClock(math.MaxUint32, 0, 1000),
+			claimWithClock(0, 1, 1001),
+			claimWithClock(1, 2, 1003),
+		}
+		err := resolvedWhileWaiting(StatusChallengerWins, claims, gameDuration)
+		require.EqualError(t, err, "game resolved with status Challenger Wins while waiting for claims: last claim 2 made at 1003, "+
+			"claimant had 0s remaining (used 2s), opponent had 1s remaining (used 1s) of 2s each")
+	})
+}
+
+func TestRequiredGameDuration(t *testing.T) {
+	require.Equal(t, 6*time.Second, RequiredGameDuration(3, time.Second))
+	require.Equal(t, time.Duration(0), RequiredGameDuration(0, time.Minute))
+}
@@ -0,0 +1,67 @@
+package disputegame
+
+import (
+	"context"
+	"math"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+)
+
+// ResolvingClaim returns the index and data of the claim that determined the game's final status, which must
+// already be resolved. The contract doesn't record the claim, so it is derived from the claim tree:
+//   - for game versions that support subgame resolution, it is the claim that won the root claim's subgame, which is
+//     the root claim itself if the defender won.
+//   - for older versions, it is the leftmost uncountered claim, as found by resolve. The defender wins if it is at an
+//     even depth.
+//
+// Fails the test if the status the claim implies doesn't match the game's status.
+func (g *FaultGameHelper) ResolvingClaim(ctx context.Context) (int64, ContractClaim) {
+	status, err := g.LoadStatus(ctx)
+	g.require.NoError(err, "load game status")
+	g.require.NotEqualf(StatusInProgress, status, "game %v has not been resolved", g.addr)
+	claims, err := g.LoadClaims(ctx)
+	g.require.NoError(err, "load claims")
+
+	var idx int64
+	var implied Status
+	if g.SupportsSubgameResolution(ctx) {
+		idx, implied = rootSubgameResolution(claims, g.maxDepth)
+	} else {
+		idx, implied = leftmostUncounteredResolution(claims, g.maxDepth)
+	}
+	g.require.Equalf(status, implied, "game %v resolved as %v but claim %v implies %v", g.addr, status, idx, implied)
+	return idx, claims[idx]
+}
+
+// rootSubgameResolution returns the claim that wins the root claim's subgame and the status that implies.
+func rootSubgameResolution(claims []ContractClaim, maxDepth int) (int64, Status) {
+	winner := subgameWinners(claims, maxDepth)[0]
+	if winner == 0 {
+		return 0, StatusDefenderWins
+	}
+	return winner, StatusChallengerWins
+}
+
+// leftmostUncounteredResolution returns the leftmost uncountered claim and the status that implies, following the
+// search resolve performs for games without subgame resolution. Claims are searched from the most recent, so of
+// uncountered claims with the same trace index the most recent is chosen. If every claim is countered the most
+// recent claim is returned and the challenger wins.
+func leftmostUncounteredResolution(claims []ContractClaim, maxDepth int) (int64, Status) {
+	leftmostIdx := int64(len(claims) - 1)
+	leftmostTraceIdx := uint64(math.MaxUint64)
+	for i := len(claims) - 1; i >= 0; i-- {
+		if claims[i].Countered {
+			continue
+		}
+		pos := types.NewPositionFromGIndex(claims[i].Position.Uint64())
+		if traceIdx := pos.TraceIndex(maxDepth); traceIdx < leftmostTraceIdx {
+			leftmostTraceIdx = traceIdx
+			leftmostIdx = int64(i)
+		}
+	}
+	pos := types.NewPositionFromGIndex(claims[leftmostIdx].Position.Uint64())
+	if pos.Depth()%2 == 0 && leftmostTraceIdx != math.MaxUint64 {
+		return leftmostIdx, StatusDefenderWins
+	}
+	return leftmostIdx, StatusChallengerWins
+}
@@ -0,0 +1,71 @@
+package disputegame
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolvingClaim(t *testing.T) {
+	const maxDepth = 2
+	root := uint32(math.MaxUint32)
+	claimAt := func(parent uint32, gindex uint64, countered bool) ContractClaim {
+		return ContractClaim{ParentIndex: parent, Countered: countered, Position: new(big.Int).SetUint64(gindex), Clock: big.NewInt(0)}
+	}
+
+	t.Run("UncounteredRoot", func(t *testing.T) {
+		claims := []ContractClaim{claimAt(root, 1, false)}
+		idx, status := leftmostUncounteredResolution(claims, maxDepth)
+		require.Equal(t, int64(0), idx)
+		require.Equal(t, StatusDefenderWins, status)
+		idx, status = rootSubgameResolution(claims, maxDepth)
+		require.Equal(t, int64(0), idx)
+		require.Equal(t, StatusDefenderWins, status)
+	})
+
+	t.Run("UncounteredAttack", func(t *testing.T) {
+		claims := []ContractClaim{claimAt(root, 1, true), claimAt(0, 2, false)}
+		idx, status := leftmostUncounteredResolution(claims, maxDepth)
+		require.Equal(t, int64(1), idx)
+		require.Equal(t, StatusChallengerWins, status)
+		idx, status = rootSubgameResolution(claims, maxDepth)
+		require.Equal(t, int64(1), idx)
+		require.Equal(t, StatusChallengerWins, status)
+	})
+
+	t.Run("LeftmostUncounteredDecides", func(t *testing.T) {
+		claims := []ContractClaim{
+			claimAt(root, 1, true),
+			claimAt(0, 2, true),
+			claimAt(1, 6, false), // Defends claim 1, at an even depth
+			claimAt(1, 4, false), // Attacks claim 1, so is left of the defense
+		}
+		idx, status := leftmostUncounteredResolution(claims, maxDepth)
+		require.Equal(t, int64(3), idx)
+		require.Equal(t, StatusDefenderWins, status)
+	})
+
+	t.Run("SubgameWinnerCountersRoot", func(t *testing.T) {
+		claims := []ContractClaim{
+			claimAt(root, 1, true),
+			claimAt(0, 2, true),
+			claimAt(1, 4, false),
+		}
+		idx, status := rootSubgameResolution(claims, maxDepth)
+		require.Equal(t, int64(0), idx)
+		require.Equal(t, StatusDefenderWins, status)
+	})
+
+	t.Run("AllCountered", func(t *testing.T) {
+		claims := []ContractClaim{
+			claimAt(root, 1, true),
+			claimAt(0, 2, true),
+			claimAt(1, 4, true), // Stepped
+		}
+		idx, status := leftmostUncounteredResolution(claims, maxDepth)
+		require.Equal(t, int64(2), idx)
+		require.Equal(t, StatusChallengerWins, status)
+	})
+}
@@ -0,0 +1,98 @@
+package disputegame
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+)
+
+// isAttack reports whether child attacks parent, by comparing the child's position to the parent's. An attack moves
+// to the left child of the parent's position, while a defense moves to the left child of the position to its right.
+func isAttack(parent ContractClaim, child ContractClaim) (bool, error) {
+	parentPos := types.NewPositionFromGIndex(parent.Position.Uint64())
+	childPos := types.NewPositionFromGIndex(child.Position.Uint64())
+	if childPos == parentPos.Attack() {
+		return true, nil
+	}
+	if !parentPos.IsRootPosition() && childPos == parentPos.Defend() {
+		return false, nil
+	}
+	return false, fmt.Errorf("claim at position %v is neither an attack nor defense of position %v",
+		childPos.ToGIndex(), parentPos.ToGIndex())
+}
+
+// waitForResponse waits for a claim to be made against the claim at parentIdx and reports whether it is an attack.
+func (g *FaultGameHelper) waitForResponse(ctx context.Context, parentIdx int64) bool {
+	parent := g.getClaim(ctx, parentIdx)
+	var child ContractClaim
+	g.WaitForClaim(ctx, func(claim ContractClaim) bool {
+		if int64(claim.ParentIndex) != parentIdx {
+			return false
+		}
+		child = claim
+		return true
+	})
+	attack, err := isAttack(parent, child)
+	g.require.NoError(err)
+	return attack
+}
+
+// waitForClaimIndex waits for a claim matching predicate and returns the index of the first one.
+func (g *FaultGameHelper) waitForClaimIndex(ctx context.Context, predicate func(claim ContractClaim) bool) int64 {
+	var idx int64
+	err := g.waitFor(ctx, time.Second, func() (bool, error) {
+		claims, err := g.LoadClaims(ctx)
+		if err != nil {
+			return false, err
+		}
+		for i, claim := range claims {
+			if predicate(claim) {
+				idx = int64(i)
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	g.require.NoError(err, "wait for claim")
+	return idx
+}
+
+// RequireAttackedAt waits for a claim to be made against the claim at parentIdx and requires that it is an attack,
+// not a defense.
+func (g *FaultGameHelper) RequireAttackedAt(ctx context.Context, parentIdx int64) {
+	g.require.Truef(g.waitForResponse(ctx, parentIdx), "claim %v should have been attacked but was defended", parentIdx)
+}
+
+// RequireNotAttackedAt requires that the claim at parentIdx is not attacked for the specified duration. The claim
+// may be defended or left unanswered.
+func (g *FaultGameHelper) RequireNotAttackedAt(ctx context.Context, parentIdx int64, duration time.Duration) {
+	parent := g.getClaim(ctx, parentIdx)
+	ctx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+	err := g.waitFor(ctx, time.Second, func() (bool, error) {
+		claims, err := g.LoadClaims(ctx)
+		if err != nil {
+			return false, err
+		}
+		for i, claim := range claims {
+			if int64(claim.ParentIndex) != parentIdx {
+				continue
+			}
+			attack, err := isAttack(parent, claim)
+			if err != nil {
+				return false, err
+			}
+			if attack {
+				return false, fmt.Errorf("claim %v was attacked by claim %v", parentIdx, i)
+			}
+		}
+		return false, nil
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		return
+	}
+	g.require.NoError(err)
+}
@@ -0,0 +1,41 @@
+package disputegame
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsAttack(t *testing.T) {
+	claimAt := func(pos types.Position) ContractClaim {
+		return ContractClaim{ParentIndex: math.MaxUint32, Position: new(big.Int).SetUint64(pos.ToGIndex())}
+	}
+	root := types.NewPositionFromGIndex(1)
+	parent := root.Attack()
+
+	t.Run("Attack", func(t *testing.T) {
+		attack, err := isAttack(claimAt(parent), claimAt(parent.Attack()))
+		require.NoError(t, err)
+		require.True(t, attack)
+	})
+
+	t.Run("Defend", func(t *testing.T) {
+		attack, err := isAttack(claimAt(parent), claimAt(parent.Defend()))
+		require.NoError(t, err)
+		require.False(t, attack)
+	})
+
+	t.Run("AttackRoot", func(t *testing.T) {
+		attack, err := isAttack(claimAt(root), claimAt(root.Attack()))
+		require.NoError(t, err)
+		require.True(t, attack)
+	})
+
+	t.Run("NotAChild", func(t *testing.T) {
+		_, err := isAttack(claimAt(parent), claimAt(types.NewPosition(3, 0)))
+		require.ErrorContains(t, err, "neither an attack nor defense")
+	})
+}
@@ -0,0 +1,67 @@
+package disputegame
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// newerGameErrors lists custom errors added by game versions newer than the generated bindings, so that their
+// reverts can still be reported by name.
+var newerGameErrors = []string{
+	"OutOfOrderResolution()",
+	"ClaimAlreadyResolved()",
+}
+
+// extractRevertData returns the revert data included in err, if err is a revert reported by the RPC node.
+func extractRevertData(err error) ([]byte, bool) {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return nil, false
+	}
+	revertData, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return nil, true
+	}
+	decoded, err := hexutil.Decode(revertData)
+	if err != nil {
+		return nil, true
+	}
+	return decoded, true
+}
+
+// decodeRevertReason returns a human-readable reason for the revert described by err.
+// Custom errors from the game, factory and block oracle contracts are reported by name and require/revert strings by
+// their message.
+func decodeRevertReason(err error) (string, bool) {
+	data, ok := extractRevertData(err)
+	if !ok || len(data) < 4 {
+		return "", false
+	}
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason, true
+	}
+	for _, metadata := range []*bind.MetaData{bindings.FaultDisputeGameMetaData, bindings.DisputeGameFactoryMetaData, bindings.BlockOracleMetaData} {
+		contractAbi, err := metadata.GetAbi()
+		if err != nil {
+			continue
+		}
+		for name, customErr := range contractAbi.Errors {
+			if string(customErr.ID[:4]) == string(data[:4]) {
+				return name, true
+			}
+		}
+	}
+	for _, sig := range newerGameErrors {
+		if string(crypto.Keccak256([]byte(sig))[:4]) == string(data[:4]) {
+			return sig[:len(sig)-2], true
+		}
+	}
+	return fmt.Sprintf("unknown error %x", data[:4]), true
+}
@@ -0,0 +1,82 @@
+package disputegame
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+type stubDataError struct {
+	data interface{}
+}
+
+func (e stubDataError) Error() string {
+	return "execution reverted"
+}
+
+func (e stubDataError) ErrorData() interface{} {
+	return e.data
+}
+
+func TestDecodeRevertReason(t *testing.T) {
+	selector := func(sig string) string {
+		return hexutil.Encode(crypto.Keccak256([]byte(sig))[:4])
+	}
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+		ok       bool
+	}{
+		{name: "NotRevert", err: errors.New("connection refused")},
+		{name: "NoData", err: stubDataError{data: nil}},
+		{name: "EmptyData", err: stubDataError{data: "0x"}},
+		{name: "KnownCustomError", err: stubDataError{data: selector("ClockNotExpired()")}, expected: "ClockNotExpired", ok: true},
+		{name: "BlockOracleError", err: stubDataError{data: selector("BlockHashNotPresent()")}, expected: "BlockHashNotPresent", ok: true},
+		{name: "NewerCustomError", err: stubDataError{data: selector("OutOfOrderResolution()")}, expected: "OutOfOrderResolution", ok: true},
+		{name: "UnknownCustomError", err: stubDataError{data: "0x12345678"}, expected: "unknown error 12345678", ok: true},
+		{
+			name: "RevertString",
+			// Error("boom")
+			err:      stubDataError{data: "0x08c379a0" + "0000000000000000000000000000000000000000000000000000000000000020" + "0000000000000000000000000000000000000000000000000000000000000004" + "626f6f6d00000000000000000000000000000000000000000000000000000000"},
+			expected: "boom",
+			ok:       true,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			reason, ok := decodeRevertReason(test.err)
+			require.Equal(t, test.ok, ok)
+			require.Equal(t, test.expected, reason)
+		})
+	}
+}
+
+func TestExtractRevertData(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected []byte
+		ok       bool
+	}{
+		{name: "NotRevert", err: errors.New("connection refused")},
+		{name: "NoData", err: stubDataError{data: nil}, ok: true},
+		{name: "EmptyData", err: stubDataError{data: "0x"}, expected: []byte{}, ok: true},
+		{name: "InvalidHex", err: stubDataError{data: "0xzz"}, ok: true},
+		{name: "CustomError", err: stubDataError{data: "0x0ea2e752"}, expected: []byte{0x0e, 0xa2, 0xe7, 0x52}, ok: true},
+		{name: "Wrapped", err: fmt.Errorf("call: %w", stubDataError{data: "0x01"}), expected: []byte{0x01}, ok: true},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			data, ok := extractRevertData(test.err)
+			require.Equal(t, test.ok, ok)
+			require.Equal(t, test.expected, data)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package disputegame
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// WithRPCCounting makes the helper send all its requests to the HTTP RPC endpoint and count the number of calls
+// made to each method, which can then be read with RPCStats.
+// HTTP doesn't support subscriptions so waiting for game events falls back to polling.
+func WithRPCCounting(endpoint string) FactoryOption {
+	return func(h *FactoryHelper) {
+		h.rpcCountingEndpoint = endpoint
+	}
+}
+
+// RPCStats returns the number of RPC calls made by the helper and the games it created, keyed by method name.
+// Each request in a batch is counted separately. Requires the helper to be created with WithRPCCounting.
+func (h *FactoryHelper) RPCStats() map[string]int {
+	h.require.NotNil(h.rpcCounter, "RPC counting not enabled")
+	return h.rpcCounter.Stats()
+}
+
+// ResetRPCStats clears the RPC call counts so the calls made by a single operation can be measured.
+func (h *FactoryHelper) ResetRPCStats() {
+	h.require.NotNil(h.rpcCounter, "RPC counting not enabled")
+	h.rpcCounter.Reset()
+}
+
+// dialCountingClient connects to the HTTP RPC endpoint with every request counted by counter.
+func dialCountingClient(ctx context.Context, endpoint string, counter *rpcCounter) (*ethclient.Client, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parse endpoint %v: %w", endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("RPC counting requires an HTTP endpoint but got %v", endpoint)
+	}
+	rpcClient, err := rpc.DialOptions(ctx, endpoint, rpc.WithHTTPClient(&http.Client{Transport: counter}))
+	if err != nil {
+		return nil, fmt.Errorf("dial %v: %w", endpoint, err)
+	}
+	return ethclient.NewClient(rpcClient), nil
+}
+
+// rpcCounter is a http.RoundTripper that counts the JSON-RPC methods called in each request before forwarding it.
+type rpcCounter struct {
+	base http.RoundTripper
+
+	lock   sync.Mutex
+	counts map[string]int
+}
+
+func newRPCCounter(base http.RoundTripper) *rpcCounter {
+	return &rpcCounter{
+		base:   base,
+		counts: make(map[string]int),
+	}
+}
+
+func (c *rpcCounter) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("read request body: %w", err)
+		}
+		c.record(body)
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	return c.base.RoundTrip(req)
+}
+
+type rpcMethod struct {
+	Method string `json:"method"`
+}
+
+// record counts the methods in a single or batch JSON-RPC request body.
+// Bodies that can't be decoded are ignored so the server can report the error.
+func (c *rpcCounter) record(body []byte) {
+	var msgs []rpcMethod
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		if err := json.Unmarshal(body, &msgs); err != nil {
+			return
+		}
+	} else {
+		var msg rpcMethod
+		if err := json.Unmarshal(body, &msg); err != nil {
+			return
+		}
+		msgs = append(msgs, msg)
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, msg := range msgs {
+		c.counts[msg.Method]++
+	}
+}
+
+func (c *rpcCounter) Stats() map[string]int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stats := make(map[string]int, len(c.counts))
+	for method, count := range c.counts {
+		stats[method] = count
+	}
+	return stats
+}
+
+func (c *rpcCounter) Reset() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.counts = make(map[string]int)
+}
@@ -0,0 +1,48 @@
+package disputegame
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type countedService struct{}
+
+func (countedService) Echo(s string) string {
+	return s
+}
+
+func TestRPCCounter(t *testing.T) {
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("test", countedService{}))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	t.Cleanup(server.Stop)
+
+	counter := newRPCCounter(http.DefaultTransport)
+	client, err := rpc.DialOptions(context.Background(), httpServer.URL, rpc.WithHTTPClient(&http.Client{Transport: counter}))
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+
+	var result string
+	require.NoError(t, client.Call(&result, "test_echo", "a"))
+	require.Equal(t, "a", result)
+	require.Error(t, client.Call(&result, "test_missing"))
+	require.NoError(t, client.BatchCall([]rpc.BatchElem{
+		{Method: "test_echo", Args: []interface{}{"b"}, Result: &result},
+		{Method: "test_echo", Args: []interface{}{"c"}, Result: &result},
+	}))
+	require.Equal(t, map[string]int{"test_echo": 3, "test_missing": 1}, counter.Stats())
+
+	counter.Reset()
+	require.Empty(t, counter.Stats())
+}
+
+func TestDialCountingClientRequiresHTTP(t *testing.T) {
+	_, err := dialCountingClient(context.Background(), "ws://127.0.0.1:0", newRPCCounter(http.DefaultTransport))
+	require.ErrorContains(t, err, "requires an HTTP endpoint")
+}
@@ -0,0 +1,62 @@
+package disputegame
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// ScannedTx is a transaction included in L1 since the game was created, along with its sender and receipt.
+type ScannedTx struct {
+	Tx      *ethtypes.Transaction
+	From    common.Address
+	Receipt *ethtypes.Receipt
+}
+
+// forEachGameTx calls fn for each successful transaction sent directly to the game contract, in the order they
+// were included.
+func (g *FaultGameHelper) forEachGameTx(ctx context.Context, fn func(tx *ethtypes.Transaction)) {
+	g.scanTxs(ctx, func(tx *ethtypes.Transaction, _ common.Address) bool {
+		return tx.To() != nil && *tx.To() == g.addr
+	}, func(scanned ScannedTx) {
+		if scanned.Receipt.Status == ethtypes.ReceiptStatusSuccessful {
+			fn(scanned.Tx)
+		}
+	})
+}
+
+// forEachTxFrom calls fn for each transaction sent by sender since the game was created, whether or not it was
+// successful, in the order they were included.
+func (g *FaultGameHelper) forEachTxFrom(ctx context.Context, sender common.Address, fn func(scanned ScannedTx)) {
+	g.scanTxs(ctx, func(_ *ethtypes.Transaction, from common.Address) bool {
+		return from == sender
+	}, fn)
+}
+
+// scanTxs calls fn for each transaction included in L1 from the block the game was created in up to the current
+// head that matches filter, in the order they were included. Receipts are only loaded for matching transactions.
+func (g *FaultGameHelper) scanTxs(ctx context.Context, filter func(tx *ethtypes.Transaction, from common.Address) bool, fn func(scanned ScannedTx)) {
+	chainID, err := g.client.ChainID(ctx)
+	g.require.NoError(err, "load L1 chain ID")
+	signer := ethtypes.LatestSignerForChainID(chainID)
+	head, err := g.client.BlockNumber(ctx)
+	g.require.NoError(err, "load L1 head")
+
+	start := g.creation.BlockNumber
+	for num := start; num <= head; num++ {
+		block, err := g.client.BlockByNumber(ctx, new(big.Int).SetUint64(num))
+		g.require.NoErrorf(err, "load L1 block %v", num)
+		for _, tx := range block.Transactions() {
+			from, err := ethtypes.Sender(signer, tx)
+			g.require.NoErrorf(err, "recover sender of tx %v", tx.Hash())
+			if !filter(tx, from) {
+				continue
+			}
+			rcpt, err := g.client.TransactionReceipt(ctx, tx.Hash())
+			g.require.NoErrorf(err, "load receipt for tx %v", tx.Hash())
+			fn(ScannedTx{Tx: tx, From: from, Receipt: rcpt})
+		}
+	}
+}
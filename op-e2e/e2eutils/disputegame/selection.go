@@ -0,0 +1,37 @@
+package disputegame
+
+import (
+	"context"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/challenger"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RequireChallengerSelectsGameType creates an alphabet game alongside cannonGame and starts a challenger for
+// cannonGame that also monitors the alphabet game but is filtered to only act on cannon games. Both games are then
+// attacked with an invalid claim. It requires that the challenger counters the claim in the cannon game but never
+// sends a transaction to the alphabet game.
+func (h *FactoryHelper) RequireChallengerSelectsGameType(ctx context.Context, cannonGame *CannonGameHelper, l1Endpoint string, l2Endpoint string, options ...challenger.Option) {
+	alphabetGame := h.StartAlphabetGame(ctx, "abcdexyz")
+	opts := []challenger.Option{
+		challenger.WithAdditionalGames(alphabetGame.addr),
+		challenger.WithGameTypes(cannonGameType),
+	}
+	c := cannonGame.StartChallenger(ctx, l1Endpoint, l2Endpoint, "CannonOnly", append(opts, options...)...)
+
+	alphabetGame.Attack(ctx, 0, common.Hash{0xaa})
+	claimCount, err := cannonGame.loadClaimCount(ctx)
+	h.require.NoError(err)
+	cannonGame.Attack(ctx, 0, common.Hash{0xaa})
+	// The alphabet game was attacked first so the challenger has seen it by the time it counters the cannon game.
+	cannonGame.WaitForClaimCount(ctx, claimCount+2)
+
+	alphabetGame.forEachTxFrom(ctx, c.Address(), func(scanned ScannedTx) {
+		to := scanned.Tx.To()
+		h.require.Falsef(to != nil && *to == alphabetGame.addr,
+			"challenger sent tx %v to alphabet game %v", scanned.Tx.Hash(), alphabetGame.addr)
+	})
+	alphabetClaims, err := alphabetGame.loadClaimCount(ctx)
+	h.require.NoError(err)
+	h.require.EqualValues(2, alphabetClaims, "alphabet game should only have the root claim and the test's attack")
+}
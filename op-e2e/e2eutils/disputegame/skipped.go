@@ -0,0 +1,52 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RequireNoClaimSkipped fails the test unless every claim not made by honest, including the root claim, was
+// countered by honest before the game expired. Claims at the maximum depth must have been countered by a step.
+// Only use it in games where every claim by another claimant is invalid.
+func (g *FaultGameHelper) RequireNoClaimSkipped(ctx context.Context, honest common.Address) {
+	skipped, err := findSkippedClaims(g.GetAllClaims(ctx), g.Moves(ctx), honest, g.maxDepth, g.Expiry(ctx))
+	g.require.NoError(err)
+	g.require.Emptyf(skipped, "%v did not counter every claim in game %v", honest, g.addr)
+}
+
+// findSkippedClaims returns an error for each claim not made by honest that honest did not counter before expiry.
+// moves must be the game's moves in order, so moves[i] created claims[i+1]. The root claim is never made by honest.
+func findSkippedClaims(claims []ContractClaim, moves []GameMove, honest common.Address, maxDepth int, expiry uint64) ([]error, error) {
+	if len(moves) != len(claims)-1 {
+		return nil, fmt.Errorf("game has %v claims but %v moves", len(claims), len(moves))
+	}
+	byHonest := func(i int) bool {
+		return i > 0 && moves[i-1].Claimant == honest
+	}
+	countered := make(map[int]bool)
+	for i := 1; i < len(claims); i++ {
+		if byHonest(i) && moves[i-1].Timestamp <= expiry {
+			countered[int(claims[i].ParentIndex)] = true
+		}
+	}
+	var skipped []error
+	for i, claim := range claims {
+		if byHonest(i) {
+			continue
+		}
+		pos := types.NewPositionFromGIndex(claim.Position.Uint64())
+		depth := pos.Depth()
+		if depth == maxDepth {
+			if !claim.Countered {
+				skipped = append(skipped, fmt.Errorf("claim %v at max depth was not stepped on", i))
+			}
+		} else if !countered[i] {
+			skipped = append(skipped, fmt.Errorf("claim %v at depth %v with value %v was not countered before expiry",
+				i, depth, common.Hash(claim.Claim)))
+		}
+	}
+	return skipped, nil
+}
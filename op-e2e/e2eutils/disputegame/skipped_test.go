@@ -0,0 +1,69 @@
+package disputegame
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindSkippedClaims(t *testing.T) {
+	claim := func(parentIdx uint32, gindex int64, countered bool) ContractClaim {
+		return ContractClaim{ParentIndex: parentIdx, Claim: common.Hash{byte(gindex)}, Position: big.NewInt(gindex), Countered: countered, Clock: big.NewInt(0)}
+	}
+	honest := common.Address{0xaa}
+	dishonest := common.Address{0xbb}
+	const maxDepth = 2
+	const expiry = 100
+	// The root is attacked by honest, then dishonest attacks that and honest attacks again at max depth.
+	claims := []ContractClaim{
+		claim(math.MaxUint32, 1, false),
+		claim(0, 2, false),
+		claim(1, 4, false),
+		claim(2, 8, false),
+	}
+
+	t.Run("AllCountered", func(t *testing.T) {
+		moves := []GameMove{{Claimant: honest, Timestamp: 10}, {Claimant: dishonest, Timestamp: 20}, {Claimant: honest, Timestamp: 30}}
+		skipped, err := findSkippedClaims(claims, moves, honest, 3, expiry)
+		require.NoError(t, err)
+		require.Empty(t, skipped)
+	})
+
+	t.Run("CounteredAfterExpiry", func(t *testing.T) {
+		moves := []GameMove{{Claimant: honest, Timestamp: 10}, {Claimant: dishonest, Timestamp: 20}, {Claimant: honest, Timestamp: expiry + 1}}
+		skipped, err := findSkippedClaims(claims, moves, honest, 3, expiry)
+		require.NoError(t, err)
+		require.Len(t, skipped, 1)
+		require.ErrorContains(t, skipped[0], "claim 2 at depth 2")
+	})
+
+	t.Run("RootNotCountered", func(t *testing.T) {
+		moves := []GameMove{{Claimant: dishonest, Timestamp: 10}, {Claimant: dishonest, Timestamp: 20}, {Claimant: honest, Timestamp: 30}}
+		skipped, err := findSkippedClaims(claims, moves, honest, 3, expiry)
+		require.NoError(t, err)
+		require.Len(t, skipped, 2)
+		require.ErrorContains(t, skipped[0], "claim 0 at depth 0")
+		require.ErrorContains(t, skipped[1], "claim 1 at depth 1")
+	})
+
+	t.Run("MaxDepthMustBeStepped", func(t *testing.T) {
+		moves := []GameMove{{Claimant: honest, Timestamp: 10}, {Claimant: dishonest, Timestamp: 20}}
+		skipped, err := findSkippedClaims(claims[:3], moves, honest, maxDepth, expiry)
+		require.NoError(t, err)
+		require.Len(t, skipped, 1)
+		require.ErrorContains(t, skipped[0], "claim 2 at max depth was not stepped on")
+
+		stepped := []ContractClaim{claims[0], claims[1], claim(1, 4, true)}
+		skipped, err = findSkippedClaims(stepped, moves, honest, maxDepth, expiry)
+		require.NoError(t, err)
+		require.Empty(t, skipped)
+	})
+
+	t.Run("MismatchedMoves", func(t *testing.T) {
+		_, err := findSkippedClaims(claims, nil, honest, maxDepth, expiry)
+		require.ErrorContains(t, err, "4 claims but 0 moves")
+	})
+}
@@ -0,0 +1,55 @@
+package disputegame
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// clockTimeExceededReason is the revert reason when a move is made after the mover's clock has expired.
+const clockTimeExceededReason = "ClockTimeExceeded"
+
+// RequireExpiredClockMoveRejected advances L1 time past the clock of the player countering the latest claim, then
+// requires that the player's attack on that claim, sent from playerKey, is rejected with ClockTimeExceeded and does
+// not add a claim. The game is not resolved first so this checks each player's clock is enforced on its own, not
+// just the game ending.
+// Fails the test if the factory helper wasn't created with WithL1TimeAdvancer.
+func (g *FaultGameHelper) RequireExpiredClockMoveRejected(ctx context.Context, playerKey *ecdsa.PrivateKey) {
+	g.require.NoError(g.checkTimeControl(), "expire player clock")
+	claims, err := g.LoadClaims(ctx)
+	g.require.NoError(err, "load claims")
+	parentIdx := int64(len(claims) - 1)
+	parent := claims[parentIdx]
+	parentPos := types.NewPositionFromGIndex(parent.Position.Uint64())
+	g.require.Less(parentPos.Depth(), g.maxDepth, "latest claim is at max depth so can't be attacked")
+
+	expiry := responseDeadline(claims, parentIdx, g.GameDuration(ctx))
+	advanceCtx, cancel := g.withTimeout(ctx, time.Minute)
+	defer cancel()
+	g.require.NoError(advanceL1TimeTo(advanceCtx, g.client, g.clock, g.timeAdvancer, expiry+1), "expire player clock")
+
+	status, err := g.LoadStatus(ctx)
+	g.require.NoError(err, "load game status")
+	g.require.Equal(StatusInProgress, status, "game should not be resolved")
+
+	chainID, err := g.client.ChainID(ctx)
+	g.require.NoError(err)
+	opts, err := bind.NewKeyedTransactorWithChainID(playerKey, chainID)
+	g.require.NoError(err)
+	player := *g
+	player.GameCore = g.withOpts(opts)
+	err = player.TryAttack(ctx, parentIdx, common.Hash{0xde, 0xad})
+	g.require.ErrorContainsf(err, clockTimeExceededReason, "move against claim %v after clock expired should be rejected", parentIdx)
+	g.require.Equal(int64(len(claims)), g.ClaimCount(ctx), "rejected move should not add a claim")
+}
+
+// withOpts returns a copy of the game core that sends transactions with opts.
+func (g *GameCore) withOpts(opts *bind.TransactOpts) *GameCore {
+	core := *g
+	core.opts = opts
+	return &core
+}
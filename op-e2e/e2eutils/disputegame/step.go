@@ -0,0 +1,84 @@
+package disputegame
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// StepCall describes a successful step transaction sent to the game.
+type StepCall struct {
+	TxHash     common.Hash
+	ClaimIndex uint64
+	IsAttack   bool
+	StateData  []byte
+	Proof      []byte
+}
+
+// AssertStepConsistency checks that every successful step performed against the game used the prestate from trace
+// at the trace index implied by the countered claim's position and whether the step was an attack or defense.
+func (g *FaultGameHelper) AssertStepConsistency(ctx context.Context, trace types.TraceProvider) {
+	steps := g.StepCalls(ctx)
+	for _, step := range steps {
+		claim := g.getClaim(ctx, int64(step.ClaimIndex))
+		pos := types.NewPositionFromGIndex(claim.Position.Uint64())
+		index, isAbsolutePrestate := expectedPrestateIndex(pos, g.maxDepth, step.IsAttack)
+		var expected []byte
+		var err error
+		if isAbsolutePrestate {
+			expected, err = trace.AbsolutePreState(ctx)
+			g.require.NoError(err, "load absolute prestate")
+		} else {
+			expected, _, err = trace.GetPreimage(ctx, index)
+			g.require.NoErrorf(err, "load prestate at trace index %v", index)
+		}
+		g.require.Truef(bytes.Equal(expected, step.StateData),
+			"step %v against claim %v (attack: %v) used incorrect prestate for trace index %v",
+			step.TxHash, step.ClaimIndex, step.IsAttack, index)
+	}
+}
+
+// expectedPrestateIndex returns the trace index of the prestate a step against a claim at pos must use.
+// Attacking the claim at trace index 0 uses the absolute prestate which is indicated by returning true.
+func expectedPrestateIndex(pos types.Position, maxDepth int, isAttack bool) (uint64, bool) {
+	index := pos.TraceIndex(maxDepth)
+	if !isAttack {
+		// Defending uses the countered claim as the prestate
+		return index, false
+	}
+	if index == 0 {
+		return 0, true
+	}
+	return index - 1, false
+}
+
+// StepCalls returns the details of every successful step transaction sent to the game, in the order they were
+// included.
+func (g *FaultGameHelper) StepCalls(ctx context.Context) []StepCall {
+	fdgAbi, err := bindings.FaultDisputeGameMetaData.GetAbi()
+	g.require.NoError(err)
+	stepMethod := fdgAbi.Methods["step"]
+
+	var steps []StepCall
+	g.forEachGameTx(ctx, func(tx *ethtypes.Transaction) {
+		data := tx.Data()
+		if len(data) < 4 || !bytes.Equal(data[:4], stepMethod.ID) {
+			return
+		}
+		args, err := stepMethod.Inputs.Unpack(data[4:])
+		g.require.NoErrorf(err, "decode step call data in tx %v", tx.Hash())
+		steps = append(steps, StepCall{
+			TxHash:     tx.Hash(),
+			ClaimIndex: args[0].(*big.Int).Uint64(),
+			IsAttack:   args[1].(bool),
+			StateData:  args[2].([]byte),
+			Proof:      args[3].([]byte),
+		})
+	})
+	return steps
+}
@@ -0,0 +1,32 @@
+package disputegame
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpectedPrestateIndex(t *testing.T) {
+	tests := []struct {
+		name               string
+		pos                types.Position
+		isAttack           bool
+		expectedIndex      uint64
+		isAbsolutePrestate bool
+	}{
+		{"AttackFirstLeaf", types.NewPosition(4, 0), true, 0, true},
+		{"DefendFirstLeaf", types.NewPosition(4, 0), false, 0, false},
+		{"AttackLeaf", types.NewPosition(4, 5), true, 4, false},
+		{"DefendLeaf", types.NewPosition(4, 5), false, 5, false},
+		{"AttackLastLeaf", types.NewPosition(4, 15), true, 14, false},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			index, isAbsolutePrestate := expectedPrestateIndex(test.pos, 4, test.isAttack)
+			require.Equal(t, test.expectedIndex, index)
+			require.Equal(t, test.isAbsolutePrestate, isAbsolutePrestate)
+		})
+	}
+}
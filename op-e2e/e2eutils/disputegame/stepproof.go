@@ -0,0 +1,68 @@
+package disputegame
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// stepProofGas is the gas available to the off-chain step, matching the limit used by the cannon EVM tests.
+const stepProofGas = 30_000_000
+
+var (
+	ErrStepReverted      = errors.New("step reverted")
+	ErrStepClaimMismatch = errors.New("step post-state does not match claim")
+
+	stepProofAddrs = &mipsevm.Addresses{
+		MIPS:         common.Address{0: 0xff, 19: 1},
+		Oracle:       common.Address{0: 0xff, 19: 2},
+		Sender:       common.Address{0x13, 0x37},
+		FeeRecipient: common.Address{0xaa},
+	}
+)
+
+// ValidateStepProof runs the MIPS state transition off-chain from the pre-state stateData using the memory proof, and
+// checks the resulting post-state hash matches the claim at claimIdx. Returns ErrStepReverted if the proof is invalid
+// and ErrStepClaimMismatch if the step produces a different state to the claim.
+// The off-chain pre-image oracle is empty, so steps that read a pre-image are reported as reverting.
+func (g *CannonGameHelper) ValidateStepProof(ctx context.Context, claimIdx int64, stateData []byte, proof []byte) error {
+	claim := g.getClaim(ctx, claimIdx)
+	return validateStepProof(claim.Claim, stateData, proof)
+}
+
+func validateStepProof(claim common.Hash, stateData []byte, proof []byte) error {
+	postState, err := runStepOffchain(stateData, proof)
+	if err != nil {
+		return err
+	}
+	if postState != claim {
+		return fmt.Errorf("%w: step from pre-state %v produced %v but claim is %v",
+			ErrStepClaimMismatch, crypto.Keccak256Hash(stateData), postState, claim)
+	}
+	return nil
+}
+
+// runStepOffchain executes a single MIPS step in an in-memory EVM using the MIPS contract from op-bindings and
+// returns the post-state hash.
+func runStepOffchain(stateData []byte, proof []byte) (common.Hash, error) {
+	contracts, err := mipsevm.LoadContracts()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("load MIPS contracts: %w", err)
+	}
+	env, _ := mipsevm.NewEVMEnv(contracts, stepProofAddrs)
+	witness := &mipsevm.StepWitness{State: stateData, MemProof: proof}
+	ret, _, err := env.Call(vm.AccountRef(stepProofAddrs.Sender), stepProofAddrs.MIPS, witness.EncodeStepInput(), stepProofGas, big.NewInt(0))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("%w: pre-state %v: %v", ErrStepReverted, crypto.Keccak256Hash(stateData), err)
+	}
+	if len(ret) != 32 {
+		return common.Hash{}, fmt.Errorf("expected 32 byte post-state hash but got %v bytes", len(ret))
+	}
+	return common.BytesToHash(ret), nil
+}
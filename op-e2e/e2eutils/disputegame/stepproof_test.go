@@ -0,0 +1,49 @@
+package disputegame
+
+import (
+	"io"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// addiStep runs a single addi instruction in the Go MIPS VM, returning the witness for the step and the post-state hash.
+func addiStep(t *testing.T) (*mipsevm.StepWitness, common.Hash) {
+	state := &mipsevm.State{PC: 0, NextPC: 4, Memory: mipsevm.NewMemory()}
+	state.Memory.SetMemory(0, 0x20_08_00_05) // addi $t0, $zero, 5
+	us := mipsevm.NewInstrumentedState(state, nil, io.Discard, io.Discard)
+	witness, err := us.Step(true)
+	require.NoError(t, err)
+	return witness, crypto.Keccak256Hash(state.EncodeWitness())
+}
+
+func TestValidateStepProof(t *testing.T) {
+	witness, postHash := addiStep(t)
+
+	t.Run("Valid", func(t *testing.T) {
+		require.NoError(t, validateStepProof(postHash, witness.State, witness.MemProof))
+	})
+
+	t.Run("ClaimMismatch", func(t *testing.T) {
+		err := validateStepProof(common.Hash{0xaa}, witness.State, witness.MemProof)
+		require.ErrorIs(t, err, ErrStepClaimMismatch)
+		require.ErrorContains(t, err, postHash.Hex())
+	})
+
+	t.Run("InvalidProof", func(t *testing.T) {
+		proof := append([]byte{}, witness.MemProof...)
+		// Corrupt a sibling in the merkle proof of the instruction memory
+		proof[40] ^= 0xff
+		require.ErrorIs(t, validateStepProof(postHash, witness.State, proof), ErrStepReverted)
+	})
+
+	t.Run("WrongPreState", func(t *testing.T) {
+		state := append([]byte{}, witness.State...)
+		// Changing the memory root invalidates the memory proof
+		state[0] ^= 0xff
+		require.ErrorIs(t, validateStepProof(postHash, state, witness.MemProof), ErrStepReverted)
+	})
+}
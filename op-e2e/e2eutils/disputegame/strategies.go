@@ -0,0 +1,178 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/solver"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// traceStrategy counters every claim it disagrees with according to a trace, using the same solver as the
+// challenger. Claims at the max depth are left alone as it doesn't step.
+type traceStrategy struct {
+	solver       *solver.Solver
+	supportsRoot bool
+	delay        DelayPolicy
+
+	lock      sync.Mutex
+	responses map[int64]*Move
+}
+
+// NewTraceStrategy creates a strategy that plays trace, supporting the root claim if supportsRoot is true and
+// disputing it otherwise. Each move is delayed as decided by delay, measured from when the move is first
+// considered.
+func NewTraceStrategy(maxDepth int, trace types.TraceProvider, supportsRoot bool, delay DelayPolicy) Strategy {
+	return &traceStrategy{
+		solver:       solver.NewSolver(maxDepth, trace),
+		supportsRoot: supportsRoot,
+		delay:        delay,
+		responses:    make(map[int64]*Move),
+	}
+}
+
+func (s *traceStrategy) NextMoves(ctx context.Context, tree GameSnapshot) ([]Move, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var moves []Move
+	for idx := int64(0); idx < int64(len(tree.Claims)); idx++ {
+		response, ok := s.responses[idx]
+		if !ok {
+			var err error
+			response, err = s.respond(ctx, tree, idx)
+			if err != nil {
+				return nil, fmt.Errorf("calculate move against claim %v: %w", idx, err)
+			}
+			s.responses[idx] = response
+		}
+		if response != nil {
+			moves = append(moves, *response)
+		}
+	}
+	return moves, nil
+}
+
+// respond returns the move to make against the claim at idx, or nil if the claim shouldn't be countered.
+func (s *traceStrategy) respond(ctx context.Context, tree GameSnapshot, idx int64) (*Move, error) {
+	claim := tree.Claims[idx]
+	pos := types.NewPositionFromGIndex(claim.Position.Uint64())
+	if pos.Depth() >= tree.MaxDepth {
+		return nil, nil
+	}
+	// Claims at even depths support the root claim.
+	agreeWithLevel := (pos.Depth()%2 == 0) == s.supportsRoot
+	move, err := s.solver.NextMove(ctx, types.Claim{
+		ClaimData: types.ClaimData{
+			Value:    claim.Claim,
+			Position: pos,
+		},
+		ContractIndex: int(idx),
+	}, agreeWithLevel)
+	if err != nil || move == nil {
+		return nil, err
+	}
+
+	// Our clock resumes from the duration accumulated by our previous claim, which is the parent of the claim
+	// being countered, and has been running since the countered claim was made.
+	remaining := tree.GameDuration / 2
+	if !pos.IsRootPosition() {
+		remaining -= tree.Claims[claim.ParentIndex].ClockDuration()
+	}
+	if tree.Now > claim.ClockTimestamp() {
+		remaining -= time.Duration(tree.Now-claim.ClockTimestamp()) * time.Second
+	}
+	delay := s.delay(move.Depth(), remaining)
+	return &Move{
+		ParentIdx: idx,
+		Value:     move.Value,
+		IsAttack:  !move.DefendsParent(),
+		NotBefore: tree.Now + uint64(delay/time.Second),
+	}, nil
+}
+
+// randomStrategy counters claims made by other accounts at random, with random values.
+type randomStrategy struct {
+	probability float64
+
+	lock       sync.Mutex
+	rng        *rand.Rand
+	considered int
+	moves      []Move
+}
+
+// NewRandomStrategy creates a strategy that considers each claim made by another account once, in the order the
+// claims were made, and counters it with a random value with the given probability. Whether it attacks or defends
+// is also random, though the root claim is always attacked.
+// Decisions are made by a random number generator seeded with seed, so the same seed makes the same moves as long
+// as the other players make the same claims.
+func NewRandomStrategy(seed int64, probability float64) Strategy {
+	return &randomStrategy{
+		probability: probability,
+		rng:         rand.New(rand.NewSource(seed)),
+	}
+}
+
+func (s *randomStrategy) NextMoves(_ context.Context, tree GameSnapshot) ([]Move, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for ; s.considered < len(tree.Claims); s.considered++ {
+		idx := int64(s.considered)
+		if tree.Claimant(idx) == tree.Self {
+			continue
+		}
+		pos := types.NewPositionFromGIndex(tree.Claims[idx].Position.Uint64())
+		if pos.Depth() >= tree.MaxDepth {
+			continue
+		}
+		// Always draw the same numbers for each claim so later decisions don't depend on earlier ones.
+		counter := s.rng.Float64() < s.probability
+		isAttack := pos.IsRootPosition() || s.rng.Intn(2) == 0
+		var value common.Hash
+		s.rng.Read(value[:])
+		if counter {
+			s.moves = append(s.moves, Move{ParentIdx: idx, Value: value, IsAttack: isAttack})
+		}
+	}
+	return s.moves, nil
+}
+
+// mirrorStrategy copies the claims made by a target account to the sibling position.
+type mirrorStrategy struct {
+	target common.Address
+}
+
+// NewMirrorStrategy creates a strategy that copies each claim made by target to its sibling position, defending the
+// claim target attacked, or attacking the claim it defended, with the same value. Attacks on the root claim can't be
+// mirrored as the root claim can't be defended.
+func NewMirrorStrategy(target common.Address) Strategy {
+	return &mirrorStrategy{target: target}
+}
+
+func (s *mirrorStrategy) NextMoves(_ context.Context, tree GameSnapshot) ([]Move, error) {
+	var moves []Move
+	for i, claim := range tree.Claims {
+		idx := int64(i)
+		if idx == 0 || tree.Claimant(idx) != s.target {
+			continue
+		}
+		if claim.ParentIndex == math.MaxUint32 || int(claim.ParentIndex) >= len(tree.Claims) {
+			continue
+		}
+		parent := tree.Claims[claim.ParentIndex]
+		attacked, err := isAttack(parent, claim)
+		if err != nil {
+			return nil, fmt.Errorf("classify claim %v: %w", idx, err)
+		}
+		parentPos := types.NewPositionFromGIndex(parent.Position.Uint64())
+		if attacked && parentPos.IsRootPosition() {
+			continue
+		}
+		moves = append(moves, Move{ParentIdx: int64(claim.ParentIndex), Value: claim.Claim, IsAttack: !attacked})
+	}
+	return moves, nil
+}
@@ -0,0 +1,340 @@
+package disputegame
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// defaultStrategyPollInterval is how often the strategy driver checks the game when it isn't woken by a game log.
+const defaultStrategyPollInterval = time.Second
+
+// Move is a move a Strategy wants to make in a game.
+type Move struct {
+	// ParentIdx is the index of the claim being attacked or defended.
+	ParentIdx int64
+	Value     common.Hash
+	IsAttack  bool
+	// NotBefore is the earliest L1 timestamp the move may be made at. The move is made as soon as possible if
+	// NotBefore is zero or has already passed.
+	NotBefore uint64
+}
+
+// moveKey identifies a move regardless of when it is made.
+type moveKey struct {
+	parentIdx int64
+	value     common.Hash
+	isAttack  bool
+}
+
+func (m Move) key() moveKey {
+	return moveKey{parentIdx: m.ParentIdx, value: m.Value, isAttack: m.IsAttack}
+}
+
+// GameSnapshot is the state of a game a Strategy decides its moves from.
+type GameSnapshot struct {
+	// Self is the account the strategy's moves are sent from.
+	Self     common.Address
+	Claims   []ContractClaim
+	MaxDepth int
+	// GameDuration is the total duration of the game. Each player's clock has half of it.
+	GameDuration time.Duration
+	// Now is the timestamp of the latest L1 block.
+	Now uint64
+
+	// claimants is the account that made each claim after the root claim, so claimants[i] made claim i+1.
+	claimants []common.Address
+}
+
+// Claimant returns the account that made the claim at idx, or the zero address for the root claim or if the move
+// that made the claim hasn't been loaded.
+func (s GameSnapshot) Claimant(idx int64) common.Address {
+	if idx < 1 || idx > int64(len(s.claimants)) {
+		return common.Address{}
+	}
+	return s.claimants[idx-1]
+}
+
+// Strategy decides the moves an actor makes in a game.
+// NextMoves is called each time the driver checks the game and should return every move the strategy wants to make
+// against the current state. Moves returned previously may be returned again, the driver only makes each move once.
+type Strategy interface {
+	NextMoves(ctx context.Context, tree GameSnapshot) ([]Move, error)
+}
+
+// StrategyFunc adapts a function to a Strategy.
+type StrategyFunc func(ctx context.Context, tree GameSnapshot) ([]Move, error)
+
+func (f StrategyFunc) NextMoves(ctx context.Context, tree GameSnapshot) ([]Move, error) {
+	return f(ctx, tree)
+}
+
+// strategyBackend is the access to a game the strategy driver requires, so the driver can be tested without a chain.
+type strategyBackend interface {
+	Status(ctx context.Context) (Status, error)
+	Snapshot(ctx context.Context) (GameSnapshot, error)
+	// SendMove makes move, paying the required bond, and waits for it to be included.
+	SendMove(ctx context.Context, move Move) error
+	// Wait calls cb at rate, and whenever the game changes if supported, until it returns true or an error.
+	Wait(ctx context.Context, rate time.Duration, cb func() (bool, error)) error
+}
+
+type StrategyOption func(d *StrategyDriver)
+
+// WithStrategyTimeAdvancer allows the driver to time travel L1 to the time delayed moves are due, rather than
+// waiting for chain time to pass.
+func WithStrategyTimeAdvancer(advancer TimeAdvancer) StrategyOption {
+	return func(d *StrategyDriver) {
+		d.advancer = advancer
+	}
+}
+
+// WithStrategyPollInterval sets how often the driver checks the game when it isn't woken by a game log.
+func WithStrategyPollInterval(interval time.Duration) StrategyOption {
+	return func(d *StrategyDriver) {
+		d.pollInterval = interval
+	}
+}
+
+// StrategyDriver plays a game by repeatedly asking a Strategy for its moves and making them.
+// Moves are made one at a time from a single goroutine, each waiting for the previous move to be included, so the
+// account's nonce is always loaded after its previous transaction. Moves that are delayed until a later L1 time
+// don't block others, the driver keeps reacting to the game until they are due.
+type StrategyDriver struct {
+	backend      strategyBackend
+	strategy     Strategy
+	advancer     TimeAdvancer
+	pollInterval time.Duration
+	logf         func(format string, args ...interface{})
+
+	// advancedTo is the latest L1 timestamp the driver has time travelled to.
+	advancedTo uint64
+
+	lock    sync.Mutex
+	queued  map[moveKey]bool
+	pending []Move
+	made    []Move
+}
+
+func newStrategyDriver(backend strategyBackend, strategy Strategy, logf func(format string, args ...interface{}), options ...StrategyOption) *StrategyDriver {
+	d := &StrategyDriver{
+		backend:      backend,
+		strategy:     strategy,
+		pollInterval: defaultStrategyPollInterval,
+		logf:         logf,
+		queued:       make(map[moveKey]bool),
+	}
+	for _, option := range options {
+		option(d)
+	}
+	return d
+}
+
+// StartStrategy plays the game with strategy in the background, sending moves from the helper's account, until the
+// game is resolved or the test completes. The test fails if the driver stops with an error.
+func (g *FaultGameHelper) StartStrategy(ctx context.Context, strategy Strategy, options ...StrategyOption) *StrategyDriver {
+	d := newStrategyDriver(&gameStrategyBackend{g: g}, strategy, g.t.Logf, options...)
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan error, 1)
+	go func() {
+		done <- d.run(ctx)
+	}()
+	g.t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil && !errors.Is(err, context.Canceled) {
+			g.t.Errorf("strategy driver failed: %v", err)
+		}
+	})
+	return d
+}
+
+// StartStrategyFrom plays game with strategy in the background, like StartStrategy, but sends moves from the
+// account of key.
+func (h *FactoryHelper) StartStrategyFrom(ctx context.Context, game *FaultGameHelper, key *ecdsa.PrivateKey, strategy Strategy, options ...StrategyOption) *StrategyDriver {
+	chainID, err := h.client.ChainID(ctx)
+	h.require.NoError(err)
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	h.require.NoError(err)
+	player := h.newGameHelperWithOpts(ctx, game.addr, game.maxDepth, opts)
+	return player.StartStrategy(ctx, strategy, options...)
+}
+
+// Made returns the moves the driver has made so far, in the order they were made.
+func (d *StrategyDriver) Made() []Move {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return append([]Move(nil), d.made...)
+}
+
+func (d *StrategyDriver) run(ctx context.Context) error {
+	return d.backend.Wait(ctx, d.pollInterval, func() (bool, error) {
+		return d.poll(ctx)
+	})
+}
+
+// poll asks the strategy for its moves against the current state of the game and makes those that are due.
+// Returns true once the game is no longer in progress.
+func (d *StrategyDriver) poll(ctx context.Context) (bool, error) {
+	status, err := d.backend.Status(ctx)
+	if err != nil {
+		return false, err
+	}
+	if status != StatusInProgress {
+		return true, nil
+	}
+	tree, err := d.backend.Snapshot(ctx)
+	if err != nil {
+		return false, err
+	}
+	moves, err := d.strategy.NextMoves(ctx, tree)
+	if err != nil {
+		return false, fmt.Errorf("calculate next moves: %w", err)
+	}
+	d.queue(moves)
+	for _, move := range d.takeDue(tree.Now) {
+		if idx, ok := existingMove(tree.Claims, move.ParentIdx, move.Value, move.IsAttack); ok {
+			d.logf("Skipping move against claim %v as claim %v already makes it", move.ParentIdx, idx)
+			continue
+		}
+		if err := d.backend.SendMove(ctx, move); err != nil {
+			return false, fmt.Errorf("move against claim %v: %w", move.ParentIdx, err)
+		}
+		d.lock.Lock()
+		d.made = append(d.made, move)
+		d.lock.Unlock()
+	}
+	d.advanceToNextDue(tree.Now)
+	return false, nil
+}
+
+// queue adds each move that hasn't been queued before to the pending moves.
+func (d *StrategyDriver) queue(moves []Move) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	for _, move := range moves {
+		if d.queued[move.key()] {
+			continue
+		}
+		d.queued[move.key()] = true
+		d.pending = append(d.pending, move)
+	}
+}
+
+// takeDue removes and returns the pending moves due by now, earliest first.
+func (d *StrategyDriver) takeDue(now uint64) []Move {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	var due, later []Move
+	for _, move := range d.pending {
+		if move.NotBefore <= now {
+			due = append(due, move)
+		} else {
+			later = append(later, move)
+		}
+	}
+	d.pending = later
+	sort.SliceStable(due, func(i, j int) bool { return due[i].NotBefore < due[j].NotBefore })
+	return due
+}
+
+// advanceToNextDue time travels L1 to when the next pending move is due, if the driver has a time advancer.
+// Time is only advanced once for each target so that polls while waiting for the next block don't advance it again.
+func (d *StrategyDriver) advanceToNextDue(now uint64) {
+	if d.advancer == nil {
+		return
+	}
+	d.lock.Lock()
+	var next uint64
+	for _, move := range d.pending {
+		if next == 0 || move.NotBefore < next {
+			next = move.NotBefore
+		}
+	}
+	d.lock.Unlock()
+	if next <= now || next <= d.advancedTo {
+		return
+	}
+	d.logf("Advancing L1 time by %vs for delayed move", next-now)
+	d.advancer.AdvanceTime(time.Duration(next-now) * time.Second)
+	d.advancedTo = next
+}
+
+// gameStrategyBackend drives a game on chain via a game helper.
+type gameStrategyBackend struct {
+	g *FaultGameHelper
+}
+
+func (b *gameStrategyBackend) Status(ctx context.Context) (Status, error) {
+	return b.g.LoadStatus(ctx)
+}
+
+func (b *gameStrategyBackend) Snapshot(ctx context.Context) (GameSnapshot, error) {
+	snapshot, err := b.g.snapshot(ctx, b.g.maxDepth, b.g.creation.BlockNumber)
+	if err != nil {
+		return GameSnapshot{}, err
+	}
+	snapshot.Self = b.g.opts.From
+	return snapshot, nil
+}
+
+// snapshot loads the current state of the game, which has maxDepth and was created in the L1 block start.
+// Self is left as the zero address.
+func (g *GameCore) snapshot(ctx context.Context, maxDepth int, start uint64) (GameSnapshot, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	claims, err := g.LoadClaims(ctx)
+	if err != nil {
+		return GameSnapshot{}, err
+	}
+	claimants, err := g.loadClaimants(ctx, start)
+	if err != nil {
+		return GameSnapshot{}, err
+	}
+	duration, err := g.game.GAMEDURATION(opts)
+	if err != nil {
+		return GameSnapshot{}, fmt.Errorf("load game duration: %w", err)
+	}
+	header, err := g.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return GameSnapshot{}, fmt.Errorf("load L1 head: %w", err)
+	}
+	return GameSnapshot{
+		Claims:       claims,
+		MaxDepth:     maxDepth,
+		GameDuration: time.Duration(duration) * time.Second,
+		Now:          header.Time,
+		claimants:    claimants,
+	}, nil
+}
+
+// loadClaimants returns the account that made each move in the game, in the order they were made, searching for
+// Move events from the L1 block start.
+func (g *GameCore) loadClaimants(ctx context.Context, start uint64) ([]common.Address, error) {
+	iter, err := g.game.FilterMove(&bind.FilterOpts{Context: ctx, Start: start}, nil, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("filter move events: %w", err)
+	}
+	defer iter.Close()
+	var claimants []common.Address
+	for iter.Next() {
+		claimants = append(claimants, iter.Event.Claimant)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("iterate move events: %w", err)
+	}
+	return claimants, nil
+}
+
+func (b *gameStrategyBackend) SendMove(ctx context.Context, move Move) error {
+	return b.g.move(ctx, move.ParentIdx, move.Value, move.IsAttack)
+}
+
+func (b *gameStrategyBackend) Wait(ctx context.Context, rate time.Duration, cb func() (bool, error)) error {
+	return b.g.waitForGameLogs(ctx, rate, cb)
+}
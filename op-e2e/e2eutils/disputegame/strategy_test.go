@@ -0,0 +1,326 @@
+package disputegame
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	strategyPlayer = common.Address{0xaa}
+	strategyOther  = common.Address{0xbb}
+)
+
+// fakeStrategyBackend is an in-memory game that applies moves as soon as they are sent.
+type fakeStrategyBackend struct {
+	clk *clock.DeterministicClock
+
+	lock      sync.Mutex
+	status    Status
+	now       uint64
+	claims    []ContractClaim
+	claimants []common.Address
+	sent      []Move
+	inFlight  int
+	// maxInFlight is the most moves that were being sent at the same time.
+	maxInFlight int
+}
+
+func newFakeStrategyBackend() *fakeStrategyBackend {
+	return &fakeStrategyBackend{
+		clk: clock.NewDeterministicClock(time.Unix(0, 0)),
+		now: 1000,
+		claims: []ContractClaim{{
+			ParentIndex: math.MaxUint32,
+			Position:    big.NewInt(1),
+			Clock:       big.NewInt(1000),
+		}},
+	}
+}
+
+func (f *fakeStrategyBackend) Status(_ context.Context) (Status, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.status, nil
+}
+
+func (f *fakeStrategyBackend) Snapshot(_ context.Context) (GameSnapshot, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return GameSnapshot{
+		Self:         strategyPlayer,
+		Claims:       append([]ContractClaim(nil), f.claims...),
+		MaxDepth:     alphabetGameDepth,
+		GameDuration: time.Hour,
+		Now:          f.now,
+		claimants:    append([]common.Address(nil), f.claimants...),
+	}, nil
+}
+
+func (f *fakeStrategyBackend) SendMove(_ context.Context, move Move) error {
+	f.lock.Lock()
+	f.inFlight++
+	if f.inFlight > f.maxInFlight {
+		f.maxInFlight = f.inFlight
+	}
+	f.lock.Unlock()
+	// Give any concurrent send the chance to overlap.
+	time.Sleep(time.Millisecond)
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.inFlight--
+	f.sent = append(f.sent, move)
+	f.addClaim(strategyPlayer, move.ParentIdx, move.Value, move.IsAttack)
+	return nil
+}
+
+func (f *fakeStrategyBackend) Wait(ctx context.Context, rate time.Duration, cb func() (bool, error)) error {
+	return waitFor(ctx, f.clk, rate, cb)
+}
+
+// addClaim adds a claim made by claimant. The caller must hold the lock.
+func (f *fakeStrategyBackend) addClaim(claimant common.Address, parentIdx int64, value common.Hash, isAttack bool) {
+	pos := types.NewPositionFromGIndex(f.claims[parentIdx].Position.Uint64())
+	if isAttack {
+		pos = pos.Attack()
+	} else {
+		pos = pos.Defend()
+	}
+	f.claims = append(f.claims, ContractClaim{
+		ParentIndex: uint32(parentIdx),
+		Claim:       value,
+		Position:    new(big.Int).SetUint64(pos.ToGIndex()),
+		Clock:       new(big.Int).SetUint64(f.now),
+	})
+	f.claimants = append(f.claimants, claimant)
+}
+
+func (f *fakeStrategyBackend) move(claimant common.Address, parentIdx int64, value common.Hash, isAttack bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.addClaim(claimant, parentIdx, value, isAttack)
+}
+
+func (f *fakeStrategyBackend) setNow(now uint64) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.now = now
+}
+
+func (f *fakeStrategyBackend) setStatus(status Status) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.status = status
+}
+
+func (f *fakeStrategyBackend) sentMoves() []Move {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]Move(nil), f.sent...)
+}
+
+type recordingAdvancer struct {
+	advances []time.Duration
+}
+
+func (r *recordingAdvancer) AdvanceTime(d time.Duration) {
+	r.advances = append(r.advances, d)
+}
+
+func fixedMoves(moves ...Move) Strategy {
+	return StrategyFunc(func(_ context.Context, _ GameSnapshot) ([]Move, error) {
+		return moves, nil
+	})
+}
+
+func TestStrategyDriverMakesEachMoveOnce(t *testing.T) {
+	ctx := context.Background()
+	backend := newFakeStrategyBackend()
+	move := Move{ParentIdx: 0, Value: common.Hash{0x01}, IsAttack: true}
+	driver := newStrategyDriver(backend, fixedMoves(move), t.Logf)
+	for i := 0; i < 3; i++ {
+		done, err := driver.poll(ctx)
+		require.NoError(t, err)
+		require.False(t, done)
+	}
+	require.Equal(t, []Move{move}, backend.sentMoves())
+	require.Equal(t, []Move{move}, driver.Made())
+}
+
+func TestStrategyDriverSkipsMovesAlreadyOnChain(t *testing.T) {
+	ctx := context.Background()
+	backend := newFakeStrategyBackend()
+	backend.move(strategyOther, 0, common.Hash{0x01}, true)
+	driver := newStrategyDriver(backend, fixedMoves(Move{ParentIdx: 0, Value: common.Hash{0x01}, IsAttack: true}), t.Logf)
+	_, err := driver.poll(ctx)
+	require.NoError(t, err)
+	require.Empty(t, backend.sentMoves())
+}
+
+func TestStrategyDriverDelaysMoves(t *testing.T) {
+	ctx := context.Background()
+	backend := newFakeStrategyBackend()
+	delayed := Move{ParentIdx: 0, Value: common.Hash{0x01}, IsAttack: true, NotBefore: 1060}
+	immediate := Move{ParentIdx: 0, Value: common.Hash{0x02}, IsAttack: true}
+	driver := newStrategyDriver(backend, fixedMoves(delayed, immediate), t.Logf)
+
+	_, err := driver.poll(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []Move{immediate}, backend.sentMoves(), "delayed move should not block other moves")
+
+	backend.setNow(1059)
+	_, err = driver.poll(ctx)
+	require.NoError(t, err)
+	require.Len(t, backend.sentMoves(), 1)
+
+	backend.setNow(1060)
+	_, err = driver.poll(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []Move{immediate, delayed}, backend.sentMoves())
+}
+
+func TestStrategyDriverAdvancesTimeOncePerDelay(t *testing.T) {
+	ctx := context.Background()
+	backend := newFakeStrategyBackend()
+	advancer := &recordingAdvancer{}
+	first := Move{ParentIdx: 0, Value: common.Hash{0x01}, IsAttack: true, NotBefore: 1030}
+	second := Move{ParentIdx: 0, Value: common.Hash{0x02}, IsAttack: true, NotBefore: 1100}
+	driver := newStrategyDriver(backend, fixedMoves(second, first), t.Logf, WithStrategyTimeAdvancer(advancer))
+
+	// Polls before the next block arrives must not advance time again.
+	for i := 0; i < 3; i++ {
+		_, err := driver.poll(ctx)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []time.Duration{30 * time.Second}, advancer.advances)
+
+	backend.setNow(1030)
+	_, err := driver.poll(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []Move{first}, backend.sentMoves())
+	require.Equal(t, []time.Duration{30 * time.Second, 70 * time.Second}, advancer.advances)
+}
+
+func TestStrategyDriverRunsUntilGameEnds(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	backend := newFakeStrategyBackend()
+	// Attack every claim made by another player, which leads to several moves in each poll.
+	strategy := StrategyFunc(func(_ context.Context, tree GameSnapshot) ([]Move, error) {
+		var moves []Move
+		for i := range tree.Claims {
+			if tree.Claimant(int64(i)) != tree.Self {
+				moves = append(moves, Move{ParentIdx: int64(i), Value: common.Hash{byte(i)}, IsAttack: true})
+			}
+		}
+		return moves, nil
+	})
+	driver := newStrategyDriver(backend, strategy, t.Logf)
+	result := make(chan error, 1)
+	go func() {
+		result <- driver.run(ctx)
+	}()
+
+	require.True(t, backend.clk.WaitForNewPendingTaskWithTimeout(5*time.Second), "driver should start polling")
+	backend.move(strategyOther, 0, common.Hash{0xcc}, true)
+	backend.move(strategyOther, 0, common.Hash{0xdd}, true)
+	backend.clk.AdvanceTime(time.Second)
+	require.Eventually(t, func() bool {
+		// Read the moves made concurrently with the driver making them.
+		return len(driver.Made()) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	backend.setStatus(StatusChallengerWins)
+	backend.clk.AdvanceTime(time.Second)
+	require.NoError(t, <-result)
+	require.Len(t, backend.sentMoves(), 3)
+	require.Equal(t, 1, backend.maxInFlight, "moves should be sent one at a time")
+}
+
+func TestTraceStrategyDelaysMoves(t *testing.T) {
+	ctx := context.Background()
+	backend := newFakeStrategyBackend()
+	trace := alphabet.NewTraceProvider(CorrectAlphabet, alphabetGameDepth)
+	backend.move(strategyOther, 0, common.Hash{0xcc}, true)
+	tree, err := backend.Snapshot(ctx)
+	require.NoError(t, err)
+
+	strategy := NewTraceStrategy(alphabetGameDepth, trace, true, FixedDelay(time.Minute))
+	moves, err := strategy.NextMoves(ctx, tree)
+	require.NoError(t, err)
+	require.Len(t, moves, 1, "should only counter the claim disputing the root")
+	require.EqualValues(t, 1, moves[0].ParentIdx)
+	require.Equal(t, tree.Now+60, moves[0].NotBefore)
+
+	// The move is remembered rather than recalculated with a later delay.
+	tree.Now += 30
+	again, err := strategy.NextMoves(ctx, tree)
+	require.NoError(t, err)
+	require.Equal(t, moves, again)
+}
+
+func TestRandomStrategyIsReproducible(t *testing.T) {
+	ctx := context.Background()
+	backend := newFakeStrategyBackend()
+	for i := 0; i < 4; i++ {
+		backend.move(strategyOther, 0, common.Hash{byte(i)}, true)
+	}
+	tree, err := backend.Snapshot(ctx)
+	require.NoError(t, err)
+
+	movesFor := func(seed int64) []Move {
+		moves, err := NewRandomStrategy(seed, 0.5).NextMoves(ctx, tree)
+		require.NoError(t, err)
+		return moves
+	}
+	require.Equal(t, movesFor(7), movesFor(7))
+	require.NotEqual(t, movesFor(7), movesFor(8))
+
+	all, err := NewRandomStrategy(7, 1).NextMoves(ctx, tree)
+	require.NoError(t, err)
+	require.Len(t, all, len(tree.Claims))
+	require.True(t, all[0].IsAttack, "root claim can only be attacked")
+	none, err := NewRandomStrategy(7, 0).NextMoves(ctx, tree)
+	require.NoError(t, err)
+	require.Empty(t, none)
+}
+
+func TestRandomStrategyIgnoresOwnClaims(t *testing.T) {
+	ctx := context.Background()
+	backend := newFakeStrategyBackend()
+	backend.move(strategyPlayer, 0, common.Hash{0x01}, true)
+	tree, err := backend.Snapshot(ctx)
+	require.NoError(t, err)
+	moves, err := NewRandomStrategy(1, 1).NextMoves(ctx, tree)
+	require.NoError(t, err)
+	require.Len(t, moves, 1)
+	require.EqualValues(t, 0, moves[0].ParentIdx)
+}
+
+func TestMirrorStrategy(t *testing.T) {
+	ctx := context.Background()
+	backend := newFakeStrategyBackend()
+	backend.move(strategyOther, 0, common.Hash{0x01}, true)  // Claim 1: attacks the root, which can't be mirrored
+	backend.move(strategyPlayer, 1, common.Hash{0x02}, true) // Claim 2
+	backend.move(strategyOther, 2, common.Hash{0x03}, true)  // Claim 3: attack mirrored by a defense
+	backend.move(strategyOther, 2, common.Hash{0x04}, false) // Claim 4: defense mirrored by an attack
+	backend.move(strategyPlayer, 2, common.Hash{0x05}, true) // Claim 5: not made by the target
+	tree, err := backend.Snapshot(ctx)
+	require.NoError(t, err)
+
+	moves, err := NewMirrorStrategy(strategyOther).NextMoves(ctx, tree)
+	require.NoError(t, err)
+	require.Equal(t, []Move{
+		{ParentIdx: 2, Value: common.Hash{0x03}, IsAttack: false},
+		{ParentIdx: 2, Value: common.Hash{0x04}, IsAttack: true},
+	}, moves)
+}
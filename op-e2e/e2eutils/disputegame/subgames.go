@@ -0,0 +1,101 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SteppedWinner is the winner reported for a subgame at the max depth that was countered by a step rather than a
+// claim, so the winner has no claim index.
+const SteppedWinner int64 = -1
+
+// SubgameWinner returns the index and claimant of the claim that wins the subgame rooted at claimIdx, which is
+// claimIdx itself if the claim is uncountered. The contract doesn't record the winning claim, so it is derived from
+// the claim tree as described by subgameWinners.
+// If the claim was countered by a step the index is SteppedWinner. The claimant is then the address that stepped,
+// recorded in the claim's counteredBy by game versions that support bonds, or the zero address for older versions.
+func (g *FaultGameHelper) SubgameWinner(ctx context.Context, claimIdx int64) (int64, common.Address) {
+	claims, err := g.LoadClaims(ctx)
+	g.require.NoError(err)
+	g.require.Lessf(claimIdx, int64(len(claims)), "no claim %v in game with %v claims", claimIdx, len(claims))
+	winner := subgameWinners(claims, g.maxDepth)[claimIdx]
+	if winner == SteppedWinner {
+		if !g.SupportsBonds(ctx) {
+			return SteppedWinner, common.Address{}
+		}
+		claim, err := g.loadBondedClaim(ctx, claimIdx)
+		g.require.NoError(err)
+		return SteppedWinner, claim.counteredBy
+	}
+	claimants, err := g.loadClaimants(ctx)
+	g.require.NoError(err)
+	return winner, claimants[winner]
+}
+
+// RequireSubgameWinners fails the test unless the honest actor, playing honestTrace, wins every subgame:
+//   - every claim at a level the honest actor disputes is countered by a claim matching honestTrace or by a step.
+//   - every claim matching honestTrace at a level the honest actor supports is uncountered.
+//
+// Claims at supported levels that don't match honestTrace aren't checked, as the honest actor has no reason to
+// counter them.
+func (g *FaultGameHelper) RequireSubgameWinners(ctx context.Context, honestTrace types.TraceProvider) {
+	claims, err := g.LoadClaims(ctx)
+	g.require.NoError(err)
+	violations, err := checkSubgameWinners(ctx, claims, g.maxDepth, honestTrace)
+	g.require.NoError(err)
+	g.require.Emptyf(violations, "game %v has subgames not won by the honest actor", g.addr)
+}
+
+func checkSubgameWinners(ctx context.Context, claims []ContractClaim, maxDepth int, honestTrace types.TraceProvider) ([]error, error) {
+	tree, err := newIntegrityTree(ctx, claims, maxDepth, honestTrace)
+	if err != nil {
+		return nil, err
+	}
+	winners := subgameWinners(claims, maxDepth)
+	var violations []error
+	for i, winner := range winners {
+		if tree.honestDisputes(i) {
+			if winner == int64(i) {
+				violations = append(violations, fmt.Errorf("claim %v disputed by the honest actor won its subgame", i))
+			} else if winner != SteppedWinner && !tree.correct[winner] {
+				violations = append(violations, fmt.Errorf("subgame of claim %v was won by dishonest claim %v", i, winner))
+			}
+		} else if tree.correct[i] && winner != int64(i) {
+			violations = append(violations, fmt.Errorf("honest claim %v lost its subgame to claim %v", i, winner))
+		}
+	}
+	return violations, nil
+}
+
+// subgameWinners returns the index of the claim that wins the subgame rooted at each claim, following the rules the
+// contract applies when resolving subgames:
+//   - a claim at the max depth is countered only by a step, in which case the winner is SteppedWinner.
+//   - any other claim is countered if any of its children is uncountered, in which case the winner is the leftmost
+//     uncountered child. Children are always at the same depth, so the leftmost has the lowest generalized index.
+//   - an uncountered claim wins its own subgame.
+//
+// Children always have a higher index than their parent, so claims are processed in reverse order.
+func subgameWinners(claims []ContractClaim, maxDepth int) []int64 {
+	winners := make([]int64, len(claims))
+	for i := len(claims) - 1; i >= 0; i-- {
+		winners[i] = int64(i)
+		pos := types.NewPositionFromGIndex(claims[i].Position.Uint64())
+		if pos.Depth() == maxDepth && claims[i].Countered {
+			winners[i] = SteppedWinner
+		}
+	}
+	for i := len(claims) - 1; i > 0; i-- {
+		if winners[i] != int64(i) {
+			continue
+		}
+		parent := claims[i].ParentIndex
+		current := winners[parent]
+		if current == int64(parent) || claims[i].Position.Cmp(claims[current].Position) < 0 {
+			winners[parent] = int64(i)
+		}
+	}
+	return winners
+}
@@ -0,0 +1,117 @@
+package disputegame
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubgameWinners(t *testing.T) {
+	const maxDepth = 2
+	root := uint32(math.MaxUint32)
+	claimAt := func(parent uint32, gindex uint64, countered bool) ContractClaim {
+		return ContractClaim{ParentIndex: parent, Countered: countered, Position: new(big.Int).SetUint64(gindex), Clock: big.NewInt(0)}
+	}
+
+	t.Run("Uncountered", func(t *testing.T) {
+		winners := subgameWinners([]ContractClaim{claimAt(root, 1, false)}, maxDepth)
+		require.Equal(t, []int64{0}, winners)
+	})
+
+	t.Run("CounteredByChild", func(t *testing.T) {
+		winners := subgameWinners([]ContractClaim{claimAt(root, 1, true), claimAt(0, 2, false)}, maxDepth)
+		require.Equal(t, []int64{1, 1}, winners)
+	})
+
+	t.Run("CounteredChildDoesNotCounterParent", func(t *testing.T) {
+		winners := subgameWinners([]ContractClaim{
+			claimAt(root, 1, true),
+			claimAt(0, 2, true),
+			claimAt(1, 4, false),
+		}, maxDepth)
+		require.Equal(t, []int64{0, 2, 2}, winners)
+	})
+
+	t.Run("Stepped", func(t *testing.T) {
+		winners := subgameWinners([]ContractClaim{
+			claimAt(root, 1, true),
+			claimAt(0, 2, true),
+			claimAt(1, 6, true),
+		}, maxDepth)
+		require.Equal(t, []int64{1, 1, SteppedWinner}, winners)
+	})
+
+	t.Run("LeftmostUncounteredChildWins", func(t *testing.T) {
+		winners := subgameWinners([]ContractClaim{
+			claimAt(root, 1, true),
+			claimAt(0, 2, true),
+			claimAt(1, 6, false), // Defends claim 1
+			claimAt(1, 4, false), // Attacks claim 1, so is left of the defense
+		}, maxDepth)
+		require.Equal(t, []int64{0, 3, 2, 3}, winners)
+	})
+
+	t.Run("UnsteppedLeafWins", func(t *testing.T) {
+		winners := subgameWinners([]ContractClaim{
+			claimAt(root, 1, true),
+			claimAt(0, 2, true),
+			claimAt(1, 4, false),
+			claimAt(1, 6, true),
+		}, maxDepth)
+		require.Equal(t, []int64{0, 2, 2, SteppedWinner}, winners)
+	})
+}
+
+func TestCheckSubgameWinners(t *testing.T) {
+	ctx := context.Background()
+	const maxDepth = 2
+	provider := alphabet.NewTraceProvider("abcd", maxDepth)
+	root := uint32(math.MaxUint32)
+
+	t.Run("HonestWinsEverySubgame", func(t *testing.T) {
+		claims := integrityClaims(t, provider, maxDepth,
+			integrityClaim{parent: root, gindex: 1, correct: false},
+			integrityClaim{parent: 0, gindex: 2, correct: true},
+			integrityClaim{parent: 1, gindex: 6, correct: false, countered: true},
+		)
+		violations, err := checkSubgameWinners(ctx, claims, maxDepth, provider)
+		require.NoError(t, err)
+		require.Empty(t, violations)
+	})
+
+	t.Run("DisputedClaimUncountered", func(t *testing.T) {
+		claims := integrityClaims(t, provider, maxDepth, integrityClaim{parent: root, gindex: 1, correct: false})
+		violations, err := checkSubgameWinners(ctx, claims, maxDepth, provider)
+		require.NoError(t, err)
+		require.Equal(t, []string{"claim 0 disputed by the honest actor won its subgame"}, errorStrings(violations))
+	})
+
+	t.Run("DishonestCounterWins", func(t *testing.T) {
+		claims := integrityClaims(t, provider, maxDepth,
+			integrityClaim{parent: root, gindex: 1, correct: false},
+			integrityClaim{parent: 0, gindex: 2, correct: false},
+		)
+		violations, err := checkSubgameWinners(ctx, claims, maxDepth, provider)
+		require.NoError(t, err)
+		require.Equal(t, []string{"subgame of claim 0 was won by dishonest claim 1"}, errorStrings(violations))
+	})
+
+	t.Run("HonestClaimLosesSubgame", func(t *testing.T) {
+		claims := integrityClaims(t, provider, maxDepth,
+			integrityClaim{parent: root, gindex: 1, correct: false},
+			integrityClaim{parent: 0, gindex: 2, correct: true},
+			integrityClaim{parent: 1, gindex: 6, correct: false},
+		)
+		violations, err := checkSubgameWinners(ctx, claims, maxDepth, provider)
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"claim 0 disputed by the honest actor won its subgame",
+			"honest claim 1 lost its subgame to claim 2",
+			"claim 2 disputed by the honest actor won its subgame",
+		}, errorStrings(violations))
+	})
+}
@@ -0,0 +1,201 @@
+package disputegame
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// logSubscriber is the subset of ethclient.Client used to subscribe to logs.
+type logSubscriber interface {
+	SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- ethtypes.Log) (ethereum.Subscription, error)
+}
+
+// supportsSubscriptions detects if the client is able to subscribe to logs.
+// HTTP endpoints report rpc.ErrNotificationsUnsupported, in which case polling must be used.
+func supportsSubscriptions(ctx context.Context, client logSubscriber) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	sub, err := client.SubscribeFilterLogs(ctx, ethereum.FilterQuery{Addresses: []common.Address{{}}}, make(chan ethtypes.Log))
+	if errors.Is(err, rpc.ErrNotificationsUnsupported) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("detect subscription support: %w", err)
+	}
+	sub.Unsubscribe()
+	return true, nil
+}
+
+// waitForLogs calls cb each time a log matching query is received and otherwise at the specified rate until cb
+// returns true or an error. If subscriber is nil or the subscription fails, cb is only called at the specified rate,
+// identical to waitFor.
+func waitForLogs(ctx context.Context, clk clock.Clock, subscriber logSubscriber, query ethereum.FilterQuery, rate time.Duration, cb func() (bool, error)) error {
+	if subscriber == nil {
+		return waitFor(ctx, clk, rate, cb)
+	}
+	logs := make(chan ethtypes.Log, 10)
+	sub, err := subscriber.SubscribeFilterLogs(ctx, query, logs)
+	if err != nil {
+		return waitFor(ctx, clk, rate, cb)
+	}
+	defer sub.Unsubscribe()
+	subErr := sub.Err()
+
+	// Polling continues while subscribed as not every change, such as clocks expiring, emits a log.
+	tick := clk.NewTicker(rate)
+	defer tick.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-subErr:
+			// Subscription dropped so fall back to only polling.
+			subErr = nil
+			logs = nil
+			continue
+		case <-logs:
+		case <-tick.Ch():
+		}
+		done, err := cb()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+	}
+}
+
+// waitForGameLogs polls cb until it returns true or an error, additionally checking each time the game emits a log
+// if the client supports subscriptions.
+func (g *GameCore) waitForGameLogs(ctx context.Context, rate time.Duration, cb func() (bool, error)) error {
+	var subscriber logSubscriber
+	if g.subscriptions {
+		subscriber = g.client
+	}
+	return waitForLogs(ctx, g.clock, subscriber, ethereum.FilterQuery{Addresses: []common.Address{g.addr}}, rate, cb)
+}
+
+// SubscribeMoves delivers each move made in the game to the returned channel, starting from the current L1 block,
+// until ctx is done. Moves are streamed from a subscription when supported and polled for otherwise.
+func (g *FaultGameHelper) SubscribeMoves(ctx context.Context) <-chan *bindings.FaultDisputeGameMove {
+	moves := make(chan *bindings.FaultDisputeGameMove, 100)
+	if g.subscriptions {
+		sub, err := g.game.WatchMove(&bind.WatchOpts{Context: ctx}, moves, nil, nil, nil)
+		g.require.NoError(err, "subscribe to moves")
+		go func() {
+			<-ctx.Done()
+			sub.Unsubscribe()
+		}()
+		return moves
+	}
+
+	start, err := g.client.BlockNumber(ctx)
+	g.require.NoError(err, "get starting block number")
+	go func() {
+		next := start
+		_ = g.waitFor(ctx, time.Second, func() (bool, error) {
+			head, err := g.client.BlockNumber(ctx)
+			if err != nil || head < next {
+				return false, nil
+			}
+			end := head
+			iter, err := g.game.FilterMove(&bind.FilterOpts{Context: ctx, Start: next, End: &end}, nil, nil, nil)
+			if err != nil {
+				return false, nil
+			}
+			defer iter.Close()
+			for iter.Next() {
+				select {
+				case moves <- iter.Event:
+				case <-ctx.Done():
+					return false, ctx.Err()
+				}
+			}
+			next = head + 1
+			return false, nil
+		})
+	}()
+	return moves
+}
+
+// StatusChange is a change in the status of a game and the L1 block it was first observed in.
+type StatusChange struct {
+	Status      Status
+	BlockNumber uint64
+	Timestamp   uint64
+}
+
+// WatchStatus delivers a StatusChange to the returned channel each time the game's status changes, until ctx is
+// done. Changes caused by resolving the game are reported at the block containing the Resolved event.
+// The channel is closed when ctx is done.
+func (g *FaultGameHelper) WatchStatus(ctx context.Context) <-chan StatusChange {
+	last, err := g.LoadStatus(ctx)
+	g.require.NoError(err, "load initial game status")
+	changes := make(chan StatusChange, 10)
+	go func() {
+		defer close(changes)
+		_ = g.waitForGameLogs(ctx, time.Second, func() (bool, error) {
+			status, err := g.LoadStatus(ctx)
+			if err != nil || status == last {
+				return false, nil
+			}
+			change, err := g.statusChange(ctx, status)
+			if err != nil {
+				g.t.Logf("Failed to load status change of game %v to %v: %v", g.addr, status, err)
+				return false, nil
+			}
+			last = status
+			select {
+			case changes <- change:
+			case <-ctx.Done():
+				return false, ctx.Err()
+			}
+			return false, nil
+		})
+	}()
+	return changes
+}
+
+// statusChange loads the L1 block the game changed to status in. Resolution is reported at the block containing the
+// latest Resolved event and any other change at the current head.
+func (g *FaultGameHelper) statusChange(ctx context.Context, status Status) (StatusChange, error) {
+	var blockHash common.Hash
+	if status != StatusInProgress {
+		iter, err := g.game.FilterResolved(&bind.FilterOpts{Context: ctx, Start: g.creation.BlockNumber}, nil)
+		if err != nil {
+			return StatusChange{}, fmt.Errorf("filter resolved events: %w", err)
+		}
+		defer iter.Close()
+		for iter.Next() {
+			blockHash = iter.Event.Raw.BlockHash
+		}
+		if err := iter.Error(); err != nil {
+			return StatusChange{}, fmt.Errorf("iterate resolved events: %w", err)
+		}
+	}
+	var header *ethtypes.Header
+	var err error
+	if blockHash != (common.Hash{}) {
+		header, err = g.client.HeaderByHash(ctx, blockHash)
+	} else {
+		header, err = g.client.HeaderByNumber(ctx, nil)
+	}
+	if err != nil {
+		return StatusChange{}, fmt.Errorf("load block header: %w", err)
+	}
+	return StatusChange{
+		Status:      status,
+		BlockNumber: header.Number.Uint64(),
+		Timestamp:   header.Time,
+	}, nil
+}
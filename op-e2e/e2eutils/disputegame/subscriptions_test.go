@@ -0,0 +1,147 @@
+package disputegame
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type stubSubscription struct {
+	errs         chan error
+	unsubscribed atomic.Bool
+}
+
+func (s *stubSubscription) Err() <-chan error {
+	return s.errs
+}
+
+func (s *stubSubscription) Unsubscribe() {
+	s.unsubscribed.Store(true)
+}
+
+type stubSubscriber struct {
+	err  error
+	sub  *stubSubscription
+	logs chan chan<- ethtypes.Log
+}
+
+func newStubSubscriber(err error) *stubSubscriber {
+	return &stubSubscriber{
+		err:  err,
+		sub:  &stubSubscription{errs: make(chan error, 1)},
+		logs: make(chan chan<- ethtypes.Log, 1),
+	}
+}
+
+func (s *stubSubscriber) SubscribeFilterLogs(_ context.Context, _ ethereum.FilterQuery, ch chan<- ethtypes.Log) (ethereum.Subscription, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.logs <- ch
+	return s.sub, nil
+}
+
+func TestSupportsSubscriptions(t *testing.T) {
+	t.Run("Supported", func(t *testing.T) {
+		subscriber := newStubSubscriber(nil)
+		supported, err := supportsSubscriptions(context.Background(), subscriber)
+		require.NoError(t, err)
+		require.True(t, supported)
+		require.True(t, subscriber.sub.unsubscribed.Load(), "should unsubscribe after detection")
+	})
+
+	t.Run("NotificationsUnsupported", func(t *testing.T) {
+		supported, err := supportsSubscriptions(context.Background(), newStubSubscriber(fmt.Errorf("wrapped: %w", rpc.ErrNotificationsUnsupported)))
+		require.NoError(t, err)
+		require.False(t, supported)
+	})
+
+	t.Run("OtherError", func(t *testing.T) {
+		_, err := supportsSubscriptions(context.Background(), newStubSubscriber(errors.New("boom")))
+		require.Error(t, err)
+	})
+}
+
+func TestWaitForLogs(t *testing.T) {
+	run := func(t *testing.T, subscriber logSubscriber) (*clock.DeterministicClock, chan struct{}, *atomic.Bool, chan error) {
+		clk := clock.NewDeterministicClock(time.Unix(0, 0))
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		t.Cleanup(cancel)
+		calls := make(chan struct{}, 10)
+		done := new(atomic.Bool)
+		result := make(chan error, 1)
+		go func() {
+			result <- waitForLogs(ctx, clk, subscriber, ethereum.FilterQuery{}, time.Second, func() (bool, error) {
+				calls <- struct{}{}
+				return done.Load(), nil
+			})
+		}()
+		require.True(t, clk.WaitForNewPendingTaskWithTimeout(5*time.Second), "ticker should be created")
+		return clk, calls, done, result
+	}
+
+	t.Run("PollsWithoutSubscriber", func(t *testing.T) {
+		clk, calls, done, result := run(t, nil)
+		require.Never(t, func() bool { return len(calls) > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+		done.Store(true)
+		clk.AdvanceTime(time.Second)
+		<-calls
+		require.NoError(t, <-result)
+	})
+
+	t.Run("FallbackWhenSubscribeFails", func(t *testing.T) {
+		clk, calls, done, result := run(t, newStubSubscriber(rpc.ErrNotificationsUnsupported))
+		require.Never(t, func() bool { return len(calls) > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+		done.Store(true)
+		clk.AdvanceTime(time.Second)
+		<-calls
+		require.NoError(t, <-result)
+	})
+
+	t.Run("ChecksOnLog", func(t *testing.T) {
+		subscriber := newStubSubscriber(nil)
+		_, calls, done, result := run(t, subscriber)
+		logs := <-subscriber.logs
+		require.Never(t, func() bool { return len(calls) > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+
+		// Each log triggers a check without the clock advancing
+		logs <- ethtypes.Log{}
+		<-calls
+		done.Store(true)
+		logs <- ethtypes.Log{}
+		<-calls
+		require.NoError(t, <-result)
+		require.True(t, subscriber.sub.unsubscribed.Load())
+	})
+
+	t.Run("PollsWhileSubscribed", func(t *testing.T) {
+		subscriber := newStubSubscriber(nil)
+		clk, calls, done, result := run(t, subscriber)
+		<-subscriber.logs
+		done.Store(true)
+		clk.AdvanceTime(time.Second)
+		<-calls
+		require.NoError(t, <-result)
+	})
+
+	t.Run("FallbackWhenSubscriptionFails", func(t *testing.T) {
+		subscriber := newStubSubscriber(nil)
+		clk, calls, done, result := run(t, subscriber)
+		<-subscriber.logs
+		subscriber.sub.errs <- errors.New("connection lost")
+		require.Never(t, func() bool { return len(calls) > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+		done.Store(true)
+		clk.AdvanceTime(time.Second)
+		<-calls
+		require.NoError(t, <-result)
+	})
+}
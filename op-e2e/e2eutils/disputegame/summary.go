@@ -0,0 +1,295 @@
+package disputegame
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ArtifactsDirEnvVar is the environment variable specifying the directory to write test summaries to.
+// Summaries are only written when it is set.
+const ArtifactsDirEnvVar = "OP_E2E_ARTIFACTS_DIR"
+
+// TestSummary is the dispute game activity of a single test, written to the artifacts dir when the test completes.
+type TestSummary struct {
+	// Test is the full name of the test, including any subtests.
+	Test  string        `json:"test"`
+	Games []GameSummary `json:"games"`
+	// SoftFailed is true if any helper check failed but was configured to only log a warning.
+	SoftFailed   bool     `json:"softFailed"`
+	SoftFailures []string `json:"softFailures"`
+	// OutputProposals are the proposals in the L2OutputOracle when the test completed.
+	OutputProposals []OutputProposal `json:"outputProposals"`
+	// OutputsLoadError is set if the output proposals couldn't be loaded.
+	OutputsLoadError string `json:"outputsLoadError,omitempty"`
+}
+
+// GameSummary is the activity of a single game used by a test.
+type GameSummary struct {
+	Address    common.Address `json:"address"`
+	GameType   uint8          `json:"gameType"`
+	RootClaim  common.Hash    `json:"rootClaim"`
+	CreatedAt  GameCreation   `json:"createdAt"`
+	Status     string         `json:"status"`
+	StatusCode Status         `json:"statusCode"`
+	// MovesByActor is the number of moves made by each account.
+	MovesByActor map[common.Address]int `json:"movesByActor"`
+	// TotalGas is the gas used by the transactions that created, moved in and resolved the game.
+	// Steps don't emit an event so can't be found efficiently and are not included.
+	TotalGas uint64 `json:"totalGas"`
+	// ResolutionSeconds is the L1 time between creating and resolving the game, or nil if it hasn't resolved.
+	ResolutionSeconds *uint64 `json:"resolutionSeconds"`
+	// DisputedOutputIndex is the index in the L2OutputOracle of the output proposal the game disputes.
+	DisputedOutputIndex uint64 `json:"disputedOutputIndex"`
+	// L2BlockNumber and L1HeadNumber are decoded from the game's extra data. Games for the same output and root claim
+	// are only distinguished by their L1 head. L1HeadNumber is 0 if the extra data doesn't include it.
+	L2BlockNumber uint64 `json:"l2BlockNumber"`
+	L1HeadNumber  uint64 `json:"l1HeadNumber"`
+	// LoadError is set if the game's activity couldn't be fully loaded, in which case the summary may be incomplete.
+	LoadError string `json:"loadError,omitempty"`
+}
+
+var (
+	summariesLock sync.Mutex
+	summaries     = make(map[*testing.T]*summaryCollector)
+)
+
+// summaryCollector records the dispute game activity of a test from every helper created for it.
+type summaryCollector struct {
+	t *testing.T
+
+	lock         sync.Mutex
+	factory      *FactoryCore
+	games        []*GameCore
+	creations    map[common.Address]GameCreation
+	softFailures []string
+}
+
+// summaryFor returns the collector for t, creating it if required. The summary is written when t completes.
+func summaryFor(t *testing.T) *summaryCollector {
+	summariesLock.Lock()
+	defer summariesLock.Unlock()
+	if c, ok := summaries[t]; ok {
+		return c
+	}
+	c := &summaryCollector{
+		t:         t,
+		creations: make(map[common.Address]GameCreation),
+	}
+	summaries[t] = c
+	// Cleanups run in reverse order so this runs before any system created earlier in the test is stopped.
+	t.Cleanup(func() {
+		summariesLock.Lock()
+		delete(summaries, t)
+		summariesLock.Unlock()
+		c.write()
+	})
+	return c
+}
+
+// trackGame adds game to the summary. Games already being tracked are ignored.
+func (c *summaryCollector) trackGame(game *GameCore, creation GameCreation) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.creations[game.addr]; ok {
+		return
+	}
+	c.games = append(c.games, game)
+	c.creations[game.addr] = creation
+}
+
+// trackOutputs includes the output proposals in the L2OutputOracle used by factory in the summary.
+// Only the first factory is used as all factories in a test share the same system.
+func (c *summaryCollector) trackOutputs(factory *FactoryCore) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.factory == nil {
+		c.factory = factory
+	}
+}
+
+// softFail records a failed check that doesn't fail the test and logs it as a warning.
+func (c *summaryCollector) softFail(err error) {
+	c.t.Logf("WARNING: %v", err)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.softFailures = append(c.softFailures, err.Error())
+}
+
+func (c *summaryCollector) write() {
+	dir := os.Getenv(ArtifactsDirEnvVar)
+	if dir == "" && !c.t.Failed() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	summary := c.summary(ctx)
+	if c.t.Failed() {
+		logOutputProposals(c.t, summary)
+	}
+	if dir == "" {
+		return
+	}
+	data, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		c.t.Logf("WARNING: failed to encode dispute game summary: %v", err)
+		return
+	}
+	path := filepath.Join(dir, summaryFileName(c.t.Name()))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		c.t.Logf("WARNING: failed to create artifacts dir %v: %v", dir, err)
+		return
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		c.t.Logf("WARNING: failed to write dispute game summary to %v: %v", path, err)
+	}
+}
+
+func (c *summaryCollector) summary(ctx context.Context) TestSummary {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	summary := TestSummary{
+		Test:         c.t.Name(),
+		Games:        make([]GameSummary, 0, len(c.games)),
+		SoftFailed:   len(c.softFailures) > 0,
+		SoftFailures: append([]string{}, c.softFailures...),
+	}
+	for _, game := range c.games {
+		summary.Games = append(summary.Games, summariseGame(ctx, game, c.creations[game.addr]))
+	}
+	if c.factory != nil {
+		proposals, err := c.factory.LoadOutputProposals(ctx)
+		if err != nil {
+			summary.OutputsLoadError = err.Error()
+		}
+		summary.OutputProposals = proposals
+	}
+	return summary
+}
+
+// GameSummaries returns the summary of each game the test has used so far, in the order they were first used.
+func (h *FactoryHelper) GameSummaries(ctx context.Context) []GameSummary {
+	return h.summary.summary(ctx).Games
+}
+
+// logOutputProposals logs the output proposals in summary so the outputs available to a failed test can be seen.
+func logOutputProposals(t *testing.T, summary TestSummary) {
+	if summary.OutputsLoadError != "" {
+		t.Logf("Failed to load output proposals: %v", summary.OutputsLoadError)
+		return
+	}
+	for _, proposal := range summary.OutputProposals {
+		t.Logf("Output proposal %v: L2 block %v, output root %v, proposed at %v by %v",
+			proposal.Index, proposal.L2BlockNumber, proposal.OutputRoot, proposal.Timestamp, proposal.Proposer)
+	}
+	for _, game := range summary.Games {
+		t.Logf("Game %v disputes output proposal %v for L2 block %v with L1 head %v",
+			game.Address, game.DisputedOutputIndex, game.L2BlockNumber, game.L1HeadNumber)
+	}
+}
+
+// summariseGame loads the final state and activity of game. Errors are recorded in the summary as the test has
+// already completed.
+func summariseGame(ctx context.Context, game *GameCore, creation GameCreation) GameSummary {
+	summary := GameSummary{
+		Address:      game.addr,
+		CreatedAt:    creation,
+		MovesByActor: make(map[common.Address]int),
+	}
+	if err := loadGameSummary(ctx, game, &summary); err != nil {
+		summary.LoadError = err.Error()
+	}
+	return summary
+}
+
+func loadGameSummary(ctx context.Context, game *GameCore, summary *GameSummary) error {
+	opts := &bind.CallOpts{Context: ctx}
+	var err error
+	if summary.GameType, err = game.game.GameType(opts); err != nil {
+		return fmt.Errorf("load game type: %w", err)
+	}
+	rootClaim, err := game.game.RootClaim(opts)
+	if err != nil {
+		return fmt.Errorf("load root claim: %w", err)
+	}
+	summary.RootClaim = rootClaim
+	status, err := game.game.Status(opts)
+	if err != nil {
+		return fmt.Errorf("load status: %w", err)
+	}
+	summary.StatusCode = Status(status)
+	summary.Status = summary.StatusCode.String()
+	proposals, err := game.game.Proposals(opts)
+	if err != nil {
+		return fmt.Errorf("load proposals: %w", err)
+	}
+	summary.DisputedOutputIndex = proposals.Disputed.Index.Uint64()
+	if summary.L2BlockNumber, summary.L1HeadNumber, err = loadExtraData(ctx, game.game); err != nil {
+		return err
+	}
+
+	txs := []common.Hash{summary.CreatedAt.TxHash}
+	filterOpts := &bind.FilterOpts{Context: ctx, Start: summary.CreatedAt.BlockNumber}
+	moves, err := game.game.FilterMove(filterOpts, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("filter move events: %w", err)
+	}
+	defer moves.Close()
+	for moves.Next() {
+		summary.MovesByActor[moves.Event.Claimant]++
+		txs = append(txs, moves.Event.Raw.TxHash)
+	}
+	if err := moves.Error(); err != nil {
+		return fmt.Errorf("iterate move events: %w", err)
+	}
+
+	resolved, err := game.game.FilterResolved(filterOpts, nil)
+	if err != nil {
+		return fmt.Errorf("filter resolved events: %w", err)
+	}
+	defer resolved.Close()
+	if resolved.Next() {
+		header, err := game.client.HeaderByHash(ctx, resolved.Event.Raw.BlockHash)
+		if err != nil {
+			return fmt.Errorf("load resolution block %v: %w", resolved.Event.Raw.BlockHash, err)
+		}
+		duration := header.Time - summary.CreatedAt.Timestamp
+		summary.ResolutionSeconds = &duration
+		txs = append(txs, resolved.Event.Raw.TxHash)
+	}
+	if err := resolved.Error(); err != nil {
+		return fmt.Errorf("iterate resolved events: %w", err)
+	}
+
+	seen := make(map[common.Hash]bool)
+	for _, txHash := range txs {
+		// Multiple events may be emitted by the same transaction, for example when moves are batched.
+		if txHash == (common.Hash{}) || seen[txHash] {
+			continue
+		}
+		seen[txHash] = true
+		rcpt, err := game.client.TransactionReceipt(ctx, txHash)
+		if err != nil {
+			return fmt.Errorf("load receipt for tx %v: %w", txHash, err)
+		}
+		summary.TotalGas += rcpt.GasUsed
+	}
+	return nil
+}
+
+var unsafeFileNameChars = regexp.MustCompile(`[^a-zA-Z0-9_.-]+`)
+
+// summaryFileName returns the file name for the summary of the test named testName.
+// Subtest names include a / separator so are flattened to a single file name.
+func summaryFileName(testName string) string {
+	return unsafeFileNameChars.ReplaceAllString(testName, "_") + ".dispute-games.json"
+}
@@ -0,0 +1,106 @@
+package disputegame
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSummaryFormat guards the JSON field names of test summaries so dashboards built on them keep working.
+func TestSummaryFormat(t *testing.T) {
+	resolution := uint64(300)
+	summary := TestSummary{
+		Test: "TestExample/Subtest",
+		Games: []GameSummary{{
+			Address:             common.Address{0x01},
+			GameType:            alphabetGameType,
+			RootClaim:           common.Hash{0x02},
+			CreatedAt:           GameCreation{BlockNumber: 10, TxHash: common.Hash{0x03}, Timestamp: 20},
+			Status:              StatusChallengerWins.String(),
+			StatusCode:          StatusChallengerWins,
+			MovesByActor:        map[common.Address]int{{0x04}: 2, {0x05}: 1},
+			TotalGas:            123456,
+			ResolutionSeconds:   &resolution,
+			DisputedOutputIndex: 3,
+			L2BlockNumber:       8,
+			L1HeadNumber:        12,
+		}},
+		SoftFailed:   true,
+		SoftFailures: []string{"root claim should be honest"},
+		OutputProposals: []OutputProposal{{
+			Index:         3,
+			OutputRoot:    common.Hash{0x06},
+			L2BlockNumber: 8,
+			Timestamp:     30,
+			Proposer:      common.Address{0x07},
+		}},
+	}
+	data, err := json.Marshal(summary)
+	require.NoError(t, err)
+
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(data, &fields))
+	require.ElementsMatch(t, []string{"test", "games", "softFailed", "softFailures", "outputProposals"}, keys(fields))
+
+	var games []map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(fields["games"], &games))
+	require.Len(t, games, 1)
+	require.ElementsMatch(t, []string{
+		"address", "gameType", "rootClaim", "createdAt", "status", "statusCode", "movesByActor", "totalGas",
+		"resolutionSeconds", "disputedOutputIndex", "l2BlockNumber", "l1HeadNumber",
+	}, keys(games[0]))
+	require.JSONEq(t, `{
+		"0x0400000000000000000000000000000000000000": 2,
+		"0x0500000000000000000000000000000000000000": 1
+	}`, string(games[0]["movesByActor"]))
+
+	var proposals []map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(fields["outputProposals"], &proposals))
+	require.Len(t, proposals, 1)
+	require.ElementsMatch(t, []string{"index", "outputRoot", "l2BlockNumber", "timestamp", "proposer"}, keys(proposals[0]))
+
+	var decoded TestSummary
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, summary, decoded)
+
+	t.Run("Unresolved", func(t *testing.T) {
+		data, err := json.Marshal(GameSummary{LoadError: "boom"})
+		require.NoError(t, err)
+		var fields map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &fields))
+		require.Equal(t, "null", string(fields["resolutionSeconds"]))
+		require.Equal(t, `"boom"`, string(fields["loadError"]))
+	})
+}
+
+func TestSummaryFileName(t *testing.T) {
+	require.Equal(t, "TestExample.dispute-games.json", summaryFileName("TestExample"))
+	require.Equal(t, "TestExample_Sub_test_1.dispute-games.json", summaryFileName("TestExample/Sub test#1"))
+}
+
+func TestWriteSummary(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(ArtifactsDirEnvVar, dir)
+
+	t.Run("Sub", func(t *testing.T) {
+		collector := summaryFor(t)
+		require.Same(t, collector, summaryFor(t), "helpers in the same test should share a collector")
+		collector.softFail(errors.New("intent mismatch"))
+	})
+
+	data, err := os.ReadFile(filepath.Join(dir, "TestWriteSummary_Sub.dispute-games.json"))
+	require.NoError(t, err)
+	var summary TestSummary
+	require.NoError(t, json.Unmarshal(data, &summary))
+	require.Equal(t, TestSummary{
+		Test:         "TestWriteSummary/Sub",
+		Games:        []GameSummary{},
+		SoftFailed:   true,
+		SoftFailures: []string{"intent mismatch"},
+	}, summary)
+}
@@ -0,0 +1,100 @@
+package disputegame
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GameResolution reports what a resolution sweep did to a single game.
+type GameResolution struct {
+	Game common.Address
+	// Status is the status of the game after the sweep.
+	Status Status
+	// Resolved is true if the game was resolved by the sweep.
+	Resolved bool
+	// SkipReason explains why the game was not resolved, if it wasn't.
+	SkipReason string
+}
+
+// CreditClaim reports the credit claimed from a single game by a sweep.
+type CreditClaim struct {
+	Game common.Address
+	// Supported is false if the game does not support bonds, in which case nothing was claimed.
+	Supported bool
+	// Amount is the amount of credit claimed.
+	Amount *big.Int
+}
+
+// ResolveAllResolvableGames resolves every game created by the factory that is still in progress but whose clocks
+// have expired, resolving each subgame first if required. Games that are already resolved or still have time
+// remaining are skipped. Returns a report for every game, in creation order.
+func (h *FactoryHelper) ResolveAllResolvableGames(ctx context.Context) []GameResolution {
+	var results []GameResolution
+	h.forEachGame(ctx, func(game *FaultGameHelper) {
+		result := GameResolution{Game: game.addr}
+		status, err := game.LoadStatus(ctx)
+		h.require.NoError(err)
+		switch {
+		case status != StatusInProgress:
+			result.SkipReason = "already resolved"
+		case !game.canResolve(ctx):
+			result.SkipReason = "clocks not expired"
+		default:
+			game.Resolve(ctx)
+			result.Resolved = true
+			status, err = game.LoadStatus(ctx)
+			h.require.NoError(err)
+		}
+		result.Status = status
+		results = append(results, result)
+	})
+	return results
+}
+
+// ClaimAllCredit claims the credit available to beneficiary from every game created by the factory.
+// Returns a report for every game, in creation order.
+func (h *FactoryHelper) ClaimAllCredit(ctx context.Context, beneficiary common.Address) []CreditClaim {
+	var results []CreditClaim
+	h.forEachGame(ctx, func(game *FaultGameHelper) {
+		result := CreditClaim{Game: game.addr, Amount: new(big.Int)}
+		if game.SupportsBonds(ctx) {
+			result.Supported = true
+			result.Amount = game.Credit(ctx, beneficiary)
+			if result.Amount.Sign() > 0 {
+				h.require.NoErrorf(game.sendRawTx(ctx, claimCreditCalldata(beneficiary)), "claim credit from game %v", game.addr)
+			}
+		}
+		results = append(results, result)
+	})
+	return results
+}
+
+// forEachGame calls fn with a helper for each game created by the factory, in creation order.
+func (h *FactoryHelper) forEachGame(ctx context.Context, fn func(game *FaultGameHelper)) {
+	addrs, err := h.GameAddresses(ctx)
+	h.require.NoError(err)
+	for _, addr := range addrs {
+		fn(h.WrapGame(ctx, addr))
+	}
+}
+
+// canResolve returns true if resolving the game would currently succeed.
+// When subgames must be resolved first, the most recent claim is checked as it has the latest clock.
+func (g *FaultGameHelper) canResolve(ctx context.Context) bool {
+	var data []byte
+	if g.SupportsSubgameResolution(ctx) {
+		count := g.ClaimCount(ctx)
+		data = append(append([]byte{}, resolveClaimSelector...), common.BigToHash(big.NewInt(count-1)).Bytes()...)
+	} else {
+		fdgAbi, err := bindings.FaultDisputeGameMetaData.GetAbi()
+		g.require.NoError(err)
+		data, err = fdgAbi.Pack("resolve")
+		g.require.NoError(err)
+	}
+	_, err := g.client.CallContract(ctx, ethereum.CallMsg{From: g.opts.From, To: &g.addr, Data: data}, nil)
+	return err == nil
+}
@@ -0,0 +1,185 @@
+package disputegame
+
+import (
+	"context"
+	"debug/elf"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/cannon/mipsevm"
+	preimage "github.com/ethereum-optimism/optimism/op-preimage"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// testProgramGameType is the game type registered for cannon games of the test program, so games of the devnet's
+// cannon game type keep using the op-program prestate.
+const testProgramGameType uint8 = 2
+
+// testProgramGameDepth is the max depth of test program games. The program exits after 100 steps so the game covers
+// its whole trace, and the depth is odd for the same reason as fixtureGameDepth.
+const testProgramGameDepth = 7
+
+// testProgramMaxSteps bounds the execution of the test program, so a broken program fails instead of never exiting.
+const testProgramMaxSteps = 1000
+
+// testProgramL1HeadKey is the key of the only preimage read by the test program.
+var testProgramL1HeadKey = preimage.LocalIndexKey(1).PreimageKey()
+
+// LoadTestProgram loads the initial state of the MIPS program in the ELF file at path. Unlike op-program, the test
+// program is not a Go binary so its state isn't patched to run the Go runtime.
+func LoadTestProgram(path string) (*mipsevm.State, error) {
+	file, err := elf.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open test program %v: %w", path, err)
+	}
+	defer file.Close()
+	state, err := mipsevm.LoadELF(file)
+	if err != nil {
+		return nil, fmt.Errorf("load test program %v: %w", path, err)
+	}
+	return state, nil
+}
+
+// TestProgramPrestate returns the absolute prestate hash of the MIPS program in the ELF file at path.
+func TestProgramPrestate(path string) (common.Hash, error) {
+	state, err := LoadTestProgram(path)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(state.EncodeWitness()), nil
+}
+
+// StartTestProgramCannonGame creates a cannon game for the MIPS test program in programPath, which reads the game's
+// L1 head from the preimage oracle and exits. A cannon implementation using the program's prestate is deployed and
+// registered with the factory as testProgramGameType, so the helper must be created with WithFactoryOwner.
+// The program's trace is generated in-process when the game is created and the game's root claim is its final state.
+// Challengers started via the returned helper execute the program with cannon, using op-program as the preimage
+// server, and their claims can be checked against the in-process trace via ExpectedTrace.
+func (h *FactoryHelper) StartTestProgramCannonGame(ctx context.Context, programPath string) *CannonGameHelper {
+	prestate, err := TestProgramPrestate(programPath)
+	h.require.NoError(err)
+	h.require.NotNil(h.ownerOpts, "factory owner not configured")
+
+	h.waitForProposals(ctx)
+	ctx, cancel := h.withTimeout(ctx, 2*time.Minute)
+	defer cancel()
+	params, err := h.loadImplementationParams(ctx, cannonGameType)
+	h.require.NoError(err)
+	params.prestate = prestate
+	params.maxDepth = big.NewInt(testProgramGameDepth)
+	impl, err := h.deployImplementation(ctx, testProgramGameType, params)
+	h.require.NoError(err, "deploy test program implementation")
+	h.SetImplementation(ctx, testProgramGameType, impl)
+	h.applyGameDuration(ctx, testProgramGameType)
+
+	l1Head := h.checkpointL1Block(ctx)
+	block, err := h.blockOracle.Load(&bind.CallOpts{Context: ctx}, l1Head)
+	h.require.NoErrorf(err, "load L1 block %v from block oracle", l1Head)
+	traceDir := h.t.TempDir()
+	final, err := writeTestProgramTrace(programPath, block.Hash, traceDir)
+	h.require.NoError(err, "generate test program trace")
+	rootClaim := crypto.Keccak256Hash(final.EncodeWitness())
+	addr, err := h.CreateGame(ctx, testProgramGameType, rootClaim, makeExtraData(l1Head.Uint64()))
+	h.require.NoError(err, "create test program game")
+	game := &CannonGameHelper{
+		FaultGameHelper:  h.newGameHelper(ctx, addr, testProgramGameDepth),
+		absolutePreState: filepath.Join(traceDir, "prestate.json"),
+		expectedTraceDir: traceDir,
+	}
+	h.require.EqualValues(block.Hash, game.L1Head(ctx), "test program trace should use the game's L1 head")
+	return game
+}
+
+// testProgramProof is a proof for a single step of the test program, in the format written by cannon run.
+type testProgramProof struct {
+	Post         common.Hash   `json:"post"`
+	StateData    hexutil.Bytes `json:"state-data"`
+	ProofData    hexutil.Bytes `json:"proof-data"`
+	OracleKey    hexutil.Bytes `json:"oracle-key,omitempty"`
+	OracleValue  hexutil.Bytes `json:"oracle-value,omitempty"`
+	OracleOffset uint32        `json:"oracle-offset,omitempty"`
+}
+
+// writeTestProgramTrace executes the test program in programPath, reading l1Head as its L1 head, and writes its
+// trace to dir in the cannon fixture format so it can be used by cannon.NewFixtureTraceProvider.
+// The final state of the program is returned.
+func writeTestProgramTrace(programPath string, l1Head common.Hash, dir string) (*mipsevm.State, error) {
+	state, err := LoadTestProgram(programPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "proofs"), 0755); err != nil {
+		return nil, fmt.Errorf("create proofs dir: %w", err)
+	}
+	if err := writeJSONFile(filepath.Join(dir, "prestate.json"), state); err != nil {
+		return nil, err
+	}
+	oracle := &testProgramOracle{l1Head: l1Head}
+	vm := mipsevm.NewInstrumentedState(state, oracle, io.Discard, io.Discard)
+	for !state.Exited {
+		if state.Step >= testProgramMaxSteps {
+			return nil, fmt.Errorf("test program did not exit within %v steps", testProgramMaxSteps)
+		}
+		step := state.Step
+		witness, err := vm.Step(true)
+		if err != nil {
+			return nil, fmt.Errorf("execute test program step %v: %w", step, err)
+		}
+		if oracle.err != nil {
+			return nil, oracle.err
+		}
+		proof := testProgramProof{
+			Post:      crypto.Keccak256Hash(state.EncodeWitness()),
+			StateData: witness.State,
+			ProofData: witness.MemProof,
+		}
+		if witness.HasPreimage() {
+			proof.OracleKey = witness.PreimageKey[:]
+			proof.OracleValue = witness.PreimageValue
+			proof.OracleOffset = witness.PreimageOffset
+		}
+		if err := writeJSONFile(filepath.Join(dir, "proofs", fmt.Sprintf("%d.json", step)), proof); err != nil {
+			return nil, err
+		}
+	}
+	if err := writeJSONFile(filepath.Join(dir, "final.json"), state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// testProgramOracle serves the preimages read by the test program. The program only reads its L1 head, so any other
+// key is recorded as an error as the oracle interface has no way to report it.
+type testProgramOracle struct {
+	l1Head common.Hash
+	err    error
+}
+
+func (o *testProgramOracle) Hint(_ []byte) {}
+
+func (o *testProgramOracle) GetPreimage(key [32]byte) []byte {
+	if key != testProgramL1HeadKey {
+		o.err = fmt.Errorf("test program requested unexpected preimage %v", common.Hash(key))
+		return nil
+	}
+	return o.l1Head.Bytes()
+}
+
+func writeJSONFile(path string, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("encode %v: %w", path, err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("write %v: %w", path, err)
+	}
+	return nil
+}
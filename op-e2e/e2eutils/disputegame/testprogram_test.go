@@ -0,0 +1,70 @@
+package disputegame
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/cannon"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const testProgramPath = "../../testdata/cannon-test-program/program.elf"
+
+func TestWriteTestProgramTrace(t *testing.T) {
+	l1Head := common.Hash{0xab, 0xcd}
+	dir := t.TempDir()
+	final, err := writeTestProgramTrace(testProgramPath, l1Head, dir)
+	require.NoError(t, err)
+	require.True(t, final.Exited)
+	require.Zero(t, final.ExitCode)
+	require.EqualValues(t, 100, final.Step)
+
+	// The program exits with the end of the buffer it read the length prefixed L1 head into in a1
+	bufferEnd := final.Registers[5]
+	data, err := io.ReadAll(final.Memory.ReadMemoryRange(bufferEnd-40, 40))
+	require.NoError(t, err)
+	require.Equal(t, append([]byte{0, 0, 0, 0, 0, 0, 0, 32}, l1Head.Bytes()...), data)
+
+	fixture, err := cannon.LoadFixture(dir)
+	require.NoError(t, err)
+	prestate, err := TestProgramPrestate(testProgramPath)
+	require.NoError(t, err)
+	require.Equal(t, prestate, crypto.Keccak256Hash(fixture.Prestate.EncodeWitness()))
+	require.Equal(t, crypto.Keccak256Hash(final.EncodeWitness()), crypto.Keccak256Hash(fixture.Final.EncodeWitness()))
+
+	provider, err := cannon.NewFixtureTraceProvider(&config.Config{CannonFixtureDir: dir, CannonDatadir: t.TempDir()})
+	require.NoError(t, err)
+	ctx := context.Background()
+	last, err := provider.Get(ctx, final.Step-1)
+	require.NoError(t, err)
+	require.Equal(t, crypto.Keccak256Hash(final.EncodeWitness()), last)
+	extended, err := provider.Get(ctx, 1<<testProgramGameDepth-1)
+	require.NoError(t, err)
+	require.Equal(t, last, extended, "trace should be extended with the final state")
+
+	oracleReads := 0
+	for i := uint64(0); i < final.Step; i++ {
+		data, err := provider.GetOracleData(ctx, i)
+		require.NoError(t, err)
+		if len(data.OracleKey) == 0 {
+			continue
+		}
+		oracleReads++
+		require.True(t, data.IsLocal, "step %v should read a local preimage", i)
+		require.Equal(t, testProgramL1HeadKey[:], data.OracleKey)
+	}
+	require.Equal(t, 10, oracleReads, "preimage should be read 4 bytes at a time")
+}
+
+func TestWriteTestProgramTraceDependsOnL1Head(t *testing.T) {
+	first, err := writeTestProgramTrace(testProgramPath, common.Hash{0x01}, t.TempDir())
+	require.NoError(t, err)
+	second, err := writeTestProgramTrace(testProgramPath, common.Hash{0x02}, t.TempDir())
+	require.NoError(t, err)
+	require.Equal(t, first.Step, second.Step)
+	require.NotEqual(t, crypto.Keccak256Hash(first.EncodeWitness()), crypto.Keccak256Hash(second.EncodeWitness()))
+}
@@ -0,0 +1,176 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ThroughputReport summarises how an honest challenger kept up with a set of games it played concurrently.
+type ThroughputReport struct {
+	Games int
+	// MaxConcurrent is the most games that were in progress at the same time.
+	MaxConcurrent int
+	// FirstResponse is the time from each game's creation until the challenger's first move in it. Games the
+	// challenger hasn't moved in are omitted.
+	FirstResponse map[common.Address]time.Duration
+	// MissedClocks are the claims whose clock expired without the honest move against them being made.
+	MissedClocks []MissedClock
+}
+
+// MissedClock is a claim the honest move against wasn't made before the mover's clock expired.
+type MissedClock struct {
+	Game       common.Address
+	ClaimIndex int64
+	// Deadline is the L1 timestamp the mover's clock expired at.
+	Deadline uint64
+}
+
+// ThroughputGame is a game to include in a throughput report.
+type ThroughputGame struct {
+	Addr     common.Address
+	MaxDepth int
+	// Honest decides the moves an honest player must make in the game. Missed clocks aren't checked if nil.
+	Honest Strategy
+}
+
+// gameTimeline is when a game was in progress. resolved is zero while the game is still in progress.
+type gameTimeline struct {
+	created  uint64
+	resolved uint64
+}
+
+// LoadThroughputReport reports how claimant, the honest challenger playing every game in games, kept up with them.
+func (c *FactoryCore) LoadThroughputReport(ctx context.Context, games []ThroughputGame, claimant common.Address) (ThroughputReport, error) {
+	report := ThroughputReport{
+		Games:         len(games),
+		FirstResponse: make(map[common.Address]time.Duration),
+	}
+	var timelines []gameTimeline
+	for _, game := range games {
+		core, err := c.Game(game.Addr)
+		if err != nil {
+			return ThroughputReport{}, err
+		}
+		creation, err := c.GameCreation(ctx, game.Addr)
+		if err != nil {
+			return ThroughputReport{}, err
+		}
+		resolved, err := core.loadResolvedAt(ctx, creation.BlockNumber)
+		if err != nil {
+			return ThroughputReport{}, err
+		}
+		timelines = append(timelines, gameTimeline{created: creation.Timestamp, resolved: resolved})
+
+		moves, err := core.loadMovesSince(ctx, creation.BlockNumber)
+		if err != nil {
+			return ThroughputReport{}, err
+		}
+		for _, move := range moves {
+			if move.Claimant == claimant {
+				report.FirstResponse[game.Addr] = time.Duration(move.Timestamp-creation.Timestamp) * time.Second
+				break
+			}
+		}
+
+		if game.Honest == nil {
+			continue
+		}
+		tree, err := core.snapshot(ctx, game.MaxDepth, creation.BlockNumber)
+		if err != nil {
+			return ThroughputReport{}, err
+		}
+		tree.Self = claimant
+		required, err := game.Honest.NextMoves(ctx, tree)
+		if err != nil {
+			return ThroughputReport{}, fmt.Errorf("calculate honest moves in game %v: %w", game.Addr, err)
+		}
+		report.MissedClocks = append(report.MissedClocks, missedClocks(game.Addr, tree, required)...)
+	}
+	report.MaxConcurrent = maxConcurrentGames(timelines)
+	return report, nil
+}
+
+// loadResolvedAt returns the timestamp of the L1 block the game was resolved in, or zero if it hasn't been resolved,
+// searching for the Resolved event from the L1 block start.
+func (g *GameCore) loadResolvedAt(ctx context.Context, start uint64) (uint64, error) {
+	iter, err := g.game.FilterResolved(&bind.FilterOpts{Context: ctx, Start: start}, nil)
+	if err != nil {
+		return 0, fmt.Errorf("filter resolved events for game %v: %w", g.addr, err)
+	}
+	defer iter.Close()
+	if !iter.Next() {
+		if err := iter.Error(); err != nil {
+			return 0, fmt.Errorf("iterate resolved events for game %v: %w", g.addr, err)
+		}
+		return 0, nil
+	}
+	header, err := g.client.HeaderByHash(ctx, iter.Event.Raw.BlockHash)
+	if err != nil {
+		return 0, fmt.Errorf("load block %v for resolution of game %v: %w", iter.Event.Raw.BlockHash, g.addr, err)
+	}
+	return header.Time, nil
+}
+
+// maxConcurrentGames returns the most games that were in progress at the same time. A game resolved in the same
+// second another was created isn't counted as overlapping with it.
+func maxConcurrentGames(timelines []gameTimeline) int {
+	type event struct {
+		time  uint64
+		delta int
+	}
+	var events []event
+	for _, timeline := range timelines {
+		events = append(events, event{time: timeline.created, delta: 1})
+		if timeline.resolved != 0 {
+			events = append(events, event{time: timeline.resolved, delta: -1})
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].time != events[j].time {
+			return events[i].time < events[j].time
+		}
+		return events[i].delta < events[j].delta
+	})
+	current, max := 0, 0
+	for _, e := range events {
+		current += e.delta
+		if current > max {
+			max = current
+		}
+	}
+	return max
+}
+
+// missedClocks returns the moves in required that haven't been made in tree even though the mover's clock has
+// expired.
+func missedClocks(game common.Address, tree GameSnapshot, required []Move) []MissedClock {
+	var missed []MissedClock
+	for _, move := range required {
+		if _, ok := existingMove(tree.Claims, move.ParentIdx, move.Value, move.IsAttack); ok {
+			continue
+		}
+		deadline := responseDeadline(tree.Claims, move.ParentIdx, tree.GameDuration)
+		if tree.Now > deadline {
+			missed = append(missed, MissedClock{Game: game, ClaimIndex: move.ParentIdx, Deadline: deadline})
+		}
+	}
+	return missed
+}
+
+// responseDeadline returns the last L1 timestamp a counter to the claim at idx can be made at. The mover's clock
+// resumes from the duration accumulated by the grandparent of the counter, which is the parent of the claim, and has
+// been running since the claim was made.
+func responseDeadline(claims []ContractClaim, idx int64, gameDuration time.Duration) uint64 {
+	claim := claims[idx]
+	var used time.Duration
+	if claim.ParentIndex != math.MaxUint32 {
+		used = claims[claim.ParentIndex].ClockDuration()
+	}
+	return claim.ClockTimestamp() + uint64((gameDuration/2-used)/time.Second)
+}
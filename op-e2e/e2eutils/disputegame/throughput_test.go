@@ -0,0 +1,67 @@
+package disputegame
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxConcurrentGames(t *testing.T) {
+	require.Zero(t, maxConcurrentGames(nil))
+	require.Equal(t, 1, maxConcurrentGames([]gameTimeline{{created: 10, resolved: 20}, {created: 20, resolved: 30}}),
+		"game created as another resolves shouldn't overlap")
+	require.Equal(t, 3, maxConcurrentGames([]gameTimeline{
+		{created: 10, resolved: 50},
+		{created: 20, resolved: 30},
+		{created: 25},
+		{created: 40, resolved: 60},
+	}))
+}
+
+func TestMissedClocks(t *testing.T) {
+	const createdAt = 1000
+	const gameDuration = 100 * time.Second
+	game := common.Address{0xaa}
+	claimWithClock := func(parentIdx uint32, gindex int64, value common.Hash, duration uint64, timestamp uint64) ContractClaim {
+		clock := new(big.Int).Lsh(new(big.Int).SetUint64(duration), 64)
+		return ContractClaim{
+			ParentIndex: parentIdx,
+			Claim:       value,
+			Position:    big.NewInt(gindex),
+			Clock:       clock.Or(clock, new(big.Int).SetUint64(timestamp)),
+		}
+	}
+	claims := []ContractClaim{
+		claimWithClock(math.MaxUint32, 1, common.Hash{0x01}, 0, createdAt),
+		claimWithClock(0, 2, common.Hash{0x02}, 10, createdAt+10),
+	}
+	// Countering the root claim is due by the time the first player's clock expires.
+	counterRoot := Move{ParentIdx: 0, Value: common.Hash{0x02}, IsAttack: true}
+	// Countering claim 1 resumes the root's clock, which has no accumulated duration.
+	counterAttack := Move{ParentIdx: 1, Value: common.Hash{0x03}, IsAttack: true}
+
+	t.Run("Deadline", func(t *testing.T) {
+		require.Equal(t, uint64(createdAt+50), responseDeadline(claims, 0, gameDuration))
+		require.Equal(t, uint64(createdAt+60), responseDeadline(claims, 1, gameDuration))
+	})
+
+	t.Run("MoveMade", func(t *testing.T) {
+		tree := GameSnapshot{Claims: claims, GameDuration: gameDuration, Now: createdAt + 1000}
+		require.Empty(t, missedClocks(game, tree, []Move{counterRoot}))
+	})
+
+	t.Run("ClockNotExpired", func(t *testing.T) {
+		tree := GameSnapshot{Claims: claims, GameDuration: gameDuration, Now: createdAt + 60}
+		require.Empty(t, missedClocks(game, tree, []Move{counterRoot, counterAttack}))
+	})
+
+	t.Run("ClockExpired", func(t *testing.T) {
+		tree := GameSnapshot{Claims: claims, GameDuration: gameDuration, Now: createdAt + 61}
+		require.Equal(t, []MissedClock{{Game: game, ClaimIndex: 1, Deadline: createdAt + 60}},
+			missedClocks(game, tree, []Move{counterRoot, counterAttack}))
+	})
+}
@@ -0,0 +1,37 @@
+package disputegame
+
+import (
+	"context"
+	"time"
+)
+
+// timeoutScale multiplies the timeouts helpers allow for waits on L1. Zero leaves timeouts unchanged.
+type timeoutScale float64
+
+// apply returns timeout multiplied by the scale.
+func (s timeoutScale) apply(timeout time.Duration) time.Duration {
+	if s <= 1 {
+		return timeout
+	}
+	return time.Duration(float64(timeout) * float64(s))
+}
+
+// WithTimeoutScale multiplies the timeouts of the helper and the game helpers it creates by scale, for use when L1
+// blocks are produced more slowly than on the dev chain the defaults are tuned for. The duration of games created
+// by the helper is scaled too, so players have as many L1 blocks to respond in, which requires WithFactoryOwner.
+// Scales of 1 or less leave the defaults unchanged.
+func WithTimeoutScale(scale float64) FactoryOption {
+	return func(h *FactoryHelper) {
+		h.timeoutScale = timeoutScale(scale)
+	}
+}
+
+// withTimeout returns a copy of ctx that is cancelled after timeout, scaled by the helper's timeout scale.
+func (h *FactoryHelper) withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, h.timeoutScale.apply(timeout))
+}
+
+// withTimeout returns a copy of ctx that is cancelled after timeout, scaled by the helper's timeout scale.
+func (g *FaultGameHelper) withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, g.timeoutScale.apply(timeout))
+}
@@ -0,0 +1,28 @@
+package disputegame
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeoutScale(t *testing.T) {
+	tests := []struct {
+		name     string
+		scale    timeoutScale
+		expected time.Duration
+	}{
+		{name: "Unset", scale: 0, expected: time.Minute},
+		{name: "One", scale: 1, expected: time.Minute},
+		{name: "LessThanOne", scale: 0.5, expected: time.Minute},
+		{name: "Whole", scale: 4, expected: 4 * time.Minute},
+		{name: "Fractional", scale: 1.5, expected: 90 * time.Second},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, test.scale.apply(time.Minute))
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package disputegame
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/clock"
+	"github.com/ethereum/go-ethereum"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// ErrL1TimeNotControllable is returned when a helper needs to move L1 time forward but wasn't given a way to do so,
+// such as when running against an external devnet.
+var ErrL1TimeNotControllable = errors.New("L1 time can't be controlled")
+
+// WithL1TimeAdvancer allows the helper to move L1 time forward with advancer, such as the time travel clock of the
+// e2e system. Without it the helper refuses to warp time as it may be running against a devnet it doesn't control.
+func WithL1TimeAdvancer(advancer TimeAdvancer) FactoryOption {
+	return func(h *FactoryHelper) {
+		h.timeAdvancer = advancer
+	}
+}
+
+// AdvanceL1Time moves L1 time forward by d and waits for an L1 block with a timestamp at least d after the head
+// when it was called.
+// Fails the test if the helper wasn't created with WithL1TimeAdvancer.
+func (h *FactoryHelper) AdvanceL1Time(ctx context.Context, d time.Duration) {
+	ctx, cancel := h.withTimeout(ctx, time.Minute)
+	defer cancel()
+	h.require.NoError(h.checkTimeControl(), "advance L1 time")
+	header, err := h.client.HeaderByNumber(ctx, nil)
+	h.require.NoError(err, "load L1 head")
+	h.advanceL1TimeTo(ctx, header.Time+uint64(d/time.Second))
+}
+
+// advanceL1TimeTo moves L1 time forward, if required, and waits for an L1 block with a timestamp of at least target.
+func (h *FactoryHelper) advanceL1TimeTo(ctx context.Context, target uint64) {
+	h.require.NoError(advanceL1TimeTo(ctx, h.client, h.clock, h.timeAdvancer, target))
+}
+
+// advanceL1TimeTo moves L1 time forward with advancer, if required, and waits for an L1 block with a timestamp of at
+// least target.
+func advanceL1TimeTo(ctx context.Context, client *ethclient.Client, clk clock.Clock, advancer TimeAdvancer, target uint64) error {
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("load L1 head: %w", err)
+	}
+	if header.Time < target {
+		advancer.AdvanceTime(time.Duration(target-header.Time) * time.Second)
+	}
+	err = waitFor(ctx, clk, 100*time.Millisecond, func() (bool, error) {
+		header, err := client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return false, err
+		}
+		return header.Time >= target, nil
+	})
+	if err != nil {
+		return fmt.Errorf("L1 did not reach timestamp %v: %w", target, err)
+	}
+	return nil
+}
+
+// RequireL1BlockInterval waits for the next two L1 blocks and fails the test unless their timestamps are interval
+// apart. The next block may already be being built with a previous interval, so only the gap after it is checked.
+func (h *FactoryHelper) RequireL1BlockInterval(ctx context.Context, interval time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, 3*interval+h.timeoutScale.apply(time.Minute))
+	defer cancel()
+	head, err := h.client.HeaderByNumber(ctx, nil)
+	h.require.NoError(err, "load L1 head")
+	first := h.waitForL1BlockAfter(ctx, head.Number.Uint64())
+	second := h.waitForL1BlockAfter(ctx, first.Number.Uint64())
+	h.require.Equalf(uint64(interval/time.Second), second.Time-first.Time,
+		"L1 blocks %v and %v should be %v apart", first.Number, second.Number, interval)
+}
+
+// waitForL1BlockAfter waits for the L1 block after blockNum and returns its header.
+func (h *FactoryHelper) waitForL1BlockAfter(ctx context.Context, blockNum uint64) *ethtypes.Header {
+	var header *ethtypes.Header
+	err := waitFor(ctx, h.clock, 100*time.Millisecond, func() (bool, error) {
+		var err error
+		header, err = h.client.HeaderByNumber(ctx, new(big.Int).SetUint64(blockNum+1))
+		if errors.Is(err, ethereum.NotFound) {
+			return false, nil
+		}
+		return err == nil, err
+	})
+	h.require.NoErrorf(err, "wait for L1 block %v", blockNum+1)
+	return header
+}
+
+func (h *FactoryHelper) checkTimeControl() error {
+	if h.timeAdvancer == nil {
+		return fmt.Errorf("%w: no time advancer configured, refusing to run against an external devnet", ErrL1TimeNotControllable)
+	}
+	return nil
+}
+
+func (g *FaultGameHelper) checkTimeControl() error {
+	if g.timeAdvancer == nil {
+		return fmt.Errorf("%w: no time advancer configured, refusing to run against an external devnet", ErrL1TimeNotControllable)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package disputegame
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// RequireValidTree fails the test unless the game's claims form a valid tree rooted at claim 0:
+//   - the root claim is the first claim, has no parent and is at the root position.
+//   - every other claim's parent index is strictly less than its own, so the parents can't form a cycle.
+//   - every other claim is at the position of an attack or defense of its parent.
+func (g *FaultGameHelper) RequireValidTree(ctx context.Context) {
+	claims, err := g.LoadClaims(ctx)
+	g.require.NoError(err)
+	g.require.Emptyf(checkTree(claims), "game %v has an invalid claim tree", g.addr)
+}
+
+func checkTree(claims []ContractClaim) []error {
+	if len(claims) == 0 {
+		return []error{fmt.Errorf("game has no claims")}
+	}
+	var violations []error
+	if claims[0].ParentIndex != math.MaxUint32 {
+		violations = append(violations, fmt.Errorf("root claim has parent %v", claims[0].ParentIndex))
+	}
+	if claims[0].Position.Uint64() != 1 {
+		violations = append(violations, fmt.Errorf("root claim is at position %v", claims[0].Position))
+	}
+	for i := 1; i < len(claims); i++ {
+		parent := claims[i].ParentIndex
+		if parent == math.MaxUint32 {
+			violations = append(violations, fmt.Errorf("claim %v has no parent", i))
+			continue
+		}
+		if int(parent) >= i {
+			violations = append(violations, fmt.Errorf("claim %v has parent %v which is not an earlier claim", i, parent))
+			continue
+		}
+		if _, err := isAttack(claims[parent], claims[i]); err != nil {
+			violations = append(violations, fmt.Errorf("claim %v: %w", i, err))
+		}
+	}
+	return violations
+}
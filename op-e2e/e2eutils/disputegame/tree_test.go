@@ -0,0 +1,56 @@
+package disputegame
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckTree(t *testing.T) {
+	root := uint32(math.MaxUint32)
+	claimAt := func(parent uint32, gindex uint64) ContractClaim {
+		return ContractClaim{ParentIndex: parent, Position: new(big.Int).SetUint64(gindex), Clock: big.NewInt(0)}
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		claims := []ContractClaim{claimAt(root, 1), claimAt(0, 2), claimAt(1, 4), claimAt(1, 6)}
+		require.Empty(t, checkTree(claims))
+	})
+
+	t.Run("NoClaims", func(t *testing.T) {
+		require.Equal(t, []string{"game has no claims"}, errorStrings(checkTree(nil)))
+	})
+
+	t.Run("RootWithParent", func(t *testing.T) {
+		claims := []ContractClaim{claimAt(0, 1)}
+		require.Equal(t, []string{"root claim has parent 0"}, errorStrings(checkTree(claims)))
+	})
+
+	t.Run("RootNotAtRootPosition", func(t *testing.T) {
+		claims := []ContractClaim{claimAt(root, 2)}
+		require.Equal(t, []string{"root claim is at position 2"}, errorStrings(checkTree(claims)))
+	})
+
+	t.Run("SecondRoot", func(t *testing.T) {
+		claims := []ContractClaim{claimAt(root, 1), claimAt(root, 2)}
+		require.Equal(t, []string{"claim 1 has no parent"}, errorStrings(checkTree(claims)))
+	})
+
+	t.Run("SelfParent", func(t *testing.T) {
+		claims := []ContractClaim{claimAt(root, 1), claimAt(1, 2)}
+		require.Equal(t, []string{"claim 1 has parent 1 which is not an earlier claim"}, errorStrings(checkTree(claims)))
+	})
+
+	t.Run("ForwardParent", func(t *testing.T) {
+		claims := []ContractClaim{claimAt(root, 1), claimAt(2, 4), claimAt(0, 2)}
+		require.Equal(t, []string{"claim 1 has parent 2 which is not an earlier claim"}, errorStrings(checkTree(claims)))
+	})
+
+	t.Run("NotAChildPosition", func(t *testing.T) {
+		claims := []ContractClaim{claimAt(root, 1), claimAt(0, 4)}
+		require.Equal(t, []string{"claim 1: claim at position 4 is neither an attack nor defense of position 1"},
+			errorStrings(checkTree(claims)))
+	})
+}
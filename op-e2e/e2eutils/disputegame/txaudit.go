@@ -0,0 +1,61 @@
+package disputegame
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// TxAudit summarises the transactions sent by a single actor since the game was created.
+// Only transactions included in a block are visible, so transactions that were replaced before landing are not
+// counted.
+type TxAudit struct {
+	// Total is the number of transactions sent by the actor that were included, to any address.
+	Total int
+	// Landed is the number of successful transactions sent by the actor to the game.
+	Landed int
+}
+
+// AuditTxsFrom scans every transaction sent by sender since the game was created.
+func (g *FaultGameHelper) AuditTxsFrom(ctx context.Context, sender common.Address) TxAudit {
+	var txs []ScannedTx
+	g.forEachTxFrom(ctx, sender, func(scanned ScannedTx) {
+		txs = append(txs, scanned)
+	})
+	return auditTxs(txs, g.addr)
+}
+
+// RequireNoWastedTxs fails the test if more than slack of the transactions sender had included were not successful
+// moves against the game, which indicates fees being wasted on failed or unnecessary transactions.
+// Replacement transactions can't be detected as only one transaction per nonce is ever included.
+func (g *FaultGameHelper) RequireNoWastedTxs(ctx context.Context, sender common.Address, slack int) {
+	audit := g.AuditTxsFrom(ctx, sender)
+	g.require.LessOrEqualf(audit.Total, audit.Landed+slack,
+		"%v sent %v transactions but only %v landed against game %v", sender, audit.Total, audit.Landed, g.addr)
+}
+
+// RequireNoDoubleMoves fails the test unless every transaction sent by each of challengers landed as a move against
+// the game. The contract rejects a claim that repeats an existing one, so when concurrent challengers race to make
+// the same move the loser's transaction reverts rather than adding a claim.
+func (g *FaultGameHelper) RequireNoDoubleMoves(ctx context.Context, challengers ...common.Address) {
+	sent := 0
+	for _, sender := range challengers {
+		audit := g.AuditTxsFrom(ctx, sender)
+		g.require.Equalf(audit.Total, audit.Landed,
+			"%v sent %v transactions but only %v landed against game %v", sender, audit.Total, audit.Landed, g.addr)
+		sent += audit.Total
+	}
+	g.require.NotZerof(sent, "no transactions sent to game %v by %v", g.addr, challengers)
+}
+
+func auditTxs(txs []ScannedTx, game common.Address) TxAudit {
+	var audit TxAudit
+	for _, scanned := range txs {
+		audit.Total++
+		if scanned.Tx.To() != nil && *scanned.Tx.To() == game && scanned.Receipt.Status == ethtypes.ReceiptStatusSuccessful {
+			audit.Landed++
+		}
+	}
+	return audit
+}
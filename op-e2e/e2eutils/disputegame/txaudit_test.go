@@ -0,0 +1,35 @@
+package disputegame
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditTxs(t *testing.T) {
+	game := common.Address{0xaa}
+	other := common.Address{0xbb}
+	scanned := func(nonce uint64, to common.Address, status uint64) ScannedTx {
+		return ScannedTx{
+			Tx:      ethtypes.NewTx(&ethtypes.DynamicFeeTx{Nonce: nonce, To: &to}),
+			Receipt: &ethtypes.Receipt{Status: status},
+		}
+	}
+	txs := []ScannedTx{
+		scanned(0, game, ethtypes.ReceiptStatusSuccessful),
+		scanned(1, game, ethtypes.ReceiptStatusFailed),
+		scanned(2, other, ethtypes.ReceiptStatusSuccessful),
+		scanned(3, game, ethtypes.ReceiptStatusSuccessful),
+	}
+	audit := auditTxs(txs, game)
+	require.Equal(t, 4, audit.Total)
+	require.Equal(t, 2, audit.Landed)
+}
+
+func TestAuditTxsEmpty(t *testing.T) {
+	audit := auditTxs(nil, common.Address{0xaa})
+	require.Zero(t, audit.Total)
+	require.Zero(t, audit.Landed)
+}
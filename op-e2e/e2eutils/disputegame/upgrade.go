@@ -0,0 +1,192 @@
+package disputegame
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/client/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GameBehaviour is the configuration a game inherits from the implementation it was created with.
+type GameBehaviour struct {
+	GameDuration uint64
+	Version      string
+}
+
+// WithFactoryOwner makes the helper send factory admin transactions, such as SetImplementation, from key.
+// key must be the owner of the factory, which for the devnet requires overriding the owner in the L1 genesis.
+func WithFactoryOwner(key *ecdsa.PrivateKey) FactoryOption {
+	return func(h *FactoryHelper) {
+		h.ownerKey = key
+	}
+}
+
+// WithAlphabetGameType makes the helper create alphabet games with gameType, such as one registered with
+// RegisterAlphabetImplementation, rather than the devnet's alphabet game type.
+func WithAlphabetGameType(gameType uint8) FactoryOption {
+	return func(h *FactoryHelper) {
+		h.alphabetType = gameType
+	}
+}
+
+// DeployAlphabetImplementation deploys a new alphabet game implementation with the same configuration as the one
+// currently registered with the factory, except for gameDuration. The implementation is not registered.
+func (h *FactoryHelper) DeployAlphabetImplementation(ctx context.Context, gameDuration uint64) common.Address {
+	addr, err := h.DeployImplementation(ctx, h.alphabetType, gameDuration)
+	h.require.NoError(err)
+	return addr
+}
+
+// SetImplementation registers impl as the implementation for new games of gameType.
+// Requires the helper to be created with WithFactoryOwner.
+func (h *FactoryHelper) SetImplementation(ctx context.Context, gameType uint8, impl common.Address) {
+	h.require.NotNil(h.ownerOpts, "factory owner not configured")
+	h.require.NoError(h.FactoryCore.SetImplementation(ctx, h.ownerOpts, gameType, impl))
+}
+
+// RegisterAlphabetImplementation deploys a copy of the devnet's alphabet implementation, configured as gameType, and
+// registers it with the factory as gameType.
+// Requires the helper to be created with WithFactoryOwner.
+func (h *FactoryHelper) RegisterAlphabetImplementation(ctx context.Context, gameType uint8) common.Address {
+	impl, err := h.DeployImplementationAs(ctx, alphabetGameType, gameType)
+	h.require.NoError(err)
+	h.SetImplementation(ctx, gameType, impl)
+	return impl
+}
+
+// ImplementationBehaviour returns the behaviour new games created with the implementation at impl will have.
+func (h *FactoryHelper) ImplementationBehaviour(ctx context.Context, impl common.Address) GameBehaviour {
+	game, err := bindings.NewFaultDisputeGameCaller(impl, h.client)
+	h.require.NoError(err)
+	behaviour, err := loadBehaviour(ctx, game)
+	h.require.NoErrorf(err, "load behaviour of implementation %v", impl)
+	return behaviour
+}
+
+// Behaviour returns the configuration the game inherited from its implementation.
+func (g *FaultGameHelper) Behaviour(ctx context.Context) GameBehaviour {
+	behaviour, err := loadBehaviour(ctx, &g.game.FaultDisputeGameCaller)
+	g.require.NoError(err, "load game behaviour")
+	return behaviour
+}
+
+// RequireBehaviour fails the test if the game's behaviour doesn't match expected.
+// Games are clones of their implementation so changing the factory's implementation must not affect existing games.
+func (g *FaultGameHelper) RequireBehaviour(ctx context.Context, expected GameBehaviour) {
+	g.require.Equalf(expected, g.Behaviour(ctx), "game %v has unexpected behaviour", g.addr)
+}
+
+// DeployImplementation deploys a new implementation for gameType with the same configuration as the one currently
+// registered with the factory, except for gameDuration. The implementation is not registered.
+func (c *FactoryCore) DeployImplementation(ctx context.Context, gameType uint8, gameDuration uint64) (common.Address, error) {
+	params, err := c.loadImplementationParams(ctx, gameType)
+	if err != nil {
+		return common.Address{}, err
+	}
+	params.gameDuration = gameDuration
+	return c.deployImplementation(ctx, gameType, params)
+}
+
+// DeployImplementationAs deploys a new implementation with the same configuration as the one currently registered
+// for fromType, except that it is configured as gameType. The implementation is not registered.
+func (c *FactoryCore) DeployImplementationAs(ctx context.Context, fromType uint8, gameType uint8) (common.Address, error) {
+	params, err := c.loadImplementationParams(ctx, fromType)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return c.deployImplementation(ctx, gameType, params)
+}
+
+// implementationParams are the constructor arguments of a fault dispute game implementation.
+type implementationParams struct {
+	prestate     common.Hash
+	maxDepth     *big.Int
+	gameDuration uint64
+	vm           common.Address
+	l2oo         common.Address
+	blockOracle  common.Address
+}
+
+// loadImplementationParams loads the constructor arguments of the implementation registered for gameType.
+func (c *FactoryCore) loadImplementationParams(ctx context.Context, gameType uint8) (implementationParams, error) {
+	current, err := c.factory.GameImpls(&bind.CallOpts{Context: ctx}, gameType)
+	if err != nil {
+		return implementationParams{}, fmt.Errorf("load implementation for game type %v: %w", gameType, err)
+	}
+	if current == (common.Address{}) {
+		return implementationParams{}, fmt.Errorf("no implementation registered for game type %v", gameType)
+	}
+	impl, err := bindings.NewFaultDisputeGameCaller(current, c.client)
+	if err != nil {
+		return implementationParams{}, fmt.Errorf("create implementation caller: %w", err)
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	var params implementationParams
+	if params.prestate, err = impl.ABSOLUTEPRESTATE(opts); err != nil {
+		return implementationParams{}, fmt.Errorf("load absolute prestate: %w", err)
+	}
+	if params.maxDepth, err = impl.MAXGAMEDEPTH(opts); err != nil {
+		return implementationParams{}, fmt.Errorf("load max game depth: %w", err)
+	}
+	if params.gameDuration, err = impl.GAMEDURATION(opts); err != nil {
+		return implementationParams{}, fmt.Errorf("load game duration: %w", err)
+	}
+	if params.vm, err = impl.VM(opts); err != nil {
+		return implementationParams{}, fmt.Errorf("load VM: %w", err)
+	}
+	if params.l2oo, err = impl.L2OUTPUTORACLE(opts); err != nil {
+		return implementationParams{}, fmt.Errorf("load L2 output oracle: %w", err)
+	}
+	if params.blockOracle, err = impl.BLOCKORACLE(opts); err != nil {
+		return implementationParams{}, fmt.Errorf("load block oracle: %w", err)
+	}
+	return params, nil
+}
+
+// deployImplementation deploys a new implementation for gameType with params. The implementation is not registered.
+func (c *FactoryCore) deployImplementation(ctx context.Context, gameType uint8, params implementationParams) (common.Address, error) {
+	deployOpts := *c.opts
+	deployOpts.Context = ctx
+	addr, tx, _, err := bindings.DeployFaultDisputeGame(&deployOpts, c.client, gameType, params.prestate, params.maxDepth,
+		params.gameDuration, params.vm, params.l2oo, params.blockOracle)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("deploy implementation: %w", err)
+	}
+	if _, err := utils.WaitReceiptOK(ctx, c.client, tx.Hash()); err != nil {
+		return common.Address{}, fmt.Errorf("wait for implementation deployment receipt to be OK: %w", err)
+	}
+	return addr, nil
+}
+
+// SetImplementation registers impl as the implementation for new games of gameType, sending the transaction with
+// ownerOpts which must be for the factory owner.
+func (c *FactoryCore) SetImplementation(ctx context.Context, ownerOpts *bind.TransactOpts, gameType uint8, impl common.Address) error {
+	opts := *ownerOpts
+	opts.Context = ctx
+	tx, err := c.factory.SetImplementation(&opts, gameType, impl)
+	if err != nil {
+		return fmt.Errorf("set implementation for game type %v: %w", gameType, err)
+	}
+	if _, err := utils.WaitReceiptOK(ctx, c.client, tx.Hash()); err != nil {
+		return fmt.Errorf("wait for set implementation receipt to be OK: %w", err)
+	}
+	return nil
+}
+
+func loadBehaviour(ctx context.Context, game *bindings.FaultDisputeGameCaller) (GameBehaviour, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	duration, err := game.GAMEDURATION(opts)
+	if err != nil {
+		return GameBehaviour{}, fmt.Errorf("load game duration: %w", err)
+	}
+	version, err := game.Version(opts)
+	if err != nil {
+		return GameBehaviour{}, fmt.Errorf("load version: %w", err)
+	}
+	return GameBehaviour{GameDuration: duration, Version: version}, nil
+}
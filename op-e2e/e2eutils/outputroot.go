@@ -0,0 +1,55 @@
+package e2eutils
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-bindings/predeploys"
+	"github.com/ethereum-optimism/optimism/op-node/eth"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	"github.com/ethereum-optimism/optimism/op-node/sources"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/stretchr/testify/require"
+)
+
+// OutputRootViaRollupNode returns the output root for the specified L2 block as reported by optimism_outputAtBlock.
+func OutputRootViaRollupNode(ctx context.Context, rollupClient *sources.RollupClient, l2Block uint64) (common.Hash, error) {
+	output, err := rollupClient.OutputAtBlock(ctx, l2Block)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("retrieve output at block %v: %w", l2Block, err)
+	}
+	return common.Hash(output.OutputRoot), nil
+}
+
+// OutputRootViaL2Client calculates the version 0 output root for the specified L2 block directly from L2 chain data,
+// using the block's state root and hash along with the storage root of the L2ToL1MessagePasser.
+func OutputRootViaL2Client(ctx context.Context, l2Client *ethclient.Client, l2Block uint64) (common.Hash, error) {
+	blockNum := new(big.Int).SetUint64(l2Block)
+	header, err := l2Client.HeaderByNumber(ctx, blockNum)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("retrieve L2 block %v: %w", l2Block, err)
+	}
+	proof, err := gethclient.New(l2Client.Client()).GetProof(ctx, predeploys.L2ToL1MessagePasserAddr, nil, blockNum)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("retrieve message passer proof at block %v: %w", l2Block, err)
+	}
+	root, err := rollup.ComputeL2OutputRootV0(eth.HeaderBlockInfo(header), proof.StorageHash)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("compute output root: %w", err)
+	}
+	return common.Hash(root), nil
+}
+
+// RequireOutputRootsMatch requires that the output root for the specified L2 block reported by the rollup node
+// matches the output root calculated from the L2 chain data.
+func RequireOutputRootsMatch(t *testing.T, ctx context.Context, rollupClient *sources.RollupClient, l2Client *ethclient.Client, l2Block uint64) {
+	expected, err := OutputRootViaRollupNode(ctx, rollupClient, l2Block)
+	require.NoError(t, err)
+	actual, err := OutputRootViaL2Client(ctx, l2Client, l2Block)
+	require.NoError(t, err)
+	require.Equalf(t, expected, actual, "output root for block %v from rollup node should match L2 chain data", l2Block)
+}
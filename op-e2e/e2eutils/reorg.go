@@ -0,0 +1,158 @@
+package e2eutils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-node/sources"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	geth_eth "github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// ReorgL1 forces a reorg of the dev L1 chain run by backend, removing the latest depth blocks.
+// Once a replacement for the first removed block has been built, the transactions from the removed blocks are
+// resubmitted so they are included again, but in different blocks to the ones they were originally included in.
+// Returns the hashes of the blocks that were removed, oldest first.
+func ReorgL1(ctx context.Context, backend *geth_eth.Ethereum, depth uint64) ([]common.Hash, error) {
+	chain := backend.BlockChain()
+	head := chain.CurrentBlock().Number.Uint64()
+	if depth == 0 || depth > head {
+		return nil, fmt.Errorf("cannot reorg %v blocks from head %v", depth, head)
+	}
+	newHead := head - depth
+
+	// Collect the transactions before they are deleted by rewinding the chain.
+	var removed []common.Hash
+	var txs []*types.Transaction
+	for num := newHead + 1; num <= head; num++ {
+		block := chain.GetBlockByNumber(num)
+		if block == nil {
+			return nil, fmt.Errorf("block %v not found", num)
+		}
+		removed = append(removed, block.Hash())
+		txs = append(txs, block.Transactions()...)
+	}
+	if err := chain.SetHead(newHead); err != nil {
+		return nil, fmt.Errorf("rewind chain to block %v: %w", newHead, err)
+	}
+
+	// Wait for a block to be built on the new head before resubmitting so the transactions don't land in an
+	// identical copy of the block they were removed from.
+	if err := waitForHead(ctx, backend, newHead+1); err != nil {
+		return nil, err
+	}
+	for i, err := range backend.TxPool().AddLocals(txs) {
+		if err != nil && !errors.Is(err, txpool.ErrAlreadyKnown) && !errors.Is(err, core.ErrNonceTooLow) {
+			return nil, fmt.Errorf("resubmit tx %v: %w", txs[i].Hash(), err)
+		}
+	}
+	return removed, nil
+}
+
+func waitForHead(ctx context.Context, backend *geth_eth.Ethereum, num uint64) error {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for backend.BlockChain().CurrentBlock().Number.Uint64() < num {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for block %v: %w", num, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// ReorgL2Unsafe forces a reorg of the unsafe portion of the L2 chain built by the sequencer whose rollup node is
+// rollupClient and execution engine is backend. Sequencing is stopped, the engine is rewound to the safe head and,
+// after the derivation pipeline is reset, sequencing restarts from the safe head. A transaction from key is sent
+// before sequencing restarts so the replacement blocks differ from the removed ones.
+// Returns the hashes of the unsafe blocks that were removed, oldest first. The safe chain is unaffected.
+func ReorgL2Unsafe(ctx context.Context, rollupClient *sources.RollupClient, backend *geth_eth.Ethereum, key *ecdsa.PrivateKey) ([]common.Hash, error) {
+	if _, err := rollupClient.StopSequencer(ctx); err != nil {
+		return nil, fmt.Errorf("stop sequencer: %w", err)
+	}
+	status, err := rollupClient.SyncStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load sync status: %w", err)
+	}
+	safe := status.SafeL2
+	chain := backend.BlockChain()
+	head := chain.CurrentBlock().Number.Uint64()
+	if head <= safe.Number {
+		return nil, fmt.Errorf("no unsafe blocks to reorg: head %v, safe head %v", head, safe.Number)
+	}
+	var removed []common.Hash
+	for num := safe.Number + 1; num <= head; num++ {
+		block := chain.GetBlockByNumber(num)
+		if block == nil {
+			return nil, fmt.Errorf("block %v not found", num)
+		}
+		removed = append(removed, block.Hash())
+	}
+	if err := chain.SetHead(safe.Number); err != nil {
+		return nil, fmt.Errorf("rewind L2 chain to safe head %v: %w", safe.Number, err)
+	}
+	if err := rollupClient.ResetDerivationPipeline(ctx); err != nil {
+		return nil, fmt.Errorf("reset derivation pipeline: %w", err)
+	}
+
+	tx, err := divergingTx(backend, key)
+	if err != nil {
+		return nil, err
+	}
+	if err := backend.TxPool().AddLocal(tx); err != nil {
+		return nil, fmt.Errorf("send diverging tx: %w", err)
+	}
+	// The pipeline reset is asynchronous so retry until the rollup node's unsafe head is the rewound head.
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		err := rollupClient.StartSequencer(ctx, safe.Hash)
+		if err == nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("restart sequencer at safe head %v: %w", safe.Hash, errors.Join(err, ctx.Err()))
+		case <-ticker.C:
+		}
+	}
+
+	if err := waitForHead(ctx, backend, safe.Number+1); err != nil {
+		return nil, err
+	}
+	if replaced := chain.GetBlockByNumber(safe.Number + 1); replaced.Hash() == removed[0] {
+		return nil, fmt.Errorf("L2 block %v was rebuilt identically so no reorg occurred", safe.Number+1)
+	}
+	return removed, nil
+}
+
+// divergingTx creates a transaction from key to itself on the chain run by backend.
+func divergingTx(backend *geth_eth.Ethereum, key *ecdsa.PrivateKey) (*types.Transaction, error) {
+	chain := backend.BlockChain()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	head := chain.CurrentBlock()
+	tip := big.NewInt(params.GWei)
+	feeCap := new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(chain.Config().ChainID), &types.DynamicFeeTx{
+		ChainID:   chain.Config().ChainID,
+		Nonce:     backend.TxPool().Nonce(from),
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       params.TxGas,
+		To:        &from,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sign diverging tx: %w", err)
+	}
+	return tx, nil
+}
@@ -1,6 +1,7 @@
 package op_e2e
 
 import (
+	"sync"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/clock"
@@ -17,10 +18,12 @@ import (
 // fakePoS is a testing-only utility to attach to Geth,
 // to build a fake proof-of-stake L1 chain with fixed block time and basic lagging safe/finalized blocks.
 type fakePoS struct {
-	clock     clock.Clock
-	eth       *eth.Ethereum
-	log       log.Logger
-	blockTime uint64
+	clock clock.Clock
+	eth   *eth.Ethereum
+	log   log.Logger
+
+	blockTimeLock sync.Mutex
+	blockTime     uint64
 
 	finalizedDistance uint64
 	safeDistance      uint64
@@ -59,7 +62,7 @@ func (f *fakePoS) Start() error {
 				if head.Time >= uint64(now.Unix()) {
 					continue
 				}
-				newBlockTime := head.Time + f.blockTime
+				newBlockTime := head.Time + f.BlockTime()
 				if time.Unix(int64(newBlockTime), 0).Add(5 * time.Minute).Before(f.clock.Now()) {
 					// We're a long way behind, let's skip some blocks...
 					newBlockTime = uint64(f.clock.Now().Unix())
@@ -117,6 +120,20 @@ func (f *fakePoS) Start() error {
 	return nil
 }
 
+// BlockTime returns the number of seconds between the timestamps of consecutive blocks.
+func (f *fakePoS) BlockTime() uint64 {
+	f.blockTimeLock.Lock()
+	defer f.blockTimeLock.Unlock()
+	return f.blockTime
+}
+
+// SetBlockTime changes the number of seconds between blocks, starting from the next block to be built.
+func (f *fakePoS) SetBlockTime(seconds uint64) {
+	f.blockTimeLock.Lock()
+	defer f.blockTimeLock.Unlock()
+	f.blockTime = seconds
+}
+
 func (f *fakePoS) Stop() error {
 	f.sub.Unsubscribe()
 	if advancing, ok := f.clock.(*clock.AdvancingClock); ok {
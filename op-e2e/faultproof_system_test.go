@@ -0,0 +1,212 @@
+package op_e2e
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/disputegame"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/require"
+)
+
+// FaultProofSystem is a running e2e system configured for fault proof tests, along with a dispute game factory
+// helper and the accounts available to act as players in games.
+type FaultProofSystem struct {
+	*System
+	L1Client *ethclient.Client
+	Factory  *disputegame.FactoryHelper
+
+	// Alice, Bob and Mallory are funded in the L1 genesis and have no other role in the system.
+	Alice   FaultProofActor
+	Bob     FaultProofActor
+	Mallory FaultProofActor
+}
+
+// FaultProofActor is a funded L1 account that can play in dispute games.
+type FaultProofActor struct {
+	Key     *ecdsa.PrivateKey
+	Address common.Address
+}
+
+// PrivateKeyHex returns the actor's private key in the format used by the challenger config.
+func (a FaultProofActor) PrivateKeyHex() string {
+	return e2eutils.EncodePrivKeyToString(a.Key)
+}
+
+func newFaultProofActor(key *ecdsa.PrivateKey) FaultProofActor {
+	return FaultProofActor{Key: key, Address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+// factoryOwnerSlot is the storage slot of the DisputeGameFactory owner, from the contract's storage layout.
+var factoryOwnerSlot = common.BigToHash(big.NewInt(51))
+
+// l2ooChallengerSlot is the storage slot of the L2OutputOracle challenger, from the contract's storage layout.
+var l2ooChallengerSlot = common.BigToHash(big.NewInt(4))
+
+// proxyAdminOwnerSlot is the storage slot of the ProxyAdmin owner, from the contract's storage layout.
+var proxyAdminOwnerSlot = common.BigToHash(big.NewInt(0))
+
+type faultProofSystemOptions struct {
+	cfgChanges     []func(cfg *SystemConfig)
+	factoryOptions []disputegame.FactoryOption
+	factoryOwner   bool
+	l2ooAdmin      bool
+	realisticL1    bool
+}
+
+type FaultProofSystemOption func(opts *faultProofSystemOptions)
+
+// WithoutProposer disables the proposer so no output proposals are made after the system starts.
+func WithoutProposer() FaultProofSystemOption {
+	return WithSystemConfig(func(cfg *SystemConfig) {
+		cfg.DisableProposer = true
+	})
+}
+
+// WithSystemConfig applies change to the system config after the fault proof defaults are set.
+func WithSystemConfig(change func(cfg *SystemConfig)) FaultProofSystemOption {
+	return func(opts *faultProofSystemOptions) {
+		opts.cfgChanges = append(opts.cfgChanges, change)
+	}
+}
+
+// WithL2BlockTime sets the L2 block time in seconds. The L1 block time is increased to match if required, as the
+// L2 block time can't exceed it.
+// The L2OutputOracle is deployed from the L1 allocs so keeps the devnet's L2_BLOCK_TIME regardless. The oracle only
+// uses it to reject outputs for blocks in the future, so a shorter block time delays proposals and a longer one has
+// no effect.
+func WithL2BlockTime(seconds uint64) FaultProofSystemOption {
+	return WithSystemConfig(func(cfg *SystemConfig) {
+		cfg.DeployConfig.L2BlockTime = seconds
+		if cfg.DeployConfig.L1BlockTime < seconds {
+			cfg.DeployConfig.L1BlockTime = seconds
+		}
+	})
+}
+
+// WithL1BlockTime sets the L1 block time in seconds. The max sequencer drift is increased to match if required, so
+// the sequencer can keep producing L2 blocks while waiting for the next L1 block.
+// Use System.SetL1BlockTime to change the L1 block time once the system is running.
+func WithL1BlockTime(seconds uint64) FaultProofSystemOption {
+	return WithSystemConfig(func(cfg *SystemConfig) {
+		setL1BlockTime(cfg, seconds)
+	})
+}
+
+func setL1BlockTime(cfg *SystemConfig, seconds uint64) {
+	cfg.DeployConfig.L1BlockTime = seconds
+	if cfg.DeployConfig.MaxSequencerDrift < seconds {
+		cfg.DeployConfig.MaxSequencerDrift = seconds
+	}
+}
+
+// realisticL1BlockTime is the L1 block time of mainnet in seconds.
+const realisticL1BlockTime = 12
+
+// WithRealisticL1 runs L1 with mainnet's 12 second block time instead of the fast blocks of the dev chain. The
+// factory helper's timeouts and the duration of the games it creates are scaled by the increase in block time, so
+// scenarios written for the dev chain can run unchanged. Implies WithFactoryOwner, which changing the game duration
+// requires.
+func WithRealisticL1() FaultProofSystemOption {
+	return func(opts *faultProofSystemOptions) {
+		opts.realisticL1 = true
+		opts.factoryOwner = true
+	}
+}
+
+// WithFactoryOptions applies options to the dispute game factory helper.
+func WithFactoryOptions(options ...disputegame.FactoryOption) FaultProofSystemOption {
+	return func(opts *faultProofSystemOptions) {
+		opts.factoryOptions = append(opts.factoryOptions, options...)
+	}
+}
+
+// WithFactoryOwner makes the deployer account the owner of the dispute game factory and configures the factory
+// helper to send admin transactions from it.
+// The devnet factory is owned by the account that generated the L1 allocs, whose key isn't available to tests.
+func WithFactoryOwner() FaultProofSystemOption {
+	return func(opts *faultProofSystemOptions) {
+		opts.factoryOwner = true
+	}
+}
+
+// WithL2OutputOracleAdmin makes the deployer both the L2OutputOracle challenger and the ProxyAdmin owner and
+// configures the factory helper to use it, so tests can delete outputs and upgrade the oracle.
+func WithL2OutputOracleAdmin() FaultProofSystemOption {
+	return func(opts *faultProofSystemOptions) {
+		opts.l2ooAdmin = true
+	}
+}
+
+// NewFaultProofSystem starts a system for fault proof tests and returns it with a ready to use factory helper.
+// By default the proposer runs and submits outputs as soon as possible. No challenger is started as each
+// challenger is configured for a single game, so use the game helper's StartChallenger once the game is created.
+// The factory helper can advance L1 time using the system's time travel clock.
+// The system is closed when the test completes.
+func NewFaultProofSystem(t *testing.T, options ...FaultProofSystemOption) *FaultProofSystem {
+	opts := &faultProofSystemOptions{}
+	for _, option := range options {
+		option(opts)
+	}
+	cfg := faultProofSystemConfig(t)
+	for _, change := range opts.cfgChanges {
+		change(&cfg)
+	}
+	factoryOptions := opts.factoryOptions
+	if opts.realisticL1 {
+		scale := float64(realisticL1BlockTime) / float64(cfg.DeployConfig.L1BlockTime)
+		setL1BlockTime(&cfg, realisticL1BlockTime)
+		factoryOptions = append(factoryOptions, disputegame.WithTimeoutScale(scale))
+	}
+	deployer := common.BytesToHash(crypto.PubkeyToAddress(cfg.Secrets.Deployer.PublicKey).Bytes())
+	if opts.factoryOwner {
+		overrideL1Storage(&cfg, cfg.L1Deployments.DisputeGameFactoryProxy, factoryOwnerSlot, deployer)
+		factoryOptions = append(factoryOptions, disputegame.WithFactoryOwner(cfg.Secrets.Deployer))
+	}
+	if opts.l2ooAdmin {
+		overrideL1Storage(&cfg, cfg.L1Deployments.L2OutputOracleProxy, l2ooChallengerSlot, deployer)
+		overrideL1Storage(&cfg, cfg.L1Deployments.ProxyAdmin, proxyAdminOwnerSlot, deployer)
+		factoryOptions = append(factoryOptions,
+			disputegame.WithL2OutputOracleChallenger(cfg.Secrets.Deployer),
+			disputegame.WithProxyAdminOwner(cfg.Secrets.Deployer))
+	}
+	sys, err := cfg.Start()
+	require.NoError(t, err, "Error starting up system")
+	t.Cleanup(sys.Close)
+
+	l1Client := sys.Clients["l1"]
+	factoryOptions = append(factoryOptions, disputegame.WithL1TimeAdvancer(sys.TimeTravelClock))
+	return &FaultProofSystem{
+		System:   sys,
+		L1Client: l1Client,
+		Factory:  disputegame.NewFactoryHelper(t, context.Background(), sys.cfg.L1Deployments, l1Client, factoryOptions...),
+		Alice:    newFaultProofActor(cfg.Secrets.Alice),
+		Bob:      newFaultProofActor(cfg.Secrets.Bob),
+		Mallory:  newFaultProofActor(cfg.Secrets.Mallory),
+	}
+}
+
+// overrideL1Storage sets slot of the contract at addr to value in the L1 genesis, keeping other overrides.
+func overrideL1Storage(cfg *SystemConfig, addr common.Address, slot common.Hash, value common.Hash) {
+	if cfg.L1GenesisStorage == nil {
+		cfg.L1GenesisStorage = make(map[common.Address]map[common.Hash]common.Hash)
+	}
+	if cfg.L1GenesisStorage[addr] == nil {
+		cfg.L1GenesisStorage[addr] = make(map[common.Hash]common.Hash)
+	}
+	cfg.L1GenesisStorage[addr][slot] = value
+}
+
+func faultProofSystemConfig(t *testing.T) SystemConfig {
+	cfg := DefaultSystemConfig(t)
+	delete(cfg.Nodes, "verifier")
+	cfg.SupportL1TimeTravel = true
+	cfg.DeployConfig.L2OutputOracleSubmissionInterval = 2
+	cfg.NonFinalizedProposals = true // Submit output proposals asap
+	return cfg
+}
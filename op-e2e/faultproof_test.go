@@ -2,14 +2,30 @@ package op_e2e
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/ethereum-optimism/optimism/op-bindings/bindings"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/deployer"
 	"github.com/ethereum-optimism/optimism/op-challenger/config"
+	"github.com/ethereum-optimism/optimism/op-challenger/fault/alphabet"
+	faultTest "github.com/ethereum-optimism/optimism/op-challenger/fault/test"
+	faultTypes "github.com/ethereum-optimism/optimism/op-challenger/fault/types"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils"
+	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/challenger"
 	"github.com/ethereum-optimism/optimism/op-e2e/e2eutils/disputegame"
+	"github.com/ethereum-optimism/optimism/op-node/client"
+	"github.com/ethereum-optimism/optimism/op-node/sources"
 	"github.com/ethereum-optimism/optimism/op-service/client/utils"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/require"
 )
 
@@ -17,11 +33,9 @@ func TestResolveDisputeGame(t *testing.T) {
 	InitParallel(t)
 
 	ctx := context.Background()
-	sys, l1Client := startFaultDisputeSystem(t)
-	t.Cleanup(sys.Close)
+	sys := NewFaultProofSystem(t, WithFactoryOptions(disputegame.WithChallengerInvariants()))
 
-	disputeGameFactory := disputegame.NewFactoryHelper(t, ctx, sys.cfg.L1Deployments, l1Client)
-	game := disputeGameFactory.StartAlphabetGame(ctx, "zyxwvut")
+	game := sys.Factory.StartAlphabetGame(ctx, "zyxwvut")
 	require.NotNil(t, game)
 	gameDuration := game.GameDuration(ctx)
 
@@ -30,16 +44,796 @@ func TestResolveDisputeGame(t *testing.T) {
 	game.StartChallenger(ctx, sys.NodeEndpoint("l1"), "HonestAlice", func(c *config.Config) {
 		c.AgreeWithProposedOutput = true // Agree with the proposed output, so disagree with the root claim
 		c.AlphabetTrace = "abcdefg"
-		c.TxMgrConfig.PrivateKey = e2eutils.EncodePrivKeyToString(sys.cfg.Secrets.Alice)
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+	})
+
+	game.WaitForClaimCount(ctx, 2)
+
+	sys.TimeTravelClock.AdvanceTime(gameDuration)
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
+
+	// Challenger should resolve the game now that the clocks have expired.
+	game.WaitForGameStatus(ctx, disputegame.StatusChallengerWins)
+	game.RequireGameIntegrity(ctx, game.TraceProvider("abcdefg"))
+	idx, _ := game.ResolvingClaim(ctx)
+	require.Equal(t, int64(1), idx, "challenger's attack on the root claim should decide the game")
+}
+
+func TestHonestRootUncontestedDefenderWins(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartHonestAlphabetGame(ctx)
+	require.NotNil(t, game)
+	gameDuration := game.GameDuration(ctx)
+
+	sys.TimeTravelClock.AdvanceTime(gameDuration)
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
+
+	game.Resolve(ctx)
+	game.WaitForGameStatus(ctx, disputegame.StatusDefenderWins)
+	game.RequireGameIntegrity(ctx, game.TraceProvider(disputegame.CorrectAlphabet))
+	idx, _ := game.ResolvingClaim(ctx)
+	require.Equal(t, int64(0), idx, "uncontested root claim should decide the game")
+}
+
+func TestConcurrentChallengersDoNotDoubleMove(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	game.StartChallenger(ctx, sys.NodeEndpoint("l1"), "Defender", func(c *config.Config) {
+		c.TxMgrConfig.PrivateKey = sys.Mallory.PrivateKeyHex()
+	})
+	game.StartConcurrentHonestChallengers(ctx, sys.NodeEndpoint("l1"), sys.Alice.PrivateKeyHex(), sys.Bob.PrivateKeyHex())
+	game.WaitForClaimAtMaxDepth(ctx, true)
+
+	sys.TimeTravelClock.AdvanceTime(game.GameDuration(ctx))
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
+	game.WaitForGameStatus(ctx, disputegame.StatusChallengerWins)
+	game.RequireNoDoubleMoves(ctx, sys.Alice.Address, sys.Bob.Address)
+	game.RequireGameIntegrity(ctx, game.TraceProvider(disputegame.CorrectAlphabet))
+}
+
+// TestZeroRootClaim documents that a game with an all-zeros root claim is accepted by the factory and handled like
+// any other invalid root: the honest challenger attacks it and wins once the clocks expire.
+func TestZeroRootClaim(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartZeroRootAlphabetGame(ctx)
+	game.StartHonestChallenger(ctx, sys.NodeEndpoint("l1"), "Challenger", func(c *config.Config) {
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+	})
+	game.WaitForClaimCount(ctx, 2)
+
+	sys.TimeTravelClock.AdvanceTime(game.GameDuration(ctx))
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
+	game.WaitForGameStatus(ctx, disputegame.StatusChallengerWins)
+	game.RequireGameIntegrity(ctx, game.TraceProvider(disputegame.CorrectAlphabet))
+}
+
+func TestWatchStatus(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartHonestAlphabetGame(ctx)
+	watchCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+	changes := game.WatchStatus(watchCtx)
+
+	gameDuration := game.GameDuration(ctx)
+	sys.TimeTravelClock.AdvanceTime(gameDuration)
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
+	game.Resolve(ctx)
+
+	change, ok := <-changes
+	require.True(t, ok, "should report status change before timing out")
+	require.Equal(t, disputegame.StatusDefenderWins, change.Status)
+	require.Greater(t, change.BlockNumber, game.CreatedAt().BlockNumber)
+	require.GreaterOrEqual(t, change.Timestamp, game.CreatedAt().Timestamp+uint64(gameDuration/time.Second))
+}
+
+func TestHonestRootSurvivesAttack(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartHonestAlphabetGame(ctx)
+	game.RequireHonestRootSurvives(ctx, sys.TimeTravelClock)
+}
+
+func TestGameCreationRequiresCheckpoint(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	l1Head, err := sys.L1Client.BlockNumber(ctx)
+	require.NoError(t, err)
+
+	err = sys.Factory.TryStartGameWithoutCheckpoint(ctx, 0, common.Hash{0xaa}, l1Head)
+	require.ErrorContains(t, err, "BlockHashNotPresent", "should not create game when L1 head is not checkpointed")
+	checkpointed, err := sys.Factory.IsBlockCheckpointed(ctx, l1Head)
+	require.NoError(t, err)
+	require.False(t, checkpointed)
+}
+
+// TestStepUsesCheckpointedL1Head verifies the L1 head a cannon game's local inputs depend on is in the block oracle
+// before the challenger steps. Games can't be created until their L1 head is checkpointed so the challenger never
+// needs to checkpoint it itself.
+func TestStepUsesCheckpointedL1Head(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t, WithFactoryOwner())
+
+	game := sys.Factory.StartFixtureCannonGame(ctx, "testdata/cannon-fixture")
+	sys.Factory.WaitForBlockOracleCheckpoint(ctx, game.L1HeadNum(ctx))
+
+	game.StartChallenger(ctx, sys.NodeEndpoint("l1"), sys.NodeEndpoint("sequencer"), "Defender", func(c *config.Config) {
+		c.AgreeWithProposedOutput = false // Agree with the root claim, which is the fixture's final state
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+	})
+
+	// Make invalid claims down to max depth so the defender has to step against the last one
+	game.Attack(ctx, 0, common.Hash{0xaa})
+	game.WaitForClaimCount(ctx, 3)
+	game.Attack(ctx, 2, common.Hash{0xbb})
+	game.WaitForClaimAtMaxDepth(ctx, true)
+	require.NotEmpty(t, game.StepCalls(ctx), "challenger should have stepped")
+	checkpointed, err := sys.Factory.IsBlockCheckpointed(ctx, game.L1HeadNum(ctx))
+	require.NoError(t, err)
+	require.True(t, checkpointed)
+}
+
+func TestChallengerSkipsFinalizedOutput(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	checkingFactory := disputegame.NewFactoryHelper(t, ctx, sys.cfg.L1Deployments, sys.L1Client, disputegame.WithFinalizationCheck())
+	require.NoError(t, checkingFactory.WaitForProposals(ctx))
+	require.NoError(t, checkingFactory.CheckDisputedOutputNotFinalized(ctx))
+
+	sys.Factory.WaitForOutputFinalized(ctx, sys.Factory.DisputedOutputIndex(ctx))
+	require.ErrorIs(t, checkingFactory.CheckDisputedOutputNotFinalized(ctx), disputegame.ErrOutputFinalized)
+
+	// Someone creates a game for the finalized output anyway
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	require.NotNil(t, game)
+
+	game.StartChallenger(ctx, sys.NodeEndpoint("l1"), "Challenger", func(c *config.Config) {
+		c.AgreeWithProposedOutput = true // Agree with the proposed output, so disagree with the root claim
+		c.AlphabetTrace = disputegame.CorrectAlphabet
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+		c.SkipFinalizedOutputs = true
+	})
+
+	// Challenger should skip the game rather than countering the root claim
+	require.Never(t, func() bool {
+		return game.ClaimCount(ctx) != 1
+	}, 10*time.Second, time.Second, "challenger should not act on a game disputing a finalized output")
+}
+
+// TestChallengerSurvivesOutputDeletion deletes the output disputed by a game while the game is in progress.
+// The game keeps the proposals it loaded when it was created, so the challenger still wins the game against the
+// invalid root claim, even though it can no longer load the disputed output to check if it has finalized.
+func TestChallengerSurvivesOutputDeletion(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t, WithL2OutputOracleAdmin())
+	// The devnet finalizes outputs almost immediately and finalized outputs can't be deleted
+	sys.Factory.UpgradeL2OutputOracle(ctx, 24*time.Hour)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	c := game.StartChallenger(ctx, sys.NodeEndpoint("l1"), "Challenger", func(c *config.Config) {
+		c.AgreeWithProposedOutput = true // Agree with the proposed output, so disagree with the root claim
+		c.AlphabetTrace = disputegame.CorrectAlphabet
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+		c.SkipFinalizedOutputs = true
+	})
+	game.WaitForClaimCount(ctx, 2)
+
+	// Stop the proposer so the deleted outputs aren't proposed again
+	sys.L2OutputSubmitter.Stop()
+	sys.L2OutputSubmitter = nil
+	sys.Factory.RequireGameSurvivesOutputDeletion(ctx, &game.FaultGameHelper, c, disputegame.StatusChallengerWins)
+}
+
+func TestParallelDisputesOfSameOutput(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	sys.Factory.RequireParallelDisputesResolveIndependently(ctx, sys.NodeEndpoint("l1"), func(c *config.Config) {
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
 	})
+}
+
+func TestFactoryRejectsUnregisteredGameType(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	sys.Factory.RequireUnregisteredGameTypeRejected(ctx)
+}
+
+func TestCreationEventIndexed(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	sys.Factory.StartHonestAlphabetGame(ctx)
+	sys.Factory.RequireCreationEventIndexed(ctx, 0)
+}
+
+func TestGameFeatureDetection(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	require.NotNil(t, game)
+
+	// The e2e tests only deploy 0.0.7, which predates bonds and subgame resolution.
+	require.Equal(t, "0.0.7", game.Version(ctx))
+	require.False(t, game.SupportsBonds(ctx), "bonds support")
+	require.False(t, game.SupportsSubgameResolution(ctx), "subgame resolution support")
+}
+
+// TestGameL2BlockNumber checks the L2 block claimed by games is consistent with the proposed outputs and L2 chain,
+// and that challengers use it, with the default L2 block time and one that is a multiple of the L1 block time.
+func TestGameL2BlockNumber(t *testing.T) {
+	InitParallel(t)
+
+	for _, blockTime := range []uint64{1, 5} {
+		blockTime := blockTime // avoid loop var capture
+		t.Run(fmt.Sprintf("BlockTime%vs", blockTime), func(t *testing.T) {
+			InitParallel(t)
+
+			ctx := context.Background()
+			sys := NewFaultProofSystem(t, WithL2BlockTime(blockTime))
+			l2Client := sys.Clients["sequencer"]
+
+			alphabetGame := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+			alphabetGame.RequireL2BlockNumber(ctx, sys.Factory.DisputedL2BlockNumber())
+			alphabetGame.RequireClaimedBlockProposed(ctx)
+			alphabetGame.RequireClaimedBlockTime(ctx, l2Client, blockTime)
+			alphabetGame.StartChallenger(ctx, sys.NodeEndpoint("l1"), "Challenger", func(c *config.Config) {
+				c.AgreeWithProposedOutput = true // Agree with the proposed output, so disagree with the root claim
+				c.AlphabetTrace = disputegame.CorrectAlphabet
+				c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+			})
+			alphabetGame.WaitForClaimCount(ctx, 2)
+
+			cannonGame := sys.Factory.StartCannonGame(ctx, common.Hash{0xaa})
+			cannonGame.RequireL2BlockNumber(ctx, sys.Factory.DisputedL2BlockNumber())
+			cannonGame.RequireClaimedBlockProposed(ctx)
+			cannonGame.RequireClaimedBlockTime(ctx, l2Client, blockTime)
+			c := cannonGame.StartChallenger(ctx, sys.NodeEndpoint("l1"), sys.NodeEndpoint("sequencer"), "Challenger", func(c *config.Config) {
+				c.AgreeWithProposedOutput = true // Agree with the proposed output, so disagree with the root claim
+				c.TxMgrConfig.PrivateKey = sys.Bob.PrivateKeyHex()
+			})
+			cannonGame.RequireCannonInputsMatchGame(ctx, c)
+		})
+	}
+}
+
+func TestGameCreatedAt(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	require.NotNil(t, game)
+
+	created := game.CreatedAt()
+	rcpt, err := sys.L1Client.TransactionReceipt(ctx, created.TxHash)
+	require.NoError(t, err)
+	require.Equal(t, rcpt.BlockNumber.Uint64(), created.BlockNumber)
+	header, err := sys.L1Client.HeaderByNumber(ctx, rcpt.BlockNumber)
+	require.NoError(t, err)
+	require.Equal(t, header.Time, created.Timestamp)
+
+	// A game wrapped from the factory listing by a different helper recovers the same creation details
+	otherFactory := disputegame.NewFactoryHelper(t, ctx, sys.cfg.L1Deployments, sys.L1Client)
+	addrs, err := otherFactory.GameAddresses(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{game.Addr()}, addrs)
+	require.Equal(t, created, otherFactory.WrapGame(ctx, addrs[0]).CreatedAt())
+}
+
+func TestGetAllClaimsRPCCalls(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	countingFactory := disputegame.NewFactoryHelper(t, ctx, sys.cfg.L1Deployments, sys.L1Client, disputegame.WithRPCCounting(sys.Nodes["l1"].HTTPEndpoint()))
+	game := countingFactory.StartAlphabetGame(ctx, "abcdexyz")
+	game.Attack(ctx, 0, common.Hash{0xaa})
+	game.WaitForClaimCount(ctx, 2)
+	game.Attack(ctx, 1, common.Hash{0xbb})
+	game.WaitForClaimCount(ctx, 3)
+
+	countingFactory.ResetRPCStats()
+	claims := game.GetAllClaims(ctx)
+	require.Len(t, claims, 3)
+	// Loading the claim count plus at most one call per claim
+	stats := countingFactory.RPCStats()
+	require.LessOrEqual(t, stats["eth_call"], len(claims)+1, "claims loaded with too many calls: %v", stats)
+}
+
+func TestDuplicateMoveRejected(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	game.RequireDuplicateMoveRejected(ctx, 0, common.Hash{0xaa})
+	// A different value at the same position is not a duplicate
+	game.Attack(ctx, 0, common.Hash{0xbb})
+	game.WaitForClaimCount(ctx, 3)
+	game.RequireNoDuplicateClaims(ctx)
+}
+
+func TestClockAccounting(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	// Alternate moves, letting time pass between each so both clocks accumulate time
+	game.Attack(ctx, 0, common.Hash{0xaa})
+	sys.TimeTravelClock.AdvanceTime(30 * time.Second)
+	game.Attack(ctx, 1, common.Hash{0xbb})
+	sys.TimeTravelClock.AdvanceTime(60 * time.Second)
+	game.Defend(ctx, 2, common.Hash{0xcc})
+	sys.TimeTravelClock.AdvanceTime(30 * time.Second)
+	game.Attack(ctx, 3, common.Hash{0xdd})
+	game.WaitForClaimCount(ctx, 5)
+
+	game.RequireClockAccounting(ctx)
+}
+
+func TestClockAccountingWithSlowL1Blocks(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t, WithL1BlockTime(12))
+	sys.Factory.RequireL1BlockInterval(ctx, 12*time.Second)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	// Each move waits for the next L1 block so the time until it is included is charged to the mover
+	game.Attack(ctx, 0, common.Hash{0xaa})
+	game.Attack(ctx, 1, common.Hash{0xbb})
+	claims := game.GetAllClaims(ctx)
+	for i, claim := range claims[1:] {
+		elapsed := claim.ClockTimestamp() - claims[0].ClockTimestamp()
+		require.NotZerof(t, elapsed, "claim %v made in the same block as the root claim", i+1)
+		require.Zerof(t, elapsed%12, "claim %v made %vs after the root claim, which is not a whole number of L1 blocks", i+1, elapsed)
+	}
+
+	sys.SetL1BlockTime(4)
+	sys.Factory.RequireL1BlockInterval(ctx, 4*time.Second)
+	game.Defend(ctx, 2, common.Hash{0xcc})
+	game.WaitForClaimCount(ctx, 4)
+	game.RequireClockAccounting(ctx)
+}
+
+func TestExpiredClockMoveRejected(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	game.Attack(ctx, 0, common.Hash{0xaa})
+	game.WaitForClaimCount(ctx, 2)
+
+	game.RequireExpiredClockMoveRejected(ctx, sys.Bob.Key)
+}
+
+func TestExportGameJSON(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	game.Attack(ctx, 0, common.Hash{0xaa})
+	game.WaitForClaimCount(ctx, 2)
+
+	path := filepath.Join(t.TempDir(), "game.json")
+	game.ExportJSON(ctx, path)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var export disputegame.GameExport
+	require.NoError(t, json.Unmarshal(data, &export))
+
+	require.Equal(t, game.Addr(), export.Address)
+	require.Equal(t, game.CreatedAt(), export.CreatedAt)
+	require.Equal(t, disputegame.StatusInProgress, export.StatusCode)
+	require.Len(t, export.Claims, 2)
+	require.Equal(t, "root", export.Claims[0].Path)
+	require.Equal(t, common.Hash{0xaa}, export.Claims[1].Value)
+	require.Equal(t, uint32(0), export.Claims[1].ParentIndex)
+	require.Equal(t, "L", export.Claims[1].Path)
+	for _, claim := range export.Claims {
+		require.Equal(t, deployer.TestAddress, claim.Claimant)
+	}
+}
+
+func TestReplayGameFromJSON(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	game.Attack(ctx, 0, common.Hash{0xaa})
+	game.WaitForClaimCount(ctx, 2)
+	game.Attack(ctx, 1, common.Hash{0xbb})
+	game.WaitForClaimCount(ctx, 3)
+	game.Defend(ctx, 2, common.Hash{0xcc})
+	game.WaitForClaimCount(ctx, 4)
+
+	path := filepath.Join(t.TempDir(), "game.json")
+	game.ExportJSON(ctx, path)
+	replayed := sys.Factory.StartGameFromJSON(ctx, path)
+	require.NotEqual(t, game.Addr(), replayed.Addr())
+
+	original := game.Export(ctx)
+	recreated := replayed.Export(ctx)
+	require.Equal(t, original.GameType, recreated.GameType)
+	require.Equal(t, original.RootClaim, recreated.RootClaim)
+	require.Equal(t, original.L2BlockNumber, recreated.L2BlockNumber)
+	require.Len(t, recreated.Claims, len(original.Claims))
+	for i, claim := range original.Claims {
+		require.Equal(t, claim.ParentIndex, recreated.Claims[i].ParentIndex)
+		require.Equal(t, claim.Value, recreated.Claims[i].Value)
+		require.Equal(t, claim.Position, recreated.Claims[i].Position)
+	}
+}
+
+func TestImplementationRegistry(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	registry := sys.Factory.ImplementationRegistry(ctx)
+	require.Contains(t, registry, uint8(0), "alphabet game should be registered")
+	require.Contains(t, registry, uint8(1), "cannon game should be registered")
+	for gameType, impl := range registry {
+		code, err := sys.L1Client.CodeAt(ctx, impl, nil)
+		require.NoError(t, err)
+		require.NotEmptyf(t, code, "implementation for game type %v has no code", gameType)
+	}
+
+	// Creating games doesn't change the registered implementations
+	sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	require.Empty(t, disputegame.DiffImplementationRegistry(registry, sys.Factory.ImplementationRegistry(ctx)))
+}
+
+func TestCreateGameIndirectly(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	rootClaim := common.Hash{0xaa}
+	extraData := sys.Factory.CheckpointedExtraData(ctx)
+	data := sys.Factory.CreateGameCalldata(0, rootClaim, extraData)
+
+	// Submit the creation from a different account to the one the factory helper uses
+	chainID, err := sys.L1Client.ChainID(ctx)
+	require.NoError(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(sys.Bob.Key, chainID)
+	require.NoError(t, err)
+	factory := bind.NewBoundContract(sys.Factory.FactoryAddress(), abi.ABI{}, sys.L1Client, sys.L1Client, sys.L1Client)
+	tx, err := factory.RawTransact(opts, data)
+	require.NoError(t, err)
+	_, err = utils.WaitReceiptOK(ctx, sys.L1Client, tx.Hash())
+	require.NoError(t, err)
+
+	games, err := sys.Factory.GameAddresses(ctx)
+	require.NoError(t, err)
+	require.Len(t, games, 1)
+	game := sys.Factory.WrapGame(ctx, games[0])
+	require.Equal(t, tx.Hash(), game.CreatedAt().TxHash)
+	game.RequireParams(ctx, 0, rootClaim, extraData)
+	export := game.Export(ctx)
+	require.Equal(t, rootClaim, export.RootClaim)
+	require.Equal(t, sys.Bob.Address, export.Claims[0].Claimant)
+}
+
+func TestCreateGameGas(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	rootClaim := common.Hash{0xaa}
+	alphabetGas := sys.Factory.MeasureCreateGas(ctx, 0, rootClaim)
+	cannonGas := sys.Factory.MeasureCreateGas(ctx, 1, rootClaim)
+	require.NotZero(t, alphabetGas)
+	require.NotZero(t, cannonGas)
+
+	// Games of the same type are created identically so should cost the same apart from the checkpointed L1 block
+	require.InDelta(t, alphabetGas, sys.Factory.MeasureCreateGas(ctx, 0, rootClaim), 1000)
+}
+
+func TestMoveGasByDepth(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartCannonGame(ctx, common.Hash{0x01, 0xaa})
+	gas := game.MeasureMoveGasByDepth(ctx)
+	require.Len(t, gas, game.MaxDepth())
+	// Moves against the root claim have no grandparent clock to load so only compare moves from depth 2.
+	for depth := 3; depth <= game.MaxDepth(); depth++ {
+		require.InDeltaf(t, gas[2], gas[depth], 5000, "move gas at depth %v should not grow with depth", depth)
+	}
+}
+
+func TestPlayGameFromTraceProvider(t *testing.T) {
+	InitParallel(t)
+
+	tests := []struct {
+		name     string
+		honest   bool
+		expected disputegame.Status
+	}{
+		{name: "HonestRoot", honest: true, expected: disputegame.StatusDefenderWins},
+		{name: "InvalidRoot", honest: false, expected: disputegame.StatusChallengerWins},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			InitParallel(t)
+
+			ctx := context.Background()
+			sys := NewFaultProofSystem(t)
+
+			provider := alphabet.NewTraceProvider(disputegame.CorrectAlphabet, 4)
+			status := sys.Factory.PlayGame(ctx, provider, test.honest, sys.Mallory.Key, disputegame.PlayWithTimeAdvancer(sys.TimeTravelClock))
+			require.Equal(t, test.expected, status)
+		})
+	}
+}
+
+func TestUpgradeGameImplementation(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t, WithFactoryOwner())
+
+	existingGame := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	existingBehaviour := existingGame.Behaviour(ctx)
+
+	registry := sys.Factory.ImplementationRegistry(ctx)
+	impl := sys.Factory.DeployAlphabetImplementation(ctx, existingBehaviour.GameDuration*2)
+	sys.Factory.SetImplementation(ctx, 0, impl)
+	require.Equal(t, map[uint8]disputegame.ImplementationChange{0: {Old: registry[0], New: impl}},
+		disputegame.DiffImplementationRegistry(registry, sys.Factory.ImplementationRegistry(ctx)))
+
+	// The existing game keeps the behaviour of the implementation it was created with
+	existingGame.RequireBehaviour(ctx, existingBehaviour)
+
+	// New games use the new implementation
+	newBehaviour := sys.Factory.ImplementationBehaviour(ctx, impl)
+	require.Equal(t, existingBehaviour.GameDuration*2, newBehaviour.GameDuration)
+	newGame := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	newGame.RequireBehaviour(ctx, newBehaviour)
+}
+
+// TestCreateGameWithExtraDataEncoder checks a game is created with the extra data built by an encoder the helper
+// doesn't know about, for an implementation registered under its own game type.
+func TestCreateGameWithExtraDataEncoder(t *testing.T) {
+	InitParallel(t)
+
+	const gameType = uint8(3)
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t, WithFactoryOwner(), WithFactoryOptions(disputegame.WithAlphabetGameType(gameType)))
+	sys.Factory.RegisterAlphabetImplementation(ctx, gameType)
+
+	encoder := &recordingExtraDataEncoder{}
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz", disputegame.WithExtraDataEncoder(encoder))
+	require.NotNil(t, encoder.encoded, "game not created with custom encoder")
+	params := game.Params(ctx)
+	require.Equal(t, gameType, params.GameType)
+	require.Equal(t, encoder.encoded, params.ExtraData, "game extra data should be built by the custom encoder")
+}
+
+// recordingExtraDataEncoder is an ExtraDataEncoder the game helper doesn't know about. It encodes extra data the same
+// way as the helper and records the last extra data it built.
+type recordingExtraDataEncoder struct {
+	encoded []byte
+}
+
+func (e *recordingExtraDataEncoder) EncodeExtraData(d disputegame.GameExtraData) ([]byte, error) {
+	e.encoded = d.Encode()
+	return e.encoded, nil
+}
+
+func TestGameDurationForMoves(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	// Deliberately tiny so the game resolves as soon as the scripted moves are made
+	sys := NewFaultProofSystem(t, WithFactoryOwner(), WithFactoryOptions(disputegame.WithGameDurationForMoves(2, 10*time.Second)))
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	require.Equal(t, disputegame.RequiredGameDuration(2, 10*time.Second), game.GameDuration(ctx))
+
+	game.Attack(ctx, 0, common.Hash{0xaa})
+	game.Attack(ctx, 1, common.Hash{0xbb})
+	require.EqualValues(t, 3, game.ClaimCount(ctx))
+
+	// The duration only allows time for the scripted moves, so a further move is rejected once the clock runs out
+	game.RequireExpiredClockMoveRejected(ctx, sys.Bob.Key)
+
+	sys.TimeTravelClock.AdvanceTime(game.GameDuration(ctx))
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
+	game.Resolve(ctx)
+	game.WaitForGameStatus(ctx, disputegame.StatusDefenderWins)
+}
+
+func TestMovesRejectedAfterResolution(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	game.Attack(ctx, 0, common.Hash{0xaa})
+	game.WaitForClaimCount(ctx, 2)
+
+	sys.TimeTravelClock.AdvanceTime(game.GameDuration(ctx))
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
+	game.Resolve(ctx)
+	game.WaitForGameStatus(ctx, disputegame.StatusChallengerWins)
+	game.RequireMovesRejectedAfterResolution(ctx)
+}
+
+func TestChallengerPrioritizesGamesClosestToExpiry(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t, WithFactoryOwner(), WithFactoryOptions(disputegame.WithGameDurationForMoves(4, time.Minute)))
+
+	// Stagger creation so each game has less time remaining than the one created after it.
+	oldest := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	sys.TimeTravelClock.AdvanceTime(time.Minute)
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
+	middle := sys.Factory.StartAlphabetGame(ctx, "abcdefgz")
+	sys.TimeTravelClock.AdvanceTime(time.Minute)
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
+	newest := sys.Factory.StartAlphabetGame(ctx, "abcdefgy")
+
+	// Only start the challenger once all games exist, listing the games with the most remaining time first.
+	newest.StartChallenger(ctx, sys.NodeEndpoint("l1"), "Challenger", func(c *config.Config) {
+		c.AgreeWithProposedOutput = true // Agree with the proposed output, so disagree with the root claim
+		c.AlphabetTrace = disputegame.CorrectAlphabet
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+	}, challenger.WithAdditionalGames(middle.Addr(), oldest.Addr()))
+
+	moves := sys.Factory.CollectMoves(&oldest.FaultGameHelper, &middle.FaultGameHelper, &newest.FaultGameHelper)
+	moves.RequireFirstResponseToEarliestExpiry(ctx, sys.Alice.Address)
+}
+
+func TestFactoryOwnership(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t, WithFactoryOwner())
+
+	sys.Factory.RequireOwner(ctx, sys.cfg.Secrets.Addresses().Deployer)
+	sys.Factory.RequireAdminCallsRejected(ctx, sys.Bob.Address)
+
+	sys.Factory.TransferOwnership(ctx, sys.Bob.Address)
+	sys.Factory.RequireAdminCallsRejected(ctx, sys.cfg.Secrets.Addresses().Deployer)
+}
+
+func TestAnchorRoot(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	require.NotNil(t, game)
+
+	root, l2BlockNum := sys.Factory.AnchorRoot(ctx)
+	require.NotEqual(t, common.Hash{}, root)
+	require.Less(t, l2BlockNum.Uint64(), game.L2BlockNumber(ctx))
+
+	// The anchor is the proposal immediately before the disputed output
+	anchor := sys.Factory.RequireOutputAt(ctx, l2BlockNum.Uint64())
+	require.Equal(t, root, anchor.OutputRoot)
+	snapshot := sys.Factory.L2OOSnapshot(ctx)
+	require.Greater(t, len(snapshot), int(anchor.Index)+1)
+	require.GreaterOrEqual(t, snapshot[anchor.Index+1].L2BlockNumber, game.L2BlockNumber(ctx))
+}
+
+func TestAnchorNotUpdatedByResolution(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	root, l2BlockNum := sys.Factory.AnchorRoot(ctx)
+
+	sys.TimeTravelClock.AdvanceTime(game.GameDuration(ctx))
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
+	game.Resolve(ctx)
+	game.WaitForGameStatus(ctx, disputegame.StatusDefenderWins)
+
+	// The root claim was invalid but went unchallenged so the game resolved in its favour. Games still start from
+	// the output in the L2OutputOracle rather than the resolved root claim.
+	sys.Factory.RequireAnchorRoot(ctx, root, l2BlockNum)
+}
+
+func TestResolveAllResolvableGames(t *testing.T) {
+	InitParallel(t)
 
-	game.WaitForClaimCount(ctx, 2)
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	alreadyResolved := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	expired1 := sys.Factory.StartAlphabetGame(ctx, "abcdefgz")
+	expired2 := sys.Factory.StartAlphabetGame(ctx, "abcdefgy")
+	gameDuration := alreadyResolved.GameDuration(ctx)
 
 	sys.TimeTravelClock.AdvanceTime(gameDuration)
-	require.NoError(t, utils.WaitNextBlock(ctx, l1Client))
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
+	alreadyResolved.Resolve(ctx)
 
-	// Challenger should resolve the game now that the clocks have expired.
-	game.WaitForGameStatus(ctx, disputegame.StatusChallengerWins)
+	// Games created after time travelling still have time remaining on their clocks
+	inProgress1 := sys.Factory.StartAlphabetGame(ctx, "abcdefgx")
+	inProgress2 := sys.Factory.StartAlphabetGame(ctx, "abcdefgw")
+
+	results := sys.Factory.ResolveAllResolvableGames(ctx)
+	require.Equal(t, []disputegame.GameResolution{
+		{Game: alreadyResolved.Addr(), Status: disputegame.StatusDefenderWins, SkipReason: "already resolved"},
+		{Game: expired1.Addr(), Status: disputegame.StatusDefenderWins, Resolved: true},
+		{Game: expired2.Addr(), Status: disputegame.StatusDefenderWins, Resolved: true},
+		{Game: inProgress1.Addr(), Status: disputegame.StatusInProgress, SkipReason: "clocks not expired"},
+		{Game: inProgress2.Addr(), Status: disputegame.StatusInProgress, SkipReason: "clocks not expired"},
+	}, results)
+	expired1.WaitForGameStatus(ctx, disputegame.StatusDefenderWins)
+	expired2.WaitForGameStatus(ctx, disputegame.StatusDefenderWins)
+	inProgress1.WaitForGameStatus(ctx, disputegame.StatusInProgress)
+	inProgress2.WaitForGameStatus(ctx, disputegame.StatusInProgress)
+
+	// None of the deployed games support bonds so there is no credit to claim
+	for _, claim := range sys.Factory.ClaimAllCredit(ctx, sys.cfg.Secrets.Addresses().Alice) {
+		require.Zero(t, claim.Amount.Sign())
+	}
 }
 
 func TestChallengerCompleteDisputeGame(t *testing.T) {
@@ -115,11 +909,9 @@ func TestChallengerCompleteDisputeGame(t *testing.T) {
 			InitParallel(t)
 
 			ctx := context.Background()
-			sys, l1Client := startFaultDisputeSystem(t)
-			t.Cleanup(sys.Close)
+			sys := NewFaultProofSystem(t)
 
-			disputeGameFactory := disputegame.NewFactoryHelper(t, ctx, sys.cfg.L1Deployments, l1Client)
-			game := disputeGameFactory.StartAlphabetGame(ctx, test.rootClaimAlphabet)
+			game := sys.Factory.StartAlphabetGame(ctx, test.rootClaimAlphabet)
 			require.NotNil(t, game)
 			gameDuration := game.GameDuration(ctx)
 
@@ -137,9 +929,388 @@ func TestChallengerCompleteDisputeGame(t *testing.T) {
 			game.WaitForClaimAtMaxDepth(ctx, test.expectStep)
 
 			sys.TimeTravelClock.AdvanceTime(gameDuration)
-			require.NoError(t, utils.WaitNextBlock(ctx, l1Client))
+			require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
 
 			game.WaitForGameStatus(ctx, test.expectedResult)
+			if test.expectStep {
+				game.AssertStepConsistency(ctx, game.TraceProvider(test.otherAlphabet))
+			}
+		})
+	}
+}
+
+func TestChallengerRespondsAfterL1Reorg(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	require.NotNil(t, game)
+
+	challenger := game.StartChallenger(ctx, sys.NodeEndpoint("l1"), "Challenger", func(c *config.Config) {
+		c.AgreeWithProposedOutput = true // Agree with the proposed output, so disagree with the root claim
+		c.AlphabetTrace = disputegame.CorrectAlphabet
+		c.TxMgrConfig.PrivateKey = e2eutils.EncodePrivKeyToString(sys.cfg.Secrets.Alice)
+	})
+
+	factory, err := bindings.NewDisputeGameFactory(sys.cfg.L1Deployments.DisputeGameFactoryProxy, sys.L1Client)
+	require.NoError(t, err)
+	createdIn := func() *types.Log {
+		iter, err := factory.FilterDisputeGameCreated(&bind.FilterOpts{Context: ctx}, []common.Address{game.Addr()}, nil, nil)
+		require.NoError(t, err)
+		defer iter.Close()
+		require.True(t, iter.Next(), "game creation event not found")
+		return &iter.Event.Raw
+	}
+	origCreation := createdIn()
+	head, err := sys.L1Client.BlockNumber(ctx)
+	require.NoError(t, err)
+
+	// Reorg out at least the last two blocks, including the one the game was created in
+	depth := head - origCreation.BlockNumber + 1
+	if depth < 2 {
+		depth = 2
+	}
+	removed, err := e2eutils.ReorgL1(ctx, sys.Backends["l1"], depth)
+	require.NoError(t, err)
+	require.Contains(t, removed, origCreation.BlockHash)
+
+	// The creation tx should be included again in a different block
+	require.Eventually(t, func() bool {
+		code, err := sys.L1Client.CodeAt(ctx, game.Addr(), nil)
+		return err == nil && len(code) > 0
+	}, time.Minute, time.Second, "game should be recreated")
+	require.NotEqual(t, origCreation.BlockHash, createdIn().BlockHash)
+
+	// Challenger should respond to the root claim exactly once
+	game.WaitForClaimCount(ctx, 2)
+	require.Never(t, func() bool {
+		return game.ClaimCount(ctx) != 2
+	}, 10*time.Second, time.Second, "challenger should not make duplicate moves")
+	audit := game.AuditTxsFrom(ctx, sys.cfg.Secrets.Addresses().Alice)
+	require.Equal(t, 1, audit.Landed)
+	require.Equal(t, 1, audit.Total)
+	sys.Factory.AssertChallengerViewMatchesChain(ctx, challenger, game.Addr())
+}
+
+func TestClaimsSurviveL1Reorg(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	game.Attack(ctx, 0, common.Hash{0xaa})
+	game.Attack(ctx, 1, common.Hash{0xbb})
+	game.Defend(ctx, 2, common.Hash{0xcc})
+	game.WaitForClaimCount(ctx, 4)
+
+	// Reorg out every block since the game was created so all of its claims are re-included
+	head, err := sys.L1Client.BlockNumber(ctx)
+	require.NoError(t, err)
+	game.RequireClaimsSurviveReorg(ctx, sys.Backends["l1"], head-game.CreatedAt().BlockNumber+1)
+	game.RequireNoDuplicateClaims(ctx)
+}
+
+func TestChallengerRecoversFromNetworkFailure(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	require.NotNil(t, game)
+
+	challenger := game.StartChallenger(ctx, sys.NodeEndpoint("l1"), "Challenger", func(c *config.Config) {
+		c.AgreeWithProposedOutput = true // Agree with the proposed output, so disagree with the root claim
+		c.AlphabetTrace = disputegame.CorrectAlphabet
+		c.TxMgrConfig.PrivateKey = e2eutils.EncodePrivKeyToString(sys.cfg.Secrets.Alice)
+	})
+	challenger.SetNetworkFailing(true)
+
+	// Challenger can't respond while it can't reach L1
+	claimCount := game.ClaimCount(ctx)
+	require.Never(t, func() bool {
+		return game.ClaimCount(ctx) != claimCount
+	}, 10*time.Second, time.Second, "challenger should not respond without L1 connectivity")
+
+	// Once restored the challenger should counter the root claim without making any invalid moves
+	challenger.SetNetworkFailing(false)
+	game.WaitForClaimCount(ctx, 2)
+	game.RequireNoWastedTxs(ctx, sys.cfg.Secrets.Addresses().Alice, 0)
+}
+
+// counterRootTraceIndex is the trace index of the claim that attacks the root of an alphabet game.
+var counterRootTraceIndex = func() uint64 {
+	root := faultTypes.NewPositionFromGIndex(1)
+	counter := root.Attack()
+	return counter.TraceIndex(4)
+}()
+
+func TestChallengerRetriesTraceProviderFailures(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+
+	faults := faultTest.NewTraceFaults()
+	faults.FailNextGets(5)
+	game.StartChallenger(ctx, sys.NodeEndpoint("l1"), "Challenger", func(c *config.Config) {
+		c.AgreeWithProposedOutput = true // Agree with the proposed output, so disagree with the root claim
+		c.AlphabetTrace = disputegame.CorrectAlphabet
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+	}, challenger.WithTraceFaults(faults))
+
+	// The challenger keeps retrying until the trace provider recovers and then counters the root claim in time
+	game.WaitForClaimCount(ctx, 2)
+	require.Equal(t, 5, faults.FailedGets())
+	counter := game.GetAllClaims(ctx)[1]
+	expected, err := alphabet.NewTraceProvider(disputegame.CorrectAlphabet, 4).Get(ctx, counterRootTraceIndex)
+	require.NoError(t, err)
+	require.Equal(t, expected, common.Hash(counter.Claim))
+	require.Less(t, counter.ClockDuration(), game.GameDuration(ctx)/2)
+}
+
+// TestChallengerPostsCorruptTrace documents a known risk: the challenger trusts its trace provider, so a corrupt
+// claim value is posted on chain as is. The move is still detectably wrong as it doesn't match the honest trace.
+func TestChallengerPostsCorruptTrace(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+
+	corrupt := common.Hash{0xbd}
+	faults := faultTest.NewTraceFaults()
+	faults.CorruptGet(counterRootTraceIndex, corrupt)
+	game.StartChallenger(ctx, sys.NodeEndpoint("l1"), "Challenger", func(c *config.Config) {
+		c.AgreeWithProposedOutput = true // Agree with the proposed output, so disagree with the root claim
+		c.AlphabetTrace = disputegame.CorrectAlphabet
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+	}, challenger.WithTraceFaults(faults))
+
+	game.WaitForClaimCount(ctx, 2)
+	counter := game.GetAllClaims(ctx)[1]
+	require.Equal(t, corrupt, common.Hash(counter.Claim), "challenger should post the corrupt value")
+	honest, err := alphabet.NewTraceProvider(disputegame.CorrectAlphabet, 4).Get(ctx, counterRootTraceIndex)
+	require.NoError(t, err)
+	require.NotEqual(t, honest, common.Hash(counter.Claim), "corrupt move should differ from the honest trace")
+}
+
+func TestChallengerWinsUnderClockPressure(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	require.NotNil(t, game)
+	gameDuration := game.GameDuration(ctx)
+
+	// The adversary uses 90% of its remaining clock before every move to leave the challenger as little time as possible
+	game.CreateDishonestHelper("abcdexyz",
+		disputegame.WithDelayPolicy(disputegame.FractionOfRemainingClock(0.9)),
+		disputegame.WithTimeAdvancer(sys.TimeTravelClock),
+	).Start(ctx)
+
+	challenger := game.StartChallenger(ctx, sys.NodeEndpoint("l1"), "Challenger", func(c *config.Config) {
+		c.AgreeWithProposedOutput = true // Agree with the proposed output, so disagree with the root claim
+		c.AlphabetTrace = disputegame.CorrectAlphabet
+		c.TxMgrConfig.PrivateKey = e2eutils.EncodePrivKeyToString(sys.cfg.Secrets.Alice)
+	})
+
+	// Challenger should step against the adversary's claim at max depth
+	game.WaitForClaimAtMaxDepth(ctx, true)
+	sys.Factory.AssertChallengerViewMatchesChain(ctx, challenger, game.Addr())
+
+	sys.TimeTravelClock.AdvanceTime(gameDuration)
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
+
+	game.WaitForGameStatus(ctx, disputegame.StatusChallengerWins)
+}
+
+func TestChallengerDefendsValidOutputAgainstAdversary(t *testing.T) {
+	InitParallel(t)
+	testChallengerDefendsValidOutputAgainstAdversary(t)
+}
+
+// TestChallengerDefendsValidOutputAgainstAdversaryRealisticL1 plays the same game against an L1 with mainnet's block
+// time, to check the challenger keeps up when it can't rely on fast blocks. Run by the nightly e2e job.
+func TestChallengerDefendsValidOutputAgainstAdversaryRealisticL1(t *testing.T) {
+	SkipUnlessRealisticL1(t)
+	InitParallel(t)
+	testChallengerDefendsValidOutputAgainstAdversary(t, WithRealisticL1())
+}
+
+func testChallengerDefendsValidOutputAgainstAdversary(t *testing.T, options ...FaultProofSystemOption) {
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t, options...)
+
+	// Mallory disputes the valid output by creating a game with an invalid root claim and defends it all the way down
+	game, _ := sys.Factory.StartAdversarialAlphabetGame(ctx, sys.Mallory.Key, "abcdexyz")
+	gameDuration := game.GameDuration(ctx)
+	game.StartHonestChallenger(ctx, sys.NodeEndpoint("l1"), "Challenger", func(c *config.Config) {
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+	})
+
+	// Challenger should step against the adversary's claim at max depth
+	game.WaitForClaimAtMaxDepth(ctx, true)
+	for _, claim := range game.Export(ctx).Claims {
+		expected := sys.Alice.Address
+		if claim.Depth%2 == 0 {
+			expected = sys.Mallory.Address
+		}
+		require.Equalf(t, expected, claim.Claimant, "unexpected claimant for claim %v at depth %v", claim.Index, claim.Depth)
+	}
+
+	sys.TimeTravelClock.AdvanceTime(gameDuration)
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
+
+	game.WaitForGameStatus(ctx, disputegame.StatusChallengerWins)
+	game.RequireConsistentHonestClaims(ctx, sys.Alice.Address)
+}
+
+func TestResolveResult(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game, _ := sys.Factory.StartAdversarialAlphabetGame(ctx, sys.Mallory.Key, "abcdexyz")
+	gameDuration := game.GameDuration(ctx)
+	honest := game.StartHonestChallenger(ctx, sys.NodeEndpoint("l1"), "Challenger", func(c *config.Config) {
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+	})
+	game.WaitForClaimAtMaxDepth(ctx, true)
+	// Stop the challenger so the game is resolved by the test rather than the challenger
+	require.NoError(t, honest.Close())
+
+	sys.TimeTravelClock.AdvanceTime(gameDuration)
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
+
+	result := game.Resolve(ctx)
+	require.Equal(t, disputegame.StatusChallengerWins, result.Status)
+	require.NotZero(t, result.GasUsed, "resolution should use gas")
+	// The deployed game doesn't take bonds so resolution pays out no credit
+	require.Empty(t, result.Credits)
+	require.Zero(t, result.TotalBonds.Sign())
+	require.Zero(t, result.TotalCredit.Sign())
+	game.RequireSubgameWinners(ctx, game.TraceProvider(disputegame.CorrectAlphabet))
+	_, claimant := game.SubgameWinner(ctx, 0)
+	require.Equal(t, sys.Alice.Address, claimant, "honest challenger should win the root claim's subgame")
+}
+
+func TestComputeOutputRoot(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	l2Client := sys.Clients["sequencer"]
+	require.NoError(t, utils.WaitBlock(ctx, l2Client, 8))
+
+	rollupRPCClient, err := rpc.DialContext(ctx, sys.RollupNodes["sequencer"].HTTPEndpoint())
+	require.NoError(t, err)
+	rollupClient := sources.NewRollupClient(client.NewBaseRPCClient(rollupRPCClient))
+	e2eutils.RequireOutputRootsMatch(t, ctx, rollupClient, l2Client, 8)
+}
+
+// TestChallengerBeatsRandomAttacker plays an honest challenger against a strategy that counters every claim the
+// challenger makes with a random claim. The seed is fixed so failures can be reproduced.
+func TestChallengerBeatsRandomAttacker(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	game.StartHonestChallenger(ctx, sys.NodeEndpoint("l1"), "Challenger", func(c *config.Config) {
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+	})
+	attacker := sys.Factory.StartStrategyFrom(ctx, &game.FaultGameHelper, sys.Mallory.Key, disputegame.NewRandomStrategy(42, 1))
+
+	// Challenger should step against the attacker's claim at max depth
+	game.WaitForClaimAtMaxDepth(ctx, true)
+	require.NotEmpty(t, attacker.Made())
+
+	sys.Factory.AdvanceL1Time(ctx, game.GameDuration(ctx))
+	game.WaitForGameStatus(ctx, disputegame.StatusChallengerWins)
+}
+
+// TestChallengerKeepsUpWithGameLoad creates games faster than they resolve while a single challenger plays all of
+// them, and requires that the challenger never lets a clock expire without making the honest move.
+func TestChallengerKeepsUpWithGameLoad(t *testing.T) {
+	SkipUnlessLongTests(t)
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	load := sys.Factory.RunAlphabetGameLoad(ctx, sys.NodeEndpoint("l1"), 30*time.Second, 10*time.Minute, func(c *config.Config) {
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+	})
+	load.RequireNoMissedClocks(ctx)
+	load.RequireResolvedCorrectly(ctx)
+}
+
+func TestChallengerDefendsAgreedClaim(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+	require.NotNil(t, game)
+
+	game.CreateDishonestHelper("abcdexyz").Start(ctx)
+
+	game.StartChallenger(ctx, sys.NodeEndpoint("l1"), "Challenger", func(c *config.Config) {
+		c.AgreeWithProposedOutput = true // Agree with the proposed output, so disagree with the root claim
+		c.AlphabetTrace = disputegame.CorrectAlphabet
+		c.TxMgrConfig.PrivateKey = e2eutils.EncodePrivKeyToString(sys.cfg.Secrets.Alice)
+	})
+
+	// The challenger attacks the root claim (claim 1) and the adversary then attacks that claim (claim 2).
+	// The adversary's claim at trace index 3 is correct so the challenger must defend it rather than attack.
+	game.RequireDefendedAt(ctx, 2)
+	game.RequireBisectionMidpoint(ctx, 0)
+	game.RequireBisectionMidpoint(ctx, 2)
+}
+
+func TestChallengerResponseTypeToAttackOnHonestClaim(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	// Each challenger runs until the test completes so they use different accounts.
+	tests := []struct {
+		name       string
+		agree      bool
+		challenger FaultProofActor
+	}{
+		{name: "AgreesDefend", agree: true, challenger: sys.Alice},
+		{name: "DisagreesAttack", agree: false, challenger: sys.Bob},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+			adversary := game.CreateDishonestHelper("abcdexyz")
+			game.StartHonestChallenger(ctx, sys.NodeEndpoint("l1"), "Challenger", func(c *config.Config) {
+				c.TxMgrConfig.PrivateKey = test.challenger.PrivateKeyHex()
+			})
+
+			// The challenger attacks the root claim and the adversary attacks that honest claim at depth 2.
+			idx := adversary.AttackHonestClaimAt(ctx, 2, game.TraceProvider(disputegame.CorrectAlphabet), test.agree)
+			if test.agree {
+				game.RequireDefendedAt(ctx, idx)
+				game.RequireNotAttackedAt(ctx, idx, 10*time.Second)
+			} else {
+				game.RequireAttackedAt(ctx, idx)
+			}
 		})
 	}
 }
@@ -149,34 +1320,229 @@ func TestCannonDisputeGame(t *testing.T) {
 	InitParallel(t)
 
 	ctx := context.Background()
-	sys, l1Client := startFaultDisputeSystem(t)
-	t.Cleanup(sys.Close)
+	sys := NewFaultProofSystem(t)
 
-	disputeGameFactory := disputegame.NewFactoryHelper(t, ctx, sys.cfg.L1Deployments, l1Client)
-	game := disputeGameFactory.StartCannonGame(ctx, common.Hash{0xaa})
+	game := sys.Factory.StartCannonGameForOutput(ctx, sys.RollupNodes["sequencer"].HTTPEndpoint(), common.Hash{0xaa})
 	require.NotNil(t, game)
 
-	game.StartChallenger(ctx, sys.NodeEndpoint("l1"), sys.NodeEndpoint("sequencer"), "Challenger", func(c *config.Config) {
+	c := game.StartChallenger(ctx, sys.NodeEndpoint("l1"), sys.NodeEndpoint("sequencer"), "Challenger", func(c *config.Config) {
 		c.AgreeWithProposedOutput = true // Agree with the proposed output, so disagree with the root claim
 		c.TxMgrConfig.PrivateKey = e2eutils.EncodePrivKeyToString(sys.cfg.Secrets.Alice)
 	})
 
 	// Challenger should counter the root claim
 	game.WaitForClaimCount(ctx, 2)
+	game.RequireCannonInputsMatchGame(ctx, c)
 
 	sys.TimeTravelClock.AdvanceTime(game.GameDuration(ctx))
-	require.NoError(t, utils.WaitNextBlock(ctx, l1Client))
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
+
+	game.WaitForGameStatus(ctx, disputegame.StatusChallengerWins)
+}
+
+// TestCannonTracePinnedAcrossL2Reorg verifies the challenger's cannon trace is derived from data fixed when the
+// game was created, even if the unsafe L2 chain reorgs before the challenger responds.
+func TestCannonTracePinnedAcrossL2Reorg(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t, WithFactoryOwner())
+
+	game := sys.Factory.StartFixtureCannonGame(ctx, "testdata/cannon-fixture")
+	// The test attacks the root claim with an invalid claim, which the defender attacks in turn.
+	response := faultTypes.NewPosition(2, 0)
+	pinned := game.PinTrace(ctx, sys.NodeEndpoint("l1"), sys.NodeEndpoint("sequencer"), response)
+
+	c := game.StartChallenger(ctx, sys.NodeEndpoint("l1"), sys.NodeEndpoint("sequencer"), "Defender", func(c *config.Config) {
+		c.AgreeWithProposedOutput = false // Agree with the root claim, which is the fixture's final state
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+	})
+
+	rollupRPCClient, err := rpc.DialContext(ctx, sys.RollupNodes["sequencer"].HTTPEndpoint())
+	require.NoError(t, err)
+	rollupClient := sources.NewRollupClient(client.NewBaseRPCClient(rollupRPCClient))
+	removed, err := e2eutils.ReorgL2Unsafe(ctx, rollupClient, sys.Backends["sequencer"], sys.Bob.Key)
+	require.NoError(t, err)
+	t.Logf("Reorged out %v unsafe L2 blocks", len(removed))
+
+	game.Attack(ctx, 0, common.Hash{0xaa})
+	game.WaitForClaimCount(ctx, 3)
+	game.RequireClaimsMatchPinnedTrace(ctx, c, pinned)
+}
+
+// TestCannonChallengerSurvivesRollupNodeRestart checks the challenger keeps countering claims in a cannon game while
+// op-node is stopped and after it restarts.
+func TestCannonChallengerSurvivesRollupNodeRestart(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t, WithFactoryOwner())
+
+	game := sys.Factory.StartFixtureCannonGame(ctx, "testdata/cannon-fixture")
+	game.StartChallenger(ctx, sys.NodeEndpoint("l1"), sys.NodeEndpoint("sequencer"), "Challenger", func(c *config.Config) {
+		c.AgreeWithProposedOutput = true // Agree with the proposed output, so disagree with the root claim
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+	})
+	game.WaitForClaimCount(ctx, 2)
+
+	// Post an invalid claim and restart op-node while the challenger is bisecting.
+	game.Attack(ctx, 1, common.Hash{0xbb})
+	require.NoError(t, sys.StopRollupNode("sequencer"))
+	game.WaitForClaimCount(ctx, 4)
+	require.NoError(t, sys.StartRollupNode(ctx, "sequencer"))
+
+	// Defend rather than attack so the challenger's response doesn't repeat its earlier claim at max depth.
+	game.Defend(ctx, 1, common.Hash{0xcc})
+	game.WaitForClaimCount(ctx, 6)
 
+	sys.TimeTravelClock.AdvanceTime(game.GameDuration(ctx))
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
 	game.WaitForGameStatus(ctx, disputegame.StatusChallengerWins)
+	game.RequireNoClaimSkipped(ctx, sys.Alice.Address)
+}
+
+// TestCannonFixtureDisputeGame covers the on-chain MIPS step using a pre-generated cannon trace, so it runs without
+// building or executing cannon. Regenerate the fixture with make cannon-fixture.
+func TestCannonFixtureDisputeGame(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t, WithFactoryOwner())
+
+	game := sys.Factory.StartFixtureCannonGame(ctx, "testdata/cannon-fixture")
+	game.StartChallenger(ctx, sys.NodeEndpoint("l1"), sys.NodeEndpoint("sequencer"), "Defender", func(c *config.Config) {
+		c.AgreeWithProposedOutput = false // Agree with the root claim, which is the fixture's final state
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+	})
+
+	// Make invalid claims down to max depth so the defender has to step against the last one
+	game.Attack(ctx, 0, common.Hash{0xaa})
+	game.WaitForClaimCount(ctx, 3)
+	game.Attack(ctx, 2, common.Hash{0xbb})
+	game.WaitForClaimAtMaxDepth(ctx, true)
+
+	sys.TimeTravelClock.AdvanceTime(game.GameDuration(ctx))
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
+	game.WaitForGameStatus(ctx, disputegame.StatusDefenderWins)
+}
+
+// TestCannonPrestateStep covers stepping against the claim at trace index 0, which uses the absolute prestate rather
+// than a claim in the game as the pre-state.
+func TestCannonPrestateStep(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t, WithFactoryOwner())
+
+	game := sys.Factory.StartFixtureCannonGame(ctx, "testdata/cannon-fixture")
+	game.RequirePrestateStep(ctx)
+}
+
+func TestStepRevertCallTrace(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t, WithFactoryOwner(), WithFactoryOptions(disputegame.WithCallTracing(t.TempDir())))
+
+	game := sys.Factory.StartFixtureCannonGame(ctx, "testdata/cannon-fixture")
+	sys.Factory.RequireStepRevertTraced(ctx, game)
+}
+
+func TestCannonMaxDepthDisputeGame(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t, WithFactoryOwner())
+
+	game := sys.Factory.PlayMaxDepthGame(ctx, "testdata/cannon-fixture")
+	require.Equal(t, 64, game.MaxDepth())
+}
+
+// TestCannonTestProgramDisputeGame covers the on-chain MIPS step and loading local data into the preimage oracle
+// using a minimal MIPS program executed by cannon, with op-program only serving the program's local inputs, so no
+// L2 blocks are derived. Regenerate the program with make cannon-test-program.
+func TestCannonTestProgramDisputeGame(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t, WithFactoryOwner())
+
+	game := sys.Factory.StartTestProgramCannonGame(ctx, "testdata/cannon-test-program/program.elf")
+	game.StartChallenger(ctx, sys.NodeEndpoint("l1"), sys.NodeEndpoint("sequencer"), "Defender", func(c *config.Config) {
+		c.AgreeWithProposedOutput = false // Agree with the root claim, which is the program's final state
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+	})
+
+	// Make invalid claims down to max depth so the defender has to step against the last one
+	game.Attack(ctx, 0, common.Hash{0xaa})
+	for claimIdx := int64(2); claimIdx < int64(game.MaxDepth()); claimIdx += 2 {
+		game.WaitForClaimCount(ctx, claimIdx+1)
+		game.Attack(ctx, claimIdx, common.Hash{0xbb})
+	}
+	game.WaitForClaimAtMaxDepth(ctx, true)
+
+	sys.TimeTravelClock.AdvanceTime(game.GameDuration(ctx))
+	require.NoError(t, utils.WaitNextBlock(ctx, sys.L1Client))
+	game.WaitForGameStatus(ctx, disputegame.StatusDefenderWins)
+	game.RequireGameIntegrity(ctx, game.ExpectedTrace())
+}
+
+func TestChallengerSelectsGameType(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t, WithFactoryOwner())
+
+	game := sys.Factory.StartFixtureCannonGame(ctx, "testdata/cannon-fixture")
+	sys.Factory.RequireChallengerSelectsGameType(ctx, game, sys.NodeEndpoint("l1"), sys.NodeEndpoint("sequencer"), func(c *config.Config) {
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+	})
+}
+
+// TestChallengerRespondsToDuplicateGames checks a single challenger plays games for the same root claim but different
+// L1 heads independently.
+func TestChallengerRespondsToDuplicateGames(t *testing.T) {
+	t.Run("Alphabet", func(t *testing.T) {
+		InitParallel(t)
+
+		ctx := context.Background()
+		sys := NewFaultProofSystem(t)
+
+		game := sys.Factory.StartAlphabetGame(ctx, "abcdexyz")
+		duplicate := sys.Factory.StartDuplicateAlphabetGame(ctx, game)
+		sys.Factory.RequireDuplicateGamesDistinguishable(ctx, &game.FaultGameHelper, &duplicate.FaultGameHelper)
+
+		game.StartChallenger(ctx, sys.NodeEndpoint("l1"), "Challenger", func(c *config.Config) {
+			c.AgreeWithProposedOutput = true // Agree with the proposed output, so disagree with the root claim
+			c.AlphabetTrace = disputegame.CorrectAlphabet
+			c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+		}, challenger.WithAdditionalGames(duplicate.Addr()))
+
+		game.WaitForClaimCount(ctx, 2)
+		duplicate.WaitForClaimCount(ctx, 2)
+	})
+
+	t.Run("CannonFixture", func(t *testing.T) {
+		InitParallel(t)
+
+		ctx := context.Background()
+		sys := NewFaultProofSystem(t, WithFactoryOwner())
+
+		game := sys.Factory.StartFixtureCannonGame(ctx, "testdata/cannon-fixture")
+		sys.Factory.RequireChallengerPlaysDuplicateGames(ctx, game, sys.NodeEndpoint("l1"), sys.NodeEndpoint("sequencer"), func(c *config.Config) {
+			c.AgreeWithProposedOutput = false // Agree with the root claim, which is the fixture's final state
+			c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+		})
+	})
 }
 
-func startFaultDisputeSystem(t *testing.T) (*System, *ethclient.Client) {
-	cfg := DefaultSystemConfig(t)
-	delete(cfg.Nodes, "verifier")
-	cfg.SupportL1TimeTravel = true
-	cfg.DeployConfig.L2OutputOracleSubmissionInterval = 2
-	cfg.NonFinalizedProposals = true // Submit output proposals asap
-	sys, err := cfg.Start()
-	require.NoError(t, err, "Error starting up system")
-	return sys, sys.Clients["l1"]
+func TestChallengerPlaysGameCreatedBeforeStart(t *testing.T) {
+	InitParallel(t)
+
+	ctx := context.Background()
+	sys := NewFaultProofSystem(t)
+
+	sys.Factory.RequireChallengerPlaysExistingGame(ctx, sys.NodeEndpoint("l1"), func(c *config.Config) {
+		c.TxMgrConfig.PrivateKey = sys.Alice.PrivateKeyHex()
+	})
 }
@@ -18,8 +18,14 @@ var _ = func() bool {
 
 var verboseGethNodes bool
 
+var longTests bool
+
+var realisticL1 bool
+
 func init() {
 	flag.BoolVar(&verboseGethNodes, "gethlogs", true, "Enable logs on geth nodes")
+	flag.BoolVar(&longTests, "longtests", false, "Run long running tests, such as sustained load tests")
+	flag.BoolVar(&realisticL1, "realisticl1", false, "Run tests that use an L1 with mainnet's 12 second block time")
 	flag.Parse()
 	if os.Getenv("OP_E2E_DISABLE_PARALLEL") == "true" {
 		enableParallelTesting = false
@@ -35,3 +41,20 @@ func InitParallel(t *testing.T) {
 		log.Root().SetHandler(log.DiscardHandler())
 	}
 }
+
+// SkipUnlessLongTests skips the test unless long running tests are enabled with -longtests.
+func SkipUnlessLongTests(t *testing.T) {
+	t.Helper()
+	if !longTests {
+		t.Skip("Long running test, enable with -longtests")
+	}
+}
+
+// SkipUnlessRealisticL1 skips the test unless tests against an L1 with realistic block times are enabled with
+// -realisticl1.
+func SkipUnlessRealisticL1(t *testing.T) {
+	t.Helper()
+	if !realisticL1 {
+		t.Skip("Realistic L1 test, enable with -realisticl1")
+	}
+}
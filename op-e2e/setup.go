@@ -10,6 +10,7 @@ import (
 	"os"
 	"path"
 	"sort"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -174,6 +175,9 @@ type SystemConfig struct {
 	ProposerLogger log.Logger
 	BatcherLogger  log.Logger
 
+	// Storage slots to override in the L1 genesis, applied after the L1 deployments are loaded
+	L1GenesisStorage map[common.Address]map[common.Hash]common.Hash
+
 	// map of outbound connections to other nodes. Node names prefixed with "~" are unconnected but linked.
 	// A nil map disables P2P completely.
 	// Any node name not in the topology will not have p2p enabled.
@@ -188,6 +192,9 @@ type SystemConfig struct {
 	// Explicitly disable batcher, for tests that rely on unsafe L2 payloads
 	DisableBatcher bool
 
+	// Explicitly disable proposer, for tests that create output proposals themselves or don't need them
+	DisableProposer bool
+
 	// Target L1 tx size for the batcher transactions
 	BatcherTargetL1TxSizeBytes uint64
 
@@ -207,6 +214,7 @@ type System struct {
 	Backends          map[string]*geth_eth.Ethereum
 	Clients           map[string]*ethclient.Client
 	RollupNodes       map[string]*rollupNode.OpNode
+	rollupNodeConfigs map[string]*rollupNode.Config
 	L2OutputSubmitter *l2os.L2OutputSubmitter
 	BatchSubmitter    *bss.BatchSubmitter
 	Mocknet           mocknet.Mocknet
@@ -217,6 +225,16 @@ type System struct {
 	// Note that this time travel may occur in a single block, creating a very large difference in the Time
 	// on sequential blocks.
 	TimeTravelClock *clock.AdvancingClock
+
+	// l1BlockProducer sequences L1 blocks at the configured L1 block time.
+	l1BlockProducer *fakePoS
+}
+
+// SetL1BlockTime changes the number of seconds between L1 blocks, starting from the next block to be built, so tests
+// can run with realistic gaps between L1 blocks rather than a block as soon as possible.
+// The rollup config is not changed so the L1 block time must not exceed the max sequencer drift.
+func (sys *System) SetL1BlockTime(seconds uint64) {
+	sys.l1BlockProducer.SetBlockTime(seconds)
 }
 
 func (sys *System) NodeEndpoint(name string) string {
@@ -242,6 +260,52 @@ func (sys *System) Close() {
 	sys.Mocknet.Close()
 }
 
+// StopRollupNode stops the named rollup node. It can be started again with StartRollupNode.
+func (sys *System) StopRollupNode(name string) error {
+	node, ok := sys.RollupNodes[name]
+	if !ok {
+		return fmt.Errorf("unknown rollup node %v", name)
+	}
+	cfg := sys.rollupNodeConfigs[name]
+	if cfg.P2P != nil {
+		return fmt.Errorf("rollup node %v uses p2p and can't be restarted", name)
+	}
+	// Keep the same RPC port when restarted so existing clients, such as the batcher, can reconnect.
+	_, port, err := net.SplitHostPort(node.ListenAddr())
+	if err != nil {
+		return fmt.Errorf("parse rollup node %v listen address: %w", name, err)
+	}
+	cfg.RPC.ListenPort, err = strconv.Atoi(port)
+	if err != nil {
+		return fmt.Errorf("parse rollup node %v listen port: %w", name, err)
+	}
+	delete(sys.RollupNodes, name)
+	return node.Close()
+}
+
+// StartRollupNode starts a rollup node previously stopped with StopRollupNode, using the same config and RPC endpoint.
+func (sys *System) StartRollupNode(ctx context.Context, name string) error {
+	if _, ok := sys.RollupNodes[name]; ok {
+		return fmt.Errorf("rollup node %v is already running", name)
+	}
+	cfg, ok := sys.rollupNodeConfigs[name]
+	if !ok {
+		return fmt.Errorf("unknown rollup node %v", name)
+	}
+	snapLog := log.New()
+	snapLog.SetHandler(log.DiscardHandler())
+	node, err := rollupNode.New(ctx, cfg, sys.cfg.Loggers[name], snapLog, "", metrics.NewMetrics(""))
+	if err != nil {
+		return fmt.Errorf("create rollup node %v: %w", name, err)
+	}
+	if err := node.Start(ctx); err != nil {
+		_ = node.Close()
+		return fmt.Errorf("start rollup node %v: %w", name, err)
+	}
+	sys.RollupNodes[name] = node
+	return nil
+}
+
 type systemConfigHook func(sCfg *SystemConfig, s *System)
 
 type SystemConfigOption struct {
@@ -280,11 +344,12 @@ func (cfg SystemConfig) Start(_opts ...SystemConfigOption) (*System, error) {
 	}
 
 	sys := &System{
-		cfg:         cfg,
-		Nodes:       make(map[string]*node.Node),
-		Backends:    make(map[string]*geth_eth.Ethereum),
-		Clients:     make(map[string]*ethclient.Client),
-		RollupNodes: make(map[string]*rollupNode.OpNode),
+		cfg:               cfg,
+		Nodes:             make(map[string]*node.Node),
+		Backends:          make(map[string]*geth_eth.Ethereum),
+		Clients:           make(map[string]*ethclient.Client),
+		RollupNodes:       make(map[string]*rollupNode.OpNode),
+		rollupNodeConfigs: make(map[string]*rollupNode.Config),
 	}
 	didErrAfterStart := false
 	defer func() {
@@ -329,6 +394,22 @@ func (cfg SystemConfig) Start(_opts ...SystemConfigOption) (*System, error) {
 		}
 	}
 
+	for addr, slots := range cfg.L1GenesisStorage {
+		account, ok := l1Genesis.Alloc[addr]
+		if !ok {
+			return nil, fmt.Errorf("cannot override storage of %v: not in L1 genesis", addr)
+		}
+		storage := make(map[common.Hash]common.Hash, len(account.Storage)+len(slots))
+		for slot, value := range account.Storage {
+			storage[slot] = value
+		}
+		for slot, value := range slots {
+			storage[slot] = value
+		}
+		account.Storage = storage
+		l1Genesis.Alloc[addr] = account
+	}
+
 	l1Block := l1Genesis.ToBlock()
 	l2Genesis, err := genesis.BuildL2Genesis(cfg.DeployConfig, l1Block)
 	if err != nil {
@@ -384,12 +465,13 @@ func (cfg SystemConfig) Start(_opts ...SystemConfigOption) (*System, error) {
 	sys.RollupConfig = &defaultConfig
 
 	// Initialize nodes
-	l1Node, l1Backend, err := initL1Geth(&cfg, l1Genesis, c, cfg.GethOptions["l1"]...)
+	l1Node, l1Backend, l1BlockProducer, err := initL1Geth(&cfg, l1Genesis, c, cfg.GethOptions["l1"]...)
 	if err != nil {
 		return nil, err
 	}
 	sys.Nodes["l1"] = l1Node
 	sys.Backends["l1"] = l1Backend
+	sys.l1BlockProducer = l1BlockProducer
 
 	for name := range cfg.Nodes {
 		node, backend, err := initL2Geth(name, big.NewInt(int64(cfg.DeployConfig.L2ChainID)), l2Genesis, cfg.JWTFilePath, cfg.GethOptions[name]...)
@@ -545,6 +627,7 @@ func (cfg SystemConfig) Start(_opts ...SystemConfigOption) (*System, error) {
 			return nil, err
 		}
 		sys.RollupNodes[name] = node
+		sys.rollupNodeConfigs[name] = &c
 
 		if action, ok := opts.Get("afterRollupNodeStart", name); ok {
 			action(&cfg, sys)
@@ -578,24 +661,26 @@ func (cfg SystemConfig) Start(_opts ...SystemConfigOption) (*System, error) {
 	}
 
 	// L2Output Submitter
-	sys.L2OutputSubmitter, err = l2os.NewL2OutputSubmitterFromCLIConfig(l2os.CLIConfig{
-		L1EthRpc:          sys.Nodes["l1"].WSEndpoint(),
-		RollupRpc:         sys.RollupNodes["sequencer"].HTTPEndpoint(),
-		L2OOAddress:       config.L1Deployments.L2OutputOracleProxy.Hex(),
-		PollInterval:      50 * time.Millisecond,
-		TxMgrConfig:       newTxMgrConfig(sys.Nodes["l1"].WSEndpoint(), cfg.Secrets.Proposer),
-		AllowNonFinalized: cfg.NonFinalizedProposals,
-		LogConfig: oplog.CLIConfig{
-			Level:  "info",
-			Format: "text",
-		},
-	}, sys.cfg.Loggers["proposer"], proposermetrics.NoopMetrics)
-	if err != nil {
-		return nil, fmt.Errorf("unable to setup l2 output submitter: %w", err)
-	}
+	if !sys.cfg.DisableProposer {
+		sys.L2OutputSubmitter, err = l2os.NewL2OutputSubmitterFromCLIConfig(l2os.CLIConfig{
+			L1EthRpc:          sys.Nodes["l1"].WSEndpoint(),
+			RollupRpc:         sys.RollupNodes["sequencer"].HTTPEndpoint(),
+			L2OOAddress:       config.L1Deployments.L2OutputOracleProxy.Hex(),
+			PollInterval:      50 * time.Millisecond,
+			TxMgrConfig:       newTxMgrConfig(sys.Nodes["l1"].WSEndpoint(), cfg.Secrets.Proposer),
+			AllowNonFinalized: cfg.NonFinalizedProposals,
+			LogConfig: oplog.CLIConfig{
+				Level:  "info",
+				Format: "text",
+			},
+		}, sys.cfg.Loggers["proposer"], proposermetrics.NoopMetrics)
+		if err != nil {
+			return nil, fmt.Errorf("unable to setup l2 output submitter: %w", err)
+		}
 
-	if err := sys.L2OutputSubmitter.Start(); err != nil {
-		return nil, fmt.Errorf("unable to start l2 output submitter: %w", err)
+		if err := sys.L2OutputSubmitter.Start(); err != nil {
+			return nil, fmt.Errorf("unable to start l2 output submitter: %w", err)
+		}
 	}
 
 	// Batch Submitter
@@ -0,0 +1,133 @@
+//go:build ignore
+
+// Generates program.elf, a minimal MIPS program for cannon games in e2e tests that don't need op-program.
+// The program requests the L1 head local preimage, reads its 8 byte length prefix and 32 byte value into memory
+// and exits with code 0, so the final state depends only on the game's L1 head and is reached in 100 steps.
+//
+// Regenerate with make cannon-test-program.
+package main
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"log"
+	"os"
+)
+
+const (
+	// loadAddr is the address the single program segment is loaded at.
+	loadAddr = 0x00400000
+	// bufferLen is the size of the buffer the preimage is read into: the length prefix plus the L1 head.
+	bufferLen = 8 + 32
+)
+
+const (
+	regZero = 0
+	regV0   = 2
+	regA0   = 4
+	regA1   = 5
+	regA2   = 6
+	regS1   = 17
+)
+
+const (
+	sysRead      = 4003
+	sysWrite     = 4004
+	sysExitGroup = 4246
+
+	fdPreimageRead  = 5
+	fdPreimageWrite = 6
+)
+
+func iType(op, rs, rt uint32, imm int16) uint32 {
+	return op<<26 | rs<<21 | rt<<16 | uint32(uint16(imm))
+}
+
+func addiu(rt, rs uint32, imm int16) uint32 { return iType(0x09, rs, rt, imm) }
+func lui(rt uint32, imm int16) uint32       { return iType(0x0f, 0, rt, imm) }
+func addu(rd, rs, rt uint32) uint32         { return rs<<21 | rt<<16 | rd<<11 | 0x21 }
+
+// bne branches back by offset instructions, relative to the delay slot.
+func bne(rs, rt uint32, offset int16) uint32 { return iType(0x05, rs, rt, offset) }
+
+const (
+	syscall = 0x0000000c
+	nop     = 0x00000000
+)
+
+func main() {
+	code := []uint32{
+		// Write the local key for the L1 head to the preimage oracle, 4 bytes at a time.
+		lui(regA1, loadAddr>>16),
+		addiu(regA1, regA1, 0), // Patched with the offset of the key below
+		addiu(regS1, regA1, 32),
+		addiu(regA0, regZero, fdPreimageWrite),
+		addiu(regA2, regZero, 4),
+		// write loop
+		addiu(regV0, regZero, sysWrite),
+		syscall,
+		addiu(regA1, regA1, 4),
+		bne(regA1, regS1, -4),
+		nop,
+		// Read the preimage into the buffer following the key, 4 bytes at a time.
+		addiu(regS1, regA1, bufferLen),
+		addiu(regA0, regZero, fdPreimageRead),
+		// read loop
+		addiu(regV0, regZero, sysRead),
+		syscall,
+		addu(regA1, regA1, regV0),
+		bne(regA1, regS1, -4),
+		nop,
+		// Exit with code 0.
+		addiu(regV0, regZero, sysExitGroup),
+		addu(regA0, regZero, regZero),
+		syscall,
+	}
+	keyOffset := len(code) * 4
+	code[1] = addiu(regA1, regA1, int16(keyOffset))
+
+	var segment bytes.Buffer
+	if err := binary.Write(&segment, binary.BigEndian, code); err != nil {
+		log.Fatalf("encode code: %v", err)
+	}
+	// Local key type, with the L1 head's local index of 1 in the last byte.
+	key := [32]byte{0: 1, 31: 1}
+	segment.Write(key[:])
+
+	const headerLen = 52 + 32
+	header := elf.Header32{
+		Type:      uint16(elf.ET_EXEC),
+		Machine:   uint16(elf.EM_MIPS),
+		Version:   uint32(elf.EV_CURRENT),
+		Entry:     loadAddr,
+		Phoff:     52,
+		Ehsize:    52,
+		Phentsize: 32,
+		Phnum:     1,
+	}
+	copy(header.Ident[:], elf.ELFMAG)
+	header.Ident[elf.EI_CLASS] = byte(elf.ELFCLASS32)
+	header.Ident[elf.EI_DATA] = byte(elf.ELFDATA2MSB)
+	header.Ident[elf.EI_VERSION] = byte(elf.EV_CURRENT)
+	prog := elf.Prog32{
+		Type:   uint32(elf.PT_LOAD),
+		Off:    headerLen,
+		Vaddr:  loadAddr,
+		Paddr:  loadAddr,
+		Filesz: uint32(segment.Len()),
+		Memsz:  uint32(segment.Len() + bufferLen),
+		Flags:  uint32(elf.PF_R | elf.PF_W | elf.PF_X),
+		Align:  4,
+	}
+
+	var out bytes.Buffer
+	for _, v := range []any{header, prog, segment.Bytes()} {
+		if err := binary.Write(&out, binary.BigEndian, v); err != nil {
+			log.Fatalf("encode elf: %v", err)
+		}
+	}
+	if err := os.WriteFile("program.elf", out.Bytes(), 0644); err != nil {
+		log.Fatalf("write program.elf: %v", err)
+	}
+}
@@ -43,6 +43,10 @@ func (r *RollupClient) Version(ctx context.Context) (string, error) {
 	return output, err
 }
 
+func (r *RollupClient) ResetDerivationPipeline(ctx context.Context) error {
+	return r.rpc.CallContext(ctx, nil, "admin_resetDerivationPipeline")
+}
+
 func (r *RollupClient) StartSequencer(ctx context.Context, unsafeHead common.Hash) error {
 	return r.rpc.CallContext(ctx, nil, "admin_startSequencer", unsafeHead)
 }